@@ -61,7 +61,7 @@ func GetMaroto(imagePath string) core.Maroto {
 		),
 		row.New(20).Add(
 			text.NewCol(4, "QrCode:", props.Text{Size: 15, Top: 6, Align: align.Center}),
-			code.NewQrCol(8, "qrcode", props.Rect{Center: true, Percent: 70}),
+			code.NewQrCol(8, "qrcode", props.QrCode{Center: true, Percent: 70}),
 		),
 		row.New(20).Add(
 			text.NewCol(4, "MatrixCode:", props.Text{Size: 15, Top: 6, Align: align.Center}),
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"io/fs"
 	"log"
+	"os"
 
 	"github.com/johnfercher/maroto/v2/pkg/core"
 
@@ -21,7 +23,7 @@ import (
 )
 
 func main() {
-	m := GetMaroto("docs/assets/fonts/arial-unicode-ms.ttf")
+	m := GetMaroto(os.DirFS("."), "docs/assets/fonts/arial-unicode-ms.ttf")
 	document, err := m.Generate()
 	if err != nil {
 		log.Fatal(err.Error())
@@ -38,7 +40,7 @@ func main() {
 	}
 }
 
-func GetMaroto(customFontFile string) core.Maroto {
+func GetMaroto(fsys fs.FS, customFontFile string) core.Maroto {
 	customFont := "arial-unicode-ms"
 
 	customFonts, err := repository.New().
@@ -52,6 +54,7 @@ func GetMaroto(customFontFile string) core.Maroto {
 	}
 
 	builder := config.NewBuilder().
+		WithFileSystem(fsys).
 		WithCustomFonts(customFonts)
 
 	cfg := builder.WithDefaultFont(&props.Font{Family: customFont}).
@@ -11,7 +11,7 @@ import (
 
 func TestGetMaroto(t *testing.T) {
 	// Act
-	sut := GetMaroto(buildPath("docs/assets/fonts/arial-unicode-ms.ttf"))
+	sut := GetMaroto(os.DirFS(buildPath("")), "docs/assets/fonts/arial-unicode-ms.ttf")
 
 	// Assert
 	test.New(t).Assert(sut.GetStructure()).Equals("examples/customfont.json")
@@ -40,71 +40,71 @@ func GetMaroto() core.Maroto {
 	m := maroto.NewMetricsDecorator(mrt)
 
 	m.AddRow(40,
-		code.NewQrCol(2, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(2, "https://github.com/johnfercher/maroto", props.QrCode{
 			Percent: 50,
 		}),
-		code.NewQrCol(4, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(4, "https://github.com/johnfercher/maroto", props.QrCode{
 			Percent: 75,
 		}),
-		code.NewQrCol(6, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(6, "https://github.com/johnfercher/maroto", props.QrCode{
 			Percent: 100,
 		}),
 	)
 
 	m.AddRow(40,
-		code.NewQrCol(2, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(2, "https://github.com/johnfercher/maroto", props.QrCode{
 			Center:  true,
 			Percent: 50,
 		}),
-		code.NewQrCol(4, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(4, "https://github.com/johnfercher/maroto", props.QrCode{
 			Center:  true,
 			Percent: 75,
 		}),
-		code.NewQrCol(6, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(6, "https://github.com/johnfercher/maroto", props.QrCode{
 			Center:  true,
 			Percent: 100,
 		}),
 	)
 
 	m.AddRow(40,
-		code.NewQrCol(6, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(6, "https://github.com/johnfercher/maroto", props.QrCode{
 			Percent: 50,
 		}),
-		code.NewQrCol(4, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(4, "https://github.com/johnfercher/maroto", props.QrCode{
 			Percent: 75,
 		}),
-		code.NewQrCol(2, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(2, "https://github.com/johnfercher/maroto", props.QrCode{
 			Percent: 100,
 		}),
 	)
 
 	m.AddRow(40,
-		code.NewQrCol(6, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(6, "https://github.com/johnfercher/maroto", props.QrCode{
 			Center:  true,
 			Percent: 50,
 		}),
-		code.NewQrCol(4, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(4, "https://github.com/johnfercher/maroto", props.QrCode{
 			Center:  true,
 			Percent: 75,
 		}),
-		code.NewQrCol(2, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(2, "https://github.com/johnfercher/maroto", props.QrCode{
 			Center:  true,
 			Percent: 100,
 		}),
 	)
 
 	m.AddAutoRow(
-		code.NewQrCol(6, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(6, "https://github.com/johnfercher/maroto", props.QrCode{
 			Center:             true,
 			Percent:            30,
 			JustReferenceWidth: true,
 		}),
-		code.NewQrCol(4, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(4, "https://github.com/johnfercher/maroto", props.QrCode{
 			Center:             true,
 			Percent:            75,
 			JustReferenceWidth: true,
 		}),
-		code.NewQrCol(2, "https://github.com/johnfercher/maroto", props.Rect{
+		code.NewQrCol(2, "https://github.com/johnfercher/maroto", props.QrCode{
 			Center:             true,
 			Percent:            100,
 			JustReferenceWidth: true,
@@ -0,0 +1,433 @@
+// Package acroform implements the interactive fields of a PDF form.
+package acroform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/formfield"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Field flags defined by the PDF specification.
+const (
+	flagReadOnly      = 1
+	flagRequired      = 1 << 1
+	flagMultiLine     = 1 << 12
+	flagNoToggleToOff = 1 << 14
+	flagRadio         = 1 << 15
+	flagCombo         = 1 << 17
+	// annotationPrint makes the widgets visible when the document is printed.
+	annotationPrint = 4
+)
+
+const (
+	helveticaID    = "Helv"
+	zapfDingbatsID = "ZaDb"
+	checkOnState   = "Yes"
+	offState       = "Off"
+	// mmToPoint converts millimeters to points.
+	mmToPoint = 72 / 25.4
+)
+
+// Widget is the area of a page where a field is drawn, in points from the bottom left corner of the page.
+type Widget struct {
+	Page   int
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+	// Option is the option of a radio group selected by the widget.
+	Option string
+}
+
+// Field is a form field with the widgets where it is drawn, radio groups have a widget for each option.
+type Field struct {
+	Data    *entity.FormField
+	Prop    *props.FormField
+	Widgets []Widget
+}
+
+type writer struct {
+	ctx   *model.Context
+	fonts types.Dict
+}
+
+// Write adds the fields to the AcroForm of a PDF, with the appearance of each field in its current value.
+func Write(pdf []byte, fields []*Field) ([]byte, error) {
+	if len(fields) == 0 {
+		return pdf, nil
+	}
+
+	conf := model.NewDefaultConfiguration()
+	conf.WriteXRefStream = false
+
+	ctx, err := api.ReadContext(bytes.NewReader(pdf), conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EnsurePageCount(); err != nil {
+		return nil, err
+	}
+
+	w := &writer{ctx: ctx}
+	if err := w.addFonts(); err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool)
+	var refs types.Array
+	for _, field := range fields {
+		if names[field.Data.Name] {
+			return nil, fmt.Errorf("form field %s is added more than once", field.Data.Name)
+		}
+		names[field.Data.Name] = true
+
+		ref, err := w.addField(field)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, *ref)
+	}
+
+	root, err := ctx.Catalog()
+	if err != nil {
+		return nil, err
+	}
+
+	root["AcroForm"] = types.Dict{
+		"Fields": refs,
+		"DA":     types.StringLiteral("/" + helveticaID + " 0 Tf 0 g"),
+		"DR":     types.Dict{"Font": w.fonts},
+	}
+
+	var buffer bytes.Buffer
+	if err := api.WriteContext(ctx, &buffer); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func (w *writer) addFonts() error {
+	helvetica, err := w.ctx.IndRefForNewObject(types.Dict{
+		"Type":     types.Name("Font"),
+		"Subtype":  types.Name("Type1"),
+		"BaseFont": types.Name("Helvetica"),
+		"Encoding": types.Name("WinAnsiEncoding"),
+	})
+	if err != nil {
+		return err
+	}
+
+	zapfDingbats, err := w.ctx.IndRefForNewObject(types.Dict{
+		"Type":     types.Name("Font"),
+		"Subtype":  types.Name("Type1"),
+		"BaseFont": types.Name("ZapfDingbats"),
+	})
+	if err != nil {
+		return err
+	}
+
+	w.fonts = types.Dict{helveticaID: *helvetica, zapfDingbatsID: *zapfDingbats}
+	return nil
+}
+
+func (w *writer) addField(field *Field) (*types.IndirectRef, error) {
+	if len(field.Widgets) == 0 {
+		return nil, fmt.Errorf("form field %s without widgets", field.Data.Name)
+	}
+
+	if field.Data.Type == formfield.RadioGroup {
+		return w.addRadioGroup(field)
+	}
+
+	d := w.getFieldDict(field)
+	if err := w.addWidget(d, field, field.Widgets[0]); err != nil {
+		return nil, err
+	}
+
+	ref, err := w.ctx.IndRefForNewObject(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return ref, w.addToPage(field.Widgets[0].Page, *ref)
+}
+
+func (w *writer) addRadioGroup(field *Field) (*types.IndirectRef, error) {
+	d := w.getFieldDict(field)
+	ref, err := w.ctx.IndRefForNewObject(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var kids types.Array
+	for _, widget := range field.Widgets {
+		if getStateName(widget.Option) == offState {
+			return nil, fmt.Errorf("form field %s option %s is reserved", field.Data.Name, offState)
+		}
+
+		kid := types.Dict{"Parent": *ref}
+		if err := w.addWidget(kid, field, widget); err != nil {
+			return nil, err
+		}
+
+		kidRef, err := w.ctx.IndRefForNewObject(kid)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := w.addToPage(widget.Page, *kidRef); err != nil {
+			return nil, err
+		}
+		kids = append(kids, *kidRef)
+	}
+
+	d["Kids"] = kids
+	return ref, nil
+}
+
+// getFieldDict returns the entries of the field, which are shared by all its widgets.
+func (w *writer) getFieldDict(field *Field) types.Dict {
+	data, prop := field.Data, field.Prop
+	d := types.Dict{"T": toTextString(data.Name)}
+
+	flags := 0
+	if prop.ReadOnly {
+		flags |= flagReadOnly
+	}
+	if prop.Required {
+		flags |= flagRequired
+	}
+
+	switch data.Type {
+	case formfield.Text:
+		d["FT"] = types.Name("Tx")
+		d["V"] = toTextString(data.Value)
+		d["DV"] = toTextString(data.Value)
+		d["DA"] = getDefaultAppearance(helveticaID, prop.Size, prop.Color)
+		d["Q"] = types.Integer(getQuadding(prop.Align))
+		if prop.MultiLine {
+			flags |= flagMultiLine
+		}
+		if prop.MaxLength > 0 {
+			d["MaxLen"] = types.Integer(prop.MaxLength)
+		}
+	case formfield.CheckBox:
+		state := types.Name(getCheckBoxState(data.Checked))
+		d["FT"] = types.Name("Btn")
+		d["V"] = state
+		d["DV"] = state
+		d["DA"] = getDefaultAppearance(zapfDingbatsID, 0, prop.Color)
+	case formfield.RadioGroup:
+		state := types.Name(offState)
+		if data.Value != "" {
+			state = types.Name(getStateName(data.Value))
+		}
+		d["FT"] = types.Name("Btn")
+		d["V"] = state
+		d["DV"] = state
+		d["DA"] = getDefaultAppearance(zapfDingbatsID, 0, prop.Color)
+		flags |= flagRadio | flagNoToggleToOff
+	case formfield.Dropdown:
+		var options types.Array
+		for _, option := range data.Options {
+			options = append(options, toTextString(option))
+		}
+		d["FT"] = types.Name("Ch")
+		d["Opt"] = options
+		d["V"] = toTextString(data.Value)
+		d["DV"] = toTextString(data.Value)
+		d["DA"] = getDefaultAppearance(helveticaID, prop.Size, prop.Color)
+		d["Q"] = types.Integer(getQuadding(prop.Align))
+		flags |= flagCombo
+	case formfield.Signature:
+		d["FT"] = types.Name("Sig")
+	}
+
+	if flags != 0 {
+		d["Ff"] = types.Integer(flags)
+	}
+
+	return d
+}
+
+// addWidget adds the entries of the widget annotation and its appearance streams to d.
+func (w *writer) addWidget(d types.Dict, field *Field, widget Widget) error {
+	pageRef, err := w.ctx.PageDictIndRef(widget.Page)
+	if err != nil {
+		return err
+	}
+	if pageRef == nil {
+		return fmt.Errorf("form field %s is in page %d, which does not exist", field.Data.Name, widget.Page)
+	}
+
+	prop := field.Prop
+	borderWidth := prop.BorderThickness * mmToPoint
+
+	d["Type"] = types.Name("Annot")
+	d["Subtype"] = types.Name("Widget")
+	d["Rect"] = types.NewNumberArray(widget.X, widget.Y, widget.X+widget.Width, widget.Y+widget.Height)
+	d["F"] = types.Integer(annotationPrint)
+	d["P"] = *pageRef
+	d["BS"] = types.Dict{"W": types.Float(borderWidth), "S": types.Name("S")}
+
+	mk := types.Dict{"BC": toColorArray(prop.BorderColor)}
+	if prop.BackgroundColor != nil {
+		mk["BG"] = toColorArray(prop.BackgroundColor)
+	}
+	d["MK"] = mk
+
+	appearance := newAppearance(widget.Width, widget.Height, prop)
+	switch field.Data.Type {
+	case formfield.Text:
+		return w.addAppearance(d, appearance, appearance.text(field.Data.Value))
+	case formfield.Dropdown:
+		return w.addAppearance(d, appearance, appearance.dropdown(field.Data.Value))
+	case formfield.Signature:
+		return w.addAppearance(d, appearance, appearance.box())
+	case formfield.CheckBox:
+		mk["CA"] = types.StringLiteral("4")
+		d["AS"] = types.Name(getCheckBoxState(field.Data.Checked))
+		return w.addStates(d, appearance, checkOnState, appearance.check(), appearance.box())
+	case formfield.RadioGroup:
+		state := getStateName(widget.Option)
+		mk["CA"] = types.StringLiteral("l")
+		d["AS"] = types.Name(offState)
+		if widget.Option == field.Data.Value {
+			d["AS"] = types.Name(state)
+		}
+		return w.addStates(d, appearance, state, appearance.radio(true), appearance.radio(false))
+	}
+
+	return errors.New("form field type is not supported")
+}
+
+func (w *writer) addAppearance(d types.Dict, a *appearance, content []byte) error {
+	ref, err := w.newAppearanceStream(a, content)
+	if err != nil {
+		return err
+	}
+
+	d["AP"] = types.Dict{"N": *ref}
+	return nil
+}
+
+// addStates adds the appearances of a button, which has an on state and the off state.
+func (w *writer) addStates(d types.Dict, a *appearance, state string, on, off []byte) error {
+	onRef, err := w.newAppearanceStream(a, on)
+	if err != nil {
+		return err
+	}
+
+	offRef, err := w.newAppearanceStream(a, off)
+	if err != nil {
+		return err
+	}
+
+	d["AP"] = types.Dict{"N": types.Dict{state: *onRef, offState: *offRef}}
+	return nil
+}
+
+func (w *writer) newAppearanceStream(a *appearance, content []byte) (*types.IndirectRef, error) {
+	sd, err := w.ctx.NewStreamDictForBuf(content)
+	if err != nil {
+		return nil, err
+	}
+
+	sd.InsertName("Type", "XObject")
+	sd.InsertName("Subtype", "Form")
+	sd.Insert("BBox", types.NewNumberArray(0, 0, a.width, a.height))
+	sd.Insert("Resources", types.Dict{"Font": w.fonts})
+
+	if err := sd.Encode(); err != nil {
+		return nil, err
+	}
+
+	return w.ctx.IndRefForNewObject(*sd)
+}
+
+// addToPage adds the widget annotation to the annotations of the page.
+func (w *writer) addToPage(page int, ref types.IndirectRef) error {
+	pageDict, _, _, err := w.ctx.PageDict(page, false)
+	if err != nil {
+		return err
+	}
+
+	var annots types.Array
+	if obj, found := pageDict.Find("Annots"); found {
+		annots, err = w.ctx.DereferenceArray(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	pageDict["Annots"] = append(annots, ref)
+	return nil
+}
+
+func getCheckBoxState(checked bool) string {
+	if checked {
+		return checkOnState
+	}
+
+	return offState
+}
+
+// getQuadding returns the alignment of the text in the format of the PDF specification.
+func getQuadding(alignType align.Type) int {
+	switch alignType {
+	case align.Center:
+		return 1
+	case align.Right:
+		return 2
+	default:
+		return 0
+	}
+}
+
+func getDefaultAppearance(fontID string, size float64, color *props.Color) types.StringLiteral {
+	return types.StringLiteral(fmt.Sprintf("/%s %s Tf %s", fontID, formatNumber(size), getFillColor(color)))
+}
+
+func toColorArray(color *props.Color) types.Array {
+	return types.NewNumberArray(float64(color.Red)/255, float64(color.Green)/255, float64(color.Blue)/255)
+}
+
+// toTextString encodes a text as a PDF text string, texts which are not ASCII are encoded in UTF-16.
+func toTextString(text string) types.StringLiteral {
+	for _, r := range text {
+		if r > 127 {
+			escaped, _ := types.EscapeUTF16String(text)
+			return types.StringLiteral(*escaped)
+		}
+	}
+
+	escaped, _ := types.Escape(text)
+	return types.StringLiteral(*escaped)
+}
+
+// getStateName returns the name of the appearance state of an option, the bytes which are not letters or
+// digits are written as #xx.
+func getStateName(option string) string {
+	var name bytes.Buffer
+	for i := 0; i < len(option); i++ {
+		c := option[i]
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_' || c == '-' {
+			name.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&name, "#%02X", c)
+	}
+
+	return name.String()
+}
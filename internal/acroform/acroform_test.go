@@ -0,0 +1,127 @@
+package acroform_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/acroform"
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/formfield"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestWrite(t *testing.T) {
+	t.Run("when there are no fields, should return the same pdf", func(t *testing.T) {
+		// Arrange
+		pdf := newPDF(t)
+
+		// Act
+		bytes, err := acroform.Write(pdf, nil)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, pdf, bytes)
+	})
+	t.Run("when pdf is invalid, should return error", func(t *testing.T) {
+		// Act
+		bytes, err := acroform.Write([]byte{1, 2, 3}, []*acroform.Field{newField(formfield.Text, "name")})
+
+		// Assert
+		assert.Nil(t, bytes)
+		assert.NotNil(t, err)
+	})
+	t.Run("when a name is repeated, should return error", func(t *testing.T) {
+		// Arrange
+		fields := []*acroform.Field{newField(formfield.Text, "name"), newField(formfield.CheckBox, "name")}
+
+		// Act
+		bytes, err := acroform.Write(newPDF(t), fields)
+
+		// Assert
+		assert.Nil(t, bytes)
+		assert.NotNil(t, err)
+	})
+	t.Run("when page does not exist, should return error", func(t *testing.T) {
+		// Arrange
+		field := newField(formfield.Text, "name")
+		field.Widgets[0].Page = 2
+
+		// Act
+		bytes, err := acroform.Write(newPDF(t), []*acroform.Field{field})
+
+		// Assert
+		assert.Nil(t, bytes)
+		assert.NotNil(t, err)
+	})
+	t.Run("when a radio group option is Off, should return error", func(t *testing.T) {
+		// Arrange
+		field := newField(formfield.RadioGroup, "plan")
+		field.Data.Options = []string{"Off"}
+		field.Widgets[0].Option = "Off"
+
+		// Act
+		bytes, err := acroform.Write(newPDF(t), []*acroform.Field{field})
+
+		// Assert
+		assert.Nil(t, bytes)
+		assert.NotNil(t, err)
+	})
+	t.Run("when fields are valid, should write the form", func(t *testing.T) {
+		// Arrange
+		text := newField(formfield.Text, "name")
+		text.Data.Value = "Maria Silva"
+		checkBox := newField(formfield.CheckBox, "accept")
+		checkBox.Data.Checked = true
+		radioGroup := newField(formfield.RadioGroup, "plan")
+		radioGroup.Widgets = append(radioGroup.Widgets, acroform.Widget{Page: 1, X: 10, Y: 30, Width: 10, Height: 10, Option: "premium"})
+		dropdown := newField(formfield.Dropdown, "country")
+		signature := newField(formfield.Signature, "signature")
+
+		// Act
+		pdf, err := acroform.Write(newPDF(t), []*acroform.Field{text, checkBox, radioGroup, dropdown, signature})
+
+		// Assert
+		assert.Nil(t, err)
+		conf := model.NewDefaultConfiguration()
+		assert.Nil(t, api.Validate(bytes.NewReader(pdf), conf))
+		fields, err := api.FormFields(bytes.NewReader(pdf), conf)
+		assert.Nil(t, err)
+		assert.Len(t, fields, 5)
+		values := make(map[string]string)
+		for _, field := range fields {
+			values[field.Name] = field.V
+		}
+		assert.Equal(t, "Maria Silva", values["name"])
+		assert.Equal(t, "Yes", values["accept"])
+		assert.Equal(t, "premium", values["plan"])
+		assert.Equal(t, "premium", values["country"])
+	})
+}
+
+func newField(fieldType formfield.Type, name string) *acroform.Field {
+	data := fixture.FormFieldEntity()
+	data.Type = fieldType
+	data.Name = name
+	prop := props.FormField{}
+	prop.MakeValid()
+
+	return &acroform.Field{
+		Data:    &data,
+		Prop:    &prop,
+		Widgets: []acroform.Widget{{Page: 1, X: 10, Y: 10, Width: 100, Height: 20, Option: data.Options[0]}},
+	}
+}
+
+func newPDF(t *testing.T) []byte {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.AddPage()
+
+	var buffer bytes.Buffer
+	assert.Nil(t, pdf.Output(&buffer))
+	return buffer.Bytes()
+}
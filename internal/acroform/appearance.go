@@ -0,0 +1,229 @@
+package acroform
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pdfcpu/pdfcpu/pkg/font"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// The text is drawn with the metrics of Helvetica, whose capital letters have 71.8% of the font size.
+const (
+	helvetica       = "Helvetica"
+	capHeight       = 0.718
+	lineSpacing     = 1.15
+	textPadding     = 2.0
+	checkWidth      = 0.76
+	checkHeight     = 0.69
+	checkPercent    = 0.8
+	radioDotPercent = 0.5
+	// bezierCircle is the distance of the control points used to draw a quarter of a circle with a bezier curve.
+	bezierCircle = 0.5523
+)
+
+// appearance draws the content of the appearance streams of a widget with its width and height in points.
+type appearance struct {
+	width       float64
+	height      float64
+	borderWidth float64
+	prop        *props.FormField
+}
+
+func newAppearance(width, height float64, prop *props.FormField) *appearance {
+	return &appearance{
+		width:       width,
+		height:      height,
+		borderWidth: prop.BorderThickness * mmToPoint,
+		prop:        prop,
+	}
+}
+
+// box draws the background and the border of the widget.
+func (a *appearance) box() []byte {
+	var content bytes.Buffer
+	a.writeBox(&content)
+	return content.Bytes()
+}
+
+// text draws the box and the value, which is broken in lines when the field is multi-line.
+func (a *appearance) text(value string) []byte {
+	var content bytes.Buffer
+	a.writeBox(&content)
+
+	padding := a.borderWidth + textPadding
+	size := a.prop.Size
+	content.WriteString("/Tx BMC\nq\n")
+	fmt.Fprintf(&content, "%s %s %s %s re W n\n", formatNumber(a.borderWidth), formatNumber(a.borderWidth),
+		formatNumber(a.width-2*a.borderWidth), formatNumber(a.height-2*a.borderWidth))
+	fmt.Fprintf(&content, "BT\n/%s %s Tf\n%s\n", helveticaID, formatNumber(size), getFillColor(a.prop.Color))
+
+	lines := []string{encodeWinAnsi(value)}
+	y := (a.height - size*capHeight) / 2
+	if a.prop.MultiLine {
+		lines = breakLines(lines[0], size, a.width-2*padding)
+		y = a.height - padding - size
+	}
+
+	for _, line := range lines {
+		x := padding
+		width := getTextWidth(line, size)
+		if a.prop.Align == align.Center {
+			x = (a.width - width) / 2
+		} else if a.prop.Align == align.Right {
+			x = a.width - padding - width
+		}
+
+		escaped, _ := types.Escape(line)
+		fmt.Fprintf(&content, "1 0 0 1 %s %s Tm (%s) Tj\n", formatNumber(x), formatNumber(y), *escaped)
+		y -= size * lineSpacing
+	}
+
+	content.WriteString("ET\nQ\nEMC\n")
+	return content.Bytes()
+}
+
+// dropdown draws the box and the selected option in a single line.
+func (a *appearance) dropdown(value string) []byte {
+	prop := *a.prop
+	prop.MultiLine = false
+	single := &appearance{width: a.width, height: a.height, borderWidth: a.borderWidth, prop: &prop}
+	return single.text(value)
+}
+
+// check draws the box and the check mark of a checked checkbox.
+func (a *appearance) check() []byte {
+	var content bytes.Buffer
+	a.writeBox(&content)
+
+	size := math.Min(a.width, a.height) * checkPercent
+	x := (a.width - size*checkWidth) / 2
+	y := (a.height - size*checkHeight) / 2
+	fmt.Fprintf(&content, "q\nBT\n/%s %s Tf\n%s\n%s %s Td\n(4) Tj\nET\nQ\n", zapfDingbatsID, formatNumber(size),
+		getFillColor(a.prop.Color), formatNumber(x), formatNumber(y))
+
+	return content.Bytes()
+}
+
+// radio draws the circle of a radio button, which has a dot in the center when it is selected.
+func (a *appearance) radio(selected bool) []byte {
+	var content bytes.Buffer
+	radius := math.Min(a.width, a.height)/2 - a.borderWidth/2
+
+	if a.prop.BackgroundColor != nil {
+		fmt.Fprintf(&content, "q\n%s\n%sf\nQ\n", getFillColor(a.prop.BackgroundColor), a.getCircle(radius))
+	}
+
+	if a.borderWidth > 0 {
+		fmt.Fprintf(&content, "q\n%s\n%s w\n%sS\nQ\n", getStrokeColor(a.prop.BorderColor), formatNumber(a.borderWidth),
+			a.getCircle(radius))
+	}
+
+	if selected {
+		fmt.Fprintf(&content, "q\n%s\n%sf\nQ\n", getFillColor(a.prop.Color), a.getCircle(radius*radioDotPercent))
+	}
+
+	return content.Bytes()
+}
+
+func (a *appearance) writeBox(content *bytes.Buffer) {
+	if a.prop.BackgroundColor != nil {
+		fmt.Fprintf(content, "q\n%s\n0 0 %s %s re f\nQ\n", getFillColor(a.prop.BackgroundColor),
+			formatNumber(a.width), formatNumber(a.height))
+	}
+
+	if a.borderWidth > 0 {
+		half := a.borderWidth / 2
+		fmt.Fprintf(content, "q\n%s\n%s w\n%s %s %s %s re S\nQ\n", getStrokeColor(a.prop.BorderColor),
+			formatNumber(a.borderWidth), formatNumber(half), formatNumber(half),
+			formatNumber(a.width-a.borderWidth), formatNumber(a.height-a.borderWidth))
+	}
+}
+
+// getCircle returns the path of a circle in the center of the widget, made of four bezier curves.
+func (a *appearance) getCircle(radius float64) string {
+	cx, cy := a.width/2, a.height/2
+	k := radius * bezierCircle
+
+	var path strings.Builder
+	fmt.Fprintf(&path, "%s %s m\n", formatNumber(cx+radius), formatNumber(cy))
+	curves := [][6]float64{
+		{cx + radius, cy + k, cx + k, cy + radius, cx, cy + radius},
+		{cx - k, cy + radius, cx - radius, cy + k, cx - radius, cy},
+		{cx - radius, cy - k, cx - k, cy - radius, cx, cy - radius},
+		{cx + k, cy - radius, cx + radius, cy - k, cx + radius, cy},
+	}
+	for _, c := range curves {
+		fmt.Fprintf(&path, "%s %s %s %s %s %s c\n", formatNumber(c[0]), formatNumber(c[1]), formatNumber(c[2]),
+			formatNumber(c[3]), formatNumber(c[4]), formatNumber(c[5]))
+	}
+
+	return path.String()
+}
+
+// breakLines breaks the text in the line breaks and in the spaces between the words that do not fit the width.
+func breakLines(text string, size, width float64) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for _, word := range strings.Split(paragraph, " ") {
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+
+			if line != "" && getTextWidth(candidate, size) > width {
+				lines = append(lines, line)
+				candidate = word
+			}
+			line = candidate
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// getTextWidth returns the width in points of a text encoded in WinAnsi.
+func getTextWidth(text string, size float64) float64 {
+	return font.TextWidth(text, helvetica, 1000) * size / 1000
+}
+
+// encodeWinAnsi encodes the text in the encoding of the Helvetica font of the form, the characters which are not
+// supported are replaced by a question mark.
+func encodeWinAnsi(text string) string {
+	var encoded strings.Builder
+	for _, r := range text {
+		b, ok := charmap.Windows1252.EncodeRune(r)
+		if !ok {
+			b = '?'
+		}
+		encoded.WriteByte(b)
+	}
+
+	return encoded.String()
+}
+
+func getFillColor(color *props.Color) string {
+	return getColor(color) + " rg"
+}
+
+func getStrokeColor(color *props.Color) string {
+	return getColor(color) + " RG"
+}
+
+func getColor(color *props.Color) string {
+	return fmt.Sprintf("%s %s %s", formatNumber(float64(color.Red)/255), formatNumber(float64(color.Green)/255),
+		formatNumber(float64(color.Blue)/255))
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(math.Round(value*1000)/1000, 'f', -1, 64)
+}
@@ -0,0 +1,79 @@
+package acroform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestAppearance_Text(t *testing.T) {
+	t.Run("when field is single line and centered, should write the value in the middle", func(t *testing.T) {
+		// Arrange
+		prop := props.FormField{Align: align.Center}
+		prop.MakeValid()
+		sut := newAppearance(100, 20, &prop)
+
+		// Act
+		content := string(sut.text("ab"))
+
+		// Assert
+		assert.Contains(t, content, "/Tx BMC")
+		assert.Contains(t, content, "/Helv 10 Tf")
+		assert.Contains(t, content, "1 0 0 1 44.44 6.41 Tm (ab) Tj")
+	})
+	t.Run("when field is multi-line, should write a line for each line of the value", func(t *testing.T) {
+		// Arrange
+		prop := props.FormField{MultiLine: true}
+		prop.MakeValid()
+		sut := newAppearance(100, 40, &prop)
+
+		// Act
+		content := string(sut.text("first\nsecond"))
+
+		// Assert
+		assert.Contains(t, content, "(first) Tj")
+		assert.Contains(t, content, "(second) Tj")
+	})
+}
+
+func TestBreakLines(t *testing.T) {
+	t.Run("when words do not fit the width, should break in the spaces", func(t *testing.T) {
+		// Act
+		lines := breakLines("one two three", 10, 40)
+
+		// Assert
+		assert.Equal(t, []string{"one two", "three"}, lines)
+	})
+	t.Run("when there are line breaks, should keep them", func(t *testing.T) {
+		// Act
+		lines := breakLines("one\ntwo", 10, 100)
+
+		// Assert
+		assert.Equal(t, []string{"one", "two"}, lines)
+	})
+}
+
+func TestEncodeWinAnsi(t *testing.T) {
+	t.Run("when character is supported, should encode it in one byte", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, "Jo\xe3o", encodeWinAnsi("João"))
+	})
+	t.Run("when character is not supported, should replace it", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, "a?", encodeWinAnsi("a中"))
+	})
+}
+
+func TestGetStateName(t *testing.T) {
+	t.Run("when option has only letters and digits, should keep it", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, "Premium2", getStateName("Premium2"))
+	})
+	t.Run("when option has spaces, should encode them", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, "Premium#20plan", getStateName("Premium plan"))
+	})
+}
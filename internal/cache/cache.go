@@ -2,10 +2,10 @@ package cache
 
 import (
 	"errors"
-	"os"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/loader"
 )
 
 // Cache is the interface to cache images.
@@ -18,19 +18,25 @@ type Cache interface {
 type cache struct {
 	images map[string]*entity.Image
 	codes  map[string][]byte
+	loader loader.Loader
 }
 
-// New is responsible to create a new Cache.
-func New() Cache {
+// New is responsible to create a new Cache, files are read from the operating system when the loader is nil.
+func New(fileLoader loader.Loader) Cache {
+	if fileLoader == nil {
+		fileLoader = loader.New()
+	}
+
 	return &cache{
 		images: make(map[string]*entity.Image),
 		codes:  make(map[string][]byte),
+		loader: fileLoader,
 	}
 }
 
-// LoadImage loads an image from a file.
+// LoadImage loads an image from a file through the loader.
 func (c *cache) LoadImage(file string, extension extension.Type) error {
-	imageBytes, err := os.ReadFile(file)
+	imageBytes, err := c.loader.Load(file)
 	if err != nil {
 		return err
 	}
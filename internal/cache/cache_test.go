@@ -1,6 +1,7 @@
 package cache_test
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -8,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/johnfercher/maroto/v2/internal/cache"
+	"github.com/johnfercher/maroto/v2/mocks"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
@@ -17,7 +19,7 @@ import (
 
 func TestNew(t *testing.T) {
 	// Act
-	sut := cache.New()
+	sut := cache.New(nil)
 
 	// Assert
 	assert.NotNil(t, sut)
@@ -27,7 +29,7 @@ func TestNew(t *testing.T) {
 func TestCache_GetImage(t *testing.T) {
 	t.Run("when cannot get image, should return error", func(t *testing.T) {
 		// Arrange
-		sut := cache.New()
+		sut := cache.New(nil)
 
 		// Act
 		img, err := sut.GetImage("image", extension.Jpg)
@@ -38,7 +40,7 @@ func TestCache_GetImage(t *testing.T) {
 	})
 	t.Run("when can get image, should return image", func(t *testing.T) {
 		// Arrange
-		sut := cache.New()
+		sut := cache.New(nil)
 		sut.AddImage("image", &entity.Image{
 			Extension: extension.Jpg,
 		})
@@ -55,7 +57,7 @@ func TestCache_GetImage(t *testing.T) {
 func TestCache_AddImage(t *testing.T) {
 	t.Run("when add image, return works", func(t *testing.T) {
 		// Arrange
-		sut := cache.New()
+		sut := cache.New(nil)
 
 		// Act
 		sut.AddImage("image", &entity.Image{
@@ -72,7 +74,7 @@ func TestCache_AddImage(t *testing.T) {
 func TestCache_LoadImage(t *testing.T) {
 	t.Run("when cannot find image, should return error", func(t *testing.T) {
 		// Arrange
-		sut := cache.New()
+		sut := cache.New(nil)
 
 		// Act
 		err := sut.LoadImage("image", extension.Jpg)
@@ -82,7 +84,7 @@ func TestCache_LoadImage(t *testing.T) {
 	})
 	t.Run("when can find image, should not return error and find image", func(t *testing.T) {
 		// Arrange
-		sut := cache.New()
+		sut := cache.New(nil)
 
 		// Act
 		err := sut.LoadImage(buildPath("/docs/assets/images/biplane.jpg"), extension.Jpg)
@@ -93,6 +95,33 @@ func TestCache_LoadImage(t *testing.T) {
 		assert.Nil(t, err)
 		assert.NotNil(t, img)
 	})
+	t.Run("when loader is sent, should read image from loader", func(t *testing.T) {
+		// Arrange
+		loader := mocks.NewLoader(t)
+		loader.EXPECT().Load("s3://bucket/logo.png").Return([]byte{1, 2, 3}, nil)
+		sut := cache.New(loader)
+
+		// Act
+		err := sut.LoadImage("s3://bucket/logo.png", extension.Png)
+
+		// Assert
+		assert.Nil(t, err)
+		img, err := sut.GetImage("s3://bucket/logo.png", extension.Png)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{1, 2, 3}, img.Bytes)
+	})
+	t.Run("when loader returns error, should return error", func(t *testing.T) {
+		// Arrange
+		loader := mocks.NewLoader(t)
+		loader.EXPECT().Load("s3://bucket/logo.png").Return(nil, errors.New("timeout"))
+		sut := cache.New(loader)
+
+		// Act
+		err := sut.LoadImage("s3://bucket/logo.png", extension.Png)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
 }
 
 func buildPath(file string) string {
@@ -0,0 +1,53 @@
+// Package caption implements the text drawn below images and codes.
+package caption
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// MakeValid copies the caption, so the prop sent by the user is not changed, and applies the default font.
+func MakeValid(prop *props.Rect, config *entity.Config) {
+	if prop.Caption == nil || config == nil || config.DefaultFont == nil {
+		return
+	}
+
+	caption := *prop.Caption
+	caption.Prop.MakeValid(config.DefaultFont)
+	prop.Caption = &caption
+}
+
+// GetHeight returns the height of the caption lines with its paddings.
+func GetHeight(provider core.Provider, caption *props.Caption, width float64) float64 {
+	if caption == nil || caption.Value == "" {
+		return 0
+	}
+
+	prop := &caption.Prop
+	amountLines := provider.GetLinesQuantity(caption.Value, prop, width-prop.Left-prop.Right)
+	fontHeight := provider.GetFontHeight(&props.Font{Family: prop.Family, Style: prop.Style, Size: prop.Size, Color: prop.Color})
+	textHeight := float64(amountLines)*fontHeight + float64(amountLines-1)*prop.VerticalPadding
+	return textHeight + prop.Top + prop.Bottom
+}
+
+// Render renders the image in the cell area above the caption, and the caption at the bottom of the cell.
+func Render(provider core.Provider, cell *entity.Cell, caption *props.Caption, renderImage func(cell *entity.Cell)) {
+	captionHeight := GetHeight(provider, caption, cell.Width)
+	if captionHeight == 0 {
+		renderImage(cell)
+		return
+	}
+
+	imageCell := cell.Copy()
+	imageCell.Height -= captionHeight
+	renderImage(&imageCell)
+
+	captionCell := &entity.Cell{
+		X:      cell.X,
+		Y:      cell.Y + imageCell.Height,
+		Width:  cell.Width,
+		Height: captionHeight,
+	}
+	provider.AddText(caption.Value, captionCell, &caption.Prop)
+}
@@ -0,0 +1,175 @@
+package code
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	libBarcode "github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/codabar"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/code39"
+	"github.com/boombuler/barcode/code93"
+	"github.com/boombuler/barcode/ean"
+	"github.com/boombuler/barcode/twooffive"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/payload"
+)
+
+// code39Charset is the set of characters accepted by Code39 and Code93 without the full ASCII mode.
+const code39Charset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ-. $/+%"
+
+const (
+	codabarStartStop = "ABCD"
+	codabarCharset   = "0123456789-$:/.+"
+)
+
+type barcodeEncoder func(code string) (libBarcode.Barcode, error)
+
+func getBarcodeClosure(barcodeType barcode.Type) barcodeEncoder {
+	switch barcodeType {
+	case barcode.Code128, "":
+		return encodeCode128
+	case barcode.EAN:
+		return encodeEAN
+	case barcode.EAN8:
+		return encodeEAN8
+	case barcode.UPCA:
+		return encodeUPCA
+	case barcode.UPCE:
+		return encodeUPCE
+	case barcode.Code39:
+		return encodeCode39
+	case barcode.Code93:
+		return encodeCode93
+	case barcode.Codabar:
+		return encodeCodabar
+	case barcode.I2of5:
+		return encodeI2of5
+	case barcode.GS1128:
+		return encodeGS1128
+	case barcode.Boleto:
+		return encodeBoleto
+	default:
+		return func(string) (libBarcode.Barcode, error) {
+			return nil, fmt.Errorf("barcode type %s is not supported", barcodeType)
+		}
+	}
+}
+
+// GetHumanReadable returns the value printed below the bars of a barcode. EAN and UPC codes sent without
+// the check digit have it calculated, so the printed value matches the encoded one, and boletos print
+// their digitable line.
+func GetHumanReadable(code string, barcodeType barcode.Type) string {
+	if !isDigits(code) {
+		return code
+	}
+
+	switch barcodeType {
+	case barcode.EAN, barcode.EAN8:
+		if len(code) == 7 || len(code) == 12 {
+			return code + string(getCheckDigit(code))
+		}
+	case barcode.UPCA:
+		if len(code) == 11 {
+			return code + string(getCheckDigit(code))
+		}
+	case barcode.Boleto:
+		if line, err := payload.GetBoletoDigitableLine(code); err == nil {
+			return line
+		}
+	case barcode.UPCE:
+		if len(code) == 6 {
+			code = "0" + code
+		}
+		if len(code) == 7 {
+			return code + string(getCheckDigit(expandUPCE(code)))
+		}
+	}
+
+	return code
+}
+
+func encodeCode128(code string) (libBarcode.Barcode, error) {
+	return code128.Encode(code)
+}
+
+func encodeEAN(code string) (libBarcode.Barcode, error) {
+	return ean.Encode(code)
+}
+
+func encodeEAN8(code string) (libBarcode.Barcode, error) {
+	if !isDigits(code) || (len(code) != 7 && len(code) != 8) {
+		return nil, errors.New("ean8 must have 7 digits or 8 digits with the check digit")
+	}
+
+	return ean.Encode(code)
+}
+
+// encodeUPCA encodes the UPC-A as an EAN-13 with a leading zero, since both share the same bars.
+func encodeUPCA(code string) (libBarcode.Barcode, error) {
+	if !isDigits(code) || (len(code) != 11 && len(code) != 12) {
+		return nil, errors.New("upca must have 11 digits or 12 digits with the check digit")
+	}
+
+	return ean.Encode("0" + code)
+}
+
+func encodeCode39(code string) (libBarcode.Barcode, error) {
+	if code == "" || !containsOnly(code, code39Charset) {
+		return nil, errors.New("code39 only accepts digits, uppercase letters, space and - . $ / + %")
+	}
+
+	return code39.Encode(code, false, false)
+}
+
+func encodeCode93(code string) (libBarcode.Barcode, error) {
+	if code == "" || !containsOnly(code, code39Charset) {
+		return nil, errors.New("code93 only accepts digits, uppercase letters, space and - . $ / + %")
+	}
+
+	return code93.Encode(code, true, false)
+}
+
+func encodeCodabar(code string) (libBarcode.Barcode, error) {
+	if len(code) < 3 || !containsOnly(code[:1], codabarStartStop) || !containsOnly(code[len(code)-1:], codabarStartStop) {
+		return nil, errors.New("codabar must start and end with one of A, B, C or D")
+	}
+
+	if !containsOnly(code[1:len(code)-1], codabarCharset) {
+		return nil, errors.New("codabar only accepts digits and - $ : / . + between start and stop characters")
+	}
+
+	return codabar.Encode(code)
+}
+
+func encodeI2of5(code string) (libBarcode.Barcode, error) {
+	if code == "" || !isDigits(code) || len(code)%2 != 0 {
+		return nil, errors.New("i2of5 must have an even amount of digits")
+	}
+
+	return twooffive.Encode(code, true)
+}
+
+// encodeBoleto checks the 44 digits of a boleto with its check digit and encodes them as Interleaved 2 of 5.
+func encodeBoleto(code string) (libBarcode.Barcode, error) {
+	if _, err := payload.GetBoletoDigitableLine(code); err != nil {
+		return nil, err
+	}
+
+	return twooffive.Encode(code, true)
+}
+
+func isDigits(code string) bool {
+	return code != "" && containsOnly(code, "0123456789")
+}
+
+func containsOnly(code, charset string) bool {
+	for _, r := range code {
+		if !strings.ContainsRune(charset, r) {
+			return false
+		}
+	}
+	return true
+}
@@ -2,17 +2,16 @@ package code
 
 import (
 	"bytes"
+	"errors"
 	"image"
 	"image/color/palette"
 	"image/draw"
 	"image/png"
 
-	"github.com/boombuler/barcode/code128"
-	"github.com/boombuler/barcode/ean"
-	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
-
 	libBarcode "github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/aztec"
 	"github.com/boombuler/barcode/datamatrix"
+	"github.com/boombuler/barcode/pdf417"
 	"github.com/boombuler/barcode/qr"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
@@ -36,8 +35,8 @@ func New() *code {
 }
 
 // GenDataMatrix is responsible to generate a data matrix byte array.
-func (c *code) GenDataMatrix(code string) (*entity.Image, error) {
-	dataMatrix, err := datamatrix.Encode(code)
+func (c *code) GenDataMatrix(code string, prop *props.Rect) (*entity.Image, error) {
+	dataMatrix, err := getDataMatrix(code, prop)
 	if err != nil {
 		return nil, err
 	}
@@ -46,13 +45,37 @@ func (c *code) GenDataMatrix(code string) (*entity.Image, error) {
 }
 
 // GenQr is responsible to generate a qr code byte array.
-func (c *code) GenQr(code string) (*entity.Image, error) {
-	qrCode, err := qr.Encode(code, qr.M, qr.Auto)
+func (c *code) GenQr(code string, prop *props.QrCode) (*entity.Image, error) {
+	qrCode, err := qr.Encode(code, getQrLevel(prop), qr.Auto)
+	if err != nil {
+		return nil, err
+	}
+
+	if prop == nil || (prop.QuietZone == 0 && prop.Color == nil && prop.BackgroundColor == nil && prop.Logo == nil) {
+		return c.getImage(qrCode)
+	}
+
+	return c.getStyledQrImage(qrCode, prop)
+}
+
+// GenPDF417 is responsible to generate a pdf417 code byte array.
+func (c *code) GenPDF417(code string, prop *props.PDF417) (*entity.Image, error) {
+	pdf417Code, err := pdf417.Encode(code, byte(prop.ErrorCorrectionLevel))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getImage(pdf417Code)
+}
+
+// GenAztec is responsible to generate an aztec code byte array.
+func (c *code) GenAztec(code string, prop *props.Aztec) (*entity.Image, error) {
+	aztecCode, err := aztec.Encode([]byte(code), prop.ErrorCorrectionPercent, aztec.DEFAULT_LAYERS)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.getImage(qrCode)
+	return c.getImage(aztecCode)
 }
 
 // GenBar is responsible to generate a barcode byte array.
@@ -76,13 +99,57 @@ func (c *code) GenBar(code string, _ *entity.Cell, prop *props.Barcode) (*entity
 	return c.getImage(scaledBarCode)
 }
 
-func getBarcodeClosure(barcodeType barcode.Type) func(code string) (libBarcode.BarcodeIntCS, error) {
-	switch barcodeType {
-	case barcode.EAN:
-		return ean.Encode
-	default:
-		return code128.Encode
+// GenVectorDataMatrix is responsible to generate a data matrix drawn with vector rectangles.
+func (c *code) GenVectorDataMatrix(code string, prop *props.Rect) (*entity.Image, error) {
+	dataMatrix, err := getDataMatrix(code, prop)
+	if err != nil {
+		return nil, err
 	}
+
+	return getVectorImage(dataMatrix, 1, 0, nil, nil), nil
+}
+
+// GenVectorQr is responsible to generate a qr code drawn with vector rectangles, logos are not supported.
+func (c *code) GenVectorQr(code string, prop *props.QrCode) (*entity.Image, error) {
+	if prop != nil && prop.Logo != nil {
+		return nil, errors.New("vector qrcode does not support logo")
+	}
+
+	qrCode, err := qr.Encode(code, getQrLevel(prop), qr.Auto)
+	if err != nil {
+		return nil, err
+	}
+
+	if prop == nil {
+		return getVectorImage(qrCode, 1, 0, nil, nil), nil
+	}
+
+	return getVectorImage(qrCode, 1, prop.QuietZone, prop.Color, prop.BackgroundColor), nil
+}
+
+// GenVectorBar is responsible to generate a barcode drawn with vector rectangles.
+func (c *code) GenVectorBar(code string, prop *props.Barcode) (*entity.Image, error) {
+	barcodeGen := getBarcodeClosure(prop.Type)
+
+	barCode, err := barcodeGen(code)
+	if err != nil {
+		return nil, err
+	}
+
+	// The height follows the proportion, 1D barcodes have a single row of modules.
+	width := float64(barCode.Bounds().Dx())
+	height := width * prop.Proportion.Height / prop.Proportion.Width / float64(barCode.Bounds().Dy())
+
+	return getVectorImage(barCode, height, 0, nil, nil), nil
+}
+
+// getDataMatrix encodes a data matrix, GS1 codes are encoded by encodeGS1DataMatrix since they start with FNC1.
+func getDataMatrix(code string, prop *props.Rect) (image.Image, error) {
+	if prop != nil && prop.GS1 {
+		return encodeGS1DataMatrix(code)
+	}
+
+	return datamatrix.Encode(code)
 }
 
 func (c *code) getImage(img image.Image) (*entity.Image, error) {
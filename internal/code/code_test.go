@@ -1,9 +1,17 @@
 package code_test
 
 import (
+	"bytes"
 	"fmt"
+	"image"
+	"image/color/palette"
+	"image/gif"
+	"image/png"
+	"strings"
 	"testing"
 
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+
 	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
 
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
@@ -41,7 +49,7 @@ func TestCode_GenDataMatrix(t *testing.T) {
 		data := genStringWithLength(5000)
 
 		// Act
-		bytes, err := sut.GenDataMatrix(data)
+		bytes, err := sut.GenDataMatrix(data, nil)
 
 		// Assert
 		assert.NotNil(t, err)
@@ -54,11 +62,124 @@ func TestCode_GenDataMatrix(t *testing.T) {
 		data := genStringWithLength(50)
 
 		// Act
-		bytes, err := sut.GenDataMatrix(data)
+		bytes, err := sut.GenDataMatrix(data, nil)
+
+		// Assert
+		assert.NotNil(t, bytes)
+		assert.Nil(t, err)
+	})
+}
+
+func TestCode_GenDataMatrix_GS1(t *testing.T) {
+	t.Run("When gs1 code is valid, should start the data with fnc1", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.Rect{GS1: true}
+
+		// Act
+		matrix, err := sut.GenDataMatrix("(01)09501101530003(17)260131(10)AB12", prop)
+
+		// Assert
+		assert.Nil(t, err)
+		img, _, _ := image.Decode(bytes.NewReader(matrix.Bytes))
+		assert.Equal(t, 18, img.Bounds().Dx())
+		assert.Equal(t, 18, img.Bounds().Dy())
+	})
+	t.Run("When gs1 code is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.Rect{GS1: true}
+
+		// Act
+		matrix, err := sut.GenDataMatrix("(01)09501101530004", prop)
+
+		// Assert
+		assert.EqualError(t, err, "gs1 (01) check digit does not match")
+		assert.Nil(t, matrix)
+	})
+	t.Run("When gs1 code is too long, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.Rect{GS1: true}
+		data := strings.Repeat("(91)"+strings.Repeat("A", 90), 30)
+
+		// Act
+		matrix, err := sut.GenDataMatrix(data, prop)
+
+		// Assert
+		assert.EqualError(t, err, "datamatrix has too much data to encode")
+		assert.Nil(t, matrix)
+	})
+}
+
+func TestCode_GenPDF417(t *testing.T) {
+	t.Run("When cannot generate pdf417, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.PDF417{ErrorCorrectionLevel: 8}
+		prop.MakeValid()
+
+		data := genStringWithLength(5000)
+
+		// Act
+		bytes, err := sut.GenPDF417(data, prop)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, bytes)
+	})
+	t.Run("When can generate pdf417, should return bytes", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.PDF417{}
+		prop.MakeValid()
+
+		data := genStringWithLength(50)
+
+		// Act
+		bytes, err := sut.GenPDF417(data, prop)
 
 		// Assert
 		assert.NotNil(t, bytes)
 		assert.Nil(t, err)
+		assert.Greater(t, bytes.Dimensions.Width, bytes.Dimensions.Height)
+	})
+}
+
+func TestCode_GenAztec(t *testing.T) {
+	t.Run("When cannot generate aztec, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.Aztec{}
+		prop.MakeValid()
+
+		data := genStringWithLength(5000)
+
+		// Act
+		bytes, err := sut.GenAztec(data, prop)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, bytes)
+	})
+	t.Run("When error correction is higher, should generate a bigger aztec", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		low := &props.Aztec{ErrorCorrectionPercent: 5}
+		low.MakeValid()
+		high := &props.Aztec{ErrorCorrectionPercent: 90}
+		high.MakeValid()
+
+		data := genStringWithLength(50)
+
+		// Act
+		lowBytes, lowErr := sut.GenAztec(data, low)
+		highBytes, highErr := sut.GenAztec(data, high)
+
+		// Assert
+		assert.Nil(t, lowErr)
+		assert.Nil(t, highErr)
+		assert.Greater(t, highBytes.Dimensions.Width, lowBytes.Dimensions.Width)
 	})
 }
 
@@ -134,6 +255,214 @@ func TestCode_GenBar(t *testing.T) {
 	})
 }
 
+func TestCode_GenBar_Types(t *testing.T) {
+	cell := &entity.Cell{Width: 100, Height: 100}
+
+	valid := map[barcode.Type]string{
+		barcode.EAN8:    "9638507",
+		barcode.UPCA:    "03600029145",
+		barcode.UPCE:    "06543217",
+		barcode.Code39:  "MAROTO-V2",
+		barcode.Code93:  "MAROTO V2",
+		barcode.Codabar: "A40156B",
+		barcode.I2of5:   "12345678",
+		barcode.GS1128:  "(01)09501101530003(17)260131(10)AB12",
+		barcode.Boleto:  "00193373700000001000500940144816060680935031",
+	}
+	for barcodeType, data := range valid {
+		t.Run("when "+string(barcodeType)+" code is valid, should return bytes", func(t *testing.T) {
+			// Arrange
+			sut := code.New()
+			prop := &props.Barcode{Type: barcodeType}
+			prop.MakeValid()
+
+			// Act
+			bytes, err := sut.GenBar(data, cell, prop)
+
+			// Assert
+			assert.Nil(t, err)
+			assert.NotNil(t, bytes)
+		})
+	}
+
+	invalid := map[barcode.Type]string{
+		barcode.EAN8:    "96385",
+		barcode.UPCA:    "0360002914A",
+		barcode.UPCE:    "26543217",
+		barcode.Code39:  "maroto",
+		barcode.Code93:  "maroto",
+		barcode.Codabar: "40156",
+		barcode.I2of5:   "1234567",
+		barcode.Boleto:  "00194373700000001000500940144816060680935031",
+	}
+	for barcodeType, data := range invalid {
+		t.Run("when "+string(barcodeType)+" code is invalid, should return error", func(t *testing.T) {
+			// Arrange
+			sut := code.New()
+			prop := &props.Barcode{Type: barcodeType}
+			prop.MakeValid()
+
+			// Act
+			bytes, err := sut.GenBar(data, cell, prop)
+
+			// Assert
+			assert.ErrorContains(t, err, string(barcodeType))
+			assert.Nil(t, bytes)
+		})
+	}
+	t.Run("when upce check digit does not match, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.Barcode{Type: barcode.UPCE}
+		prop.MakeValid()
+
+		// Act
+		bytes, err := sut.GenBar("06543210", cell, prop)
+
+		// Assert
+		assert.ErrorContains(t, err, "check digit")
+		assert.Nil(t, bytes)
+	})
+	t.Run("when type is not supported, should return error instead of code128", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.Barcode{Type: "pharmacode"}
+		prop.MakeValid()
+
+		// Act
+		bytes, err := sut.GenBar("123", cell, prop)
+
+		// Assert
+		assert.ErrorContains(t, err, "pharmacode")
+		assert.Nil(t, bytes)
+	})
+}
+
+func TestCode_GenBar_GS1(t *testing.T) {
+	cell := &entity.Cell{Width: 100, Height: 100}
+	invalid := map[string]string{
+		"(01)09501101530004":             "gs1 (01) check digit does not match",
+		"(01)0950110153000":              "gs1 (01) must have 14 characters",
+		"(17)261331":                     "gs1 (17) must be a date in the format YYMMDD",
+		"(10)AB 12":                      "gs1 (10) has characters outside the GS1 character set",
+		"(10)ABCDEFGHIJKLMNOPQRSTU":      "gs1 (10) must have from 1 to 20 characters",
+		"(3103)00A500":                   "gs1 (3103) only accepts digits",
+		"(01)09501101530003(88)123":      "gs1 application identifier (88) is not supported",
+		"01095011015300031726013110AB12": "gs1 application identifier must be in parentheses at \"01095011015300031726013110AB12\"",
+		"":                               "gs1 code must have at least one application identifier",
+	}
+	for data, message := range invalid {
+		t.Run("when gs1 code is "+data+", should return error", func(t *testing.T) {
+			// Arrange
+			sut := code.New()
+			prop := &props.Barcode{Type: barcode.GS1128}
+			prop.MakeValid()
+
+			// Act
+			bytes, err := sut.GenBar(data, cell, prop)
+
+			// Assert
+			assert.EqualError(t, err, message)
+			assert.Nil(t, bytes)
+		})
+	}
+	t.Run("when variable length value is not the last one, should separate it with fnc1", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.Barcode{Type: barcode.GS1128}
+		prop.MakeValid()
+
+		// Act
+		last, errLast := sut.GenVectorBar("(01)09501101530003(10)AB12", prop)
+		middle, errMiddle := sut.GenVectorBar("(10)AB12(01)09501101530003", prop)
+
+		// Assert
+		assert.Nil(t, errLast)
+		assert.Nil(t, errMiddle)
+		assert.Equal(t, last.Dimensions.Width+11, middle.Dimensions.Width)
+	})
+}
+
+func TestGetGS1Data(t *testing.T) {
+	t.Run("when variable length value is not the last one, should separate it with fnc1", func(t *testing.T) {
+		// Arrange
+		elements := []entity.GS1Element{{AI: "10", Value: "AB12"}, {AI: "01", Value: "09501101530003"}}
+
+		// Act
+		data, err := code.GetGS1Data(elements)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "\u00f110AB12\u00f10109501101530003", data)
+	})
+	t.Run("when value has parentheses, should keep the value", func(t *testing.T) {
+		// Arrange
+		elements := []entity.GS1Element{{AI: "10", Value: "AB(21)12"}, {AI: "21", Value: "(X)"}}
+
+		// Act
+		data, err := code.GetGS1Data(elements)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "\u00f110AB(21)12\u00f121(X)", data)
+	})
+	t.Run("when application identifier is not supported, should return error", func(t *testing.T) {
+		// Act
+		data, err := code.GetGS1Data([]entity.GS1Element{{AI: "88", Value: "123"}})
+
+		// Assert
+		assert.EqualError(t, err, "gs1 application identifier (88) is not supported")
+		assert.Empty(t, data)
+	})
+	t.Run("when elements are empty, should return error", func(t *testing.T) {
+		// Act
+		data, err := code.GetGS1Data(nil)
+
+		// Assert
+		assert.EqualError(t, err, "gs1 code must have at least one application identifier")
+		assert.Empty(t, data)
+	})
+	t.Run("when data is sent to the encoder, should encode it without parsing the values", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.Barcode{Type: barcode.GS1128}
+		prop.MakeValid()
+		data, _ := code.GetGS1Data([]entity.GS1Element{{AI: "10", Value: "AB(21)12"}})
+
+		// Act
+		bar, err := sut.GenVectorBar(data, prop)
+		matrix, errMatrix := sut.GenVectorDataMatrix(data, &props.Rect{GS1: true})
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, bar)
+		assert.Nil(t, errMatrix)
+		assert.NotNil(t, matrix)
+	})
+}
+
+func TestGetHumanReadable(t *testing.T) {
+	t.Run("when ean is sent without check digit, should add it", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, "7891234567895", code.GetHumanReadable("789123456789", barcode.EAN))
+		assert.Equal(t, "96385074", code.GetHumanReadable("9638507", barcode.EAN8))
+	})
+	t.Run("when upc is sent without check digit, should add it", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, "036000291452", code.GetHumanReadable("03600029145", barcode.UPCA))
+		assert.Equal(t, "06543217", code.GetHumanReadable("654321", barcode.UPCE))
+	})
+	t.Run("when boleto is valid, should return the digitable line", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, "00190.50095 40144.816069 06809.350314 3 37370000000100",
+			code.GetHumanReadable("00193373700000001000500940144816060680935031", barcode.Boleto))
+	})
+	t.Run("when code is not numeric, should return the code", func(t *testing.T) {
+		// Act & Assert
+		assert.Equal(t, "MAROTO-V2", code.GetHumanReadable("MAROTO-V2", barcode.Code39))
+	})
+}
+
 func TestCode_GenQr(t *testing.T) {
 	t.Run("When cannot generate qr code, should return error", func(t *testing.T) {
 		// Arrange
@@ -142,7 +471,7 @@ func TestCode_GenQr(t *testing.T) {
 		data := genStringWithLength(5000)
 
 		// Act
-		bytes, err := sut.GenQr(data)
+		bytes, err := sut.GenQr(data, nil)
 
 		// Assert
 		assert.NotNil(t, err)
@@ -155,7 +484,7 @@ func TestCode_GenQr(t *testing.T) {
 		data := genStringWithLength(50)
 
 		// Act
-		bytes, err := sut.GenQr(data)
+		bytes, err := sut.GenQr(data, nil)
 
 		// Assert
 		assert.NotNil(t, bytes)
@@ -163,6 +492,163 @@ func TestCode_GenQr(t *testing.T) {
 	})
 }
 
+func TestCode_GenQr_Options(t *testing.T) {
+	t.Run("When quiet zone is sent, should add the blank modules", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.QrCode{QuietZone: 4}
+		prop.MakeValid()
+
+		// Act
+		plain, _ := sut.GenQr("maroto", nil)
+		qr, err := sut.GenQr("maroto", prop)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, plain.Dimensions.Width+8, qr.Dimensions.Width)
+	})
+	t.Run("When colors are sent, should draw the modules with them", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.QrCode{Color: &props.Color{Red: 200}, BackgroundColor: &props.Color{Blue: 200}}
+		prop.MakeValid()
+
+		// Act
+		qr, err := sut.GenQr("maroto", prop)
+
+		// Assert
+		assert.Nil(t, err)
+		img, err := png.Decode(bytes.NewReader(qr.Bytes))
+		assert.Nil(t, err)
+		r, _, _, _ := img.At(0, 0).RGBA()
+		assert.Equal(t, uint32(200*257), r)
+	})
+	t.Run("When logo is valid, should draw it in the center", func(t *testing.T) {
+		// Arrange
+		var logo bytes.Buffer
+		_ = png.Encode(&logo, image.NewRGBA(image.Rect(0, 0, 4, 4)))
+		sut := code.New()
+		prop := &props.QrCode{Logo: &props.QrLogo{Bytes: logo.Bytes(), Extension: extension.Png}}
+		prop.MakeValid()
+
+		// Act
+		qr, err := sut.GenQr("maroto", prop)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotNil(t, qr)
+	})
+	t.Run("When logo is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.QrCode{Logo: &props.QrLogo{Bytes: []byte{1, 2, 3}, Extension: extension.Png}}
+		prop.MakeValid()
+
+		// Act
+		qr, err := sut.GenQr("maroto", prop)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, qr)
+	})
+	t.Run("When logo has no size, should return error", func(t *testing.T) {
+		// Arrange
+		var logo bytes.Buffer
+		_ = gif.Encode(&logo, image.NewPaletted(image.Rect(0, 0, 0, 0), palette.Plan9), nil)
+
+		sut := code.New()
+		prop := &props.QrCode{Logo: &props.QrLogo{Bytes: logo.Bytes(), Extension: extension.Png}}
+		prop.MakeValid()
+
+		// Act
+		qr, err := sut.GenQr("maroto", prop)
+
+		// Assert
+		assert.Equal(t, "qrcode logo must have width and height", err.Error())
+		assert.Nil(t, qr)
+	})
+}
+
+func TestCode_GenVectorDataMatrix(t *testing.T) {
+	t.Run("When code is valid, should return a svg with one unit per module", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+
+		// Act
+		raster, _ := sut.GenDataMatrix("maroto", nil)
+		vector, err := sut.GenVectorDataMatrix("maroto", nil)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, extension.Svg, vector.Extension)
+		assert.Equal(t, raster.Dimensions, vector.Dimensions)
+		assert.Contains(t, string(vector.Bytes), `<path fill="rgb(0,0,0)" d="M0 0h1v2h-1z`)
+	})
+}
+
+func TestCode_GenVectorQr(t *testing.T) {
+	t.Run("When options are sent, should draw the quiet zone and colors", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.QrCode{QuietZone: 2, Color: &props.RedColor, BackgroundColor: &props.WhiteColor}
+		prop.MakeValid()
+
+		// Act
+		raster, _ := sut.GenQr("maroto", nil)
+		vector, err := sut.GenVectorQr("maroto", prop)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, raster.Dimensions.Width+4, vector.Dimensions.Width)
+		assert.Contains(t, string(vector.Bytes), `fill="rgb(255,255,255)"`)
+		assert.Contains(t, string(vector.Bytes), `<path fill="rgb(255,0,0)" d="M2 2h7v1h-7z`)
+	})
+	t.Run("When logo is sent, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.QrCode{Logo: &props.QrLogo{Bytes: []byte{1}, Extension: extension.Png}}
+
+		// Act
+		vector, err := sut.GenVectorQr("maroto", prop)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, vector)
+	})
+}
+
+func TestCode_GenVectorBar(t *testing.T) {
+	t.Run("When code is valid, should draw each bar as a single rectangle", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.Barcode{Type: barcode.EAN}
+		prop.MakeValid()
+
+		// Act
+		vector, err := sut.GenVectorBar("7891234567895", prop)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 95.0, vector.Dimensions.Width)
+		assert.Equal(t, 19.0, vector.Dimensions.Height)
+		assert.Equal(t, 30, strings.Count(string(vector.Bytes), "z"))
+		assert.Contains(t, string(vector.Bytes), "M0 0h1v19h-1z")
+	})
+	t.Run("When code is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		sut := code.New()
+		prop := &props.Barcode{Type: barcode.EAN8}
+		prop.MakeValid()
+
+		// Act
+		vector, err := sut.GenVectorBar("abc", prop)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, vector)
+	})
+}
+
 func genStringWithLength(length int) string {
 	var content string
 	for i := 0; i < length; i++ {
@@ -0,0 +1,256 @@
+package code
+
+import (
+	"errors"
+	"image"
+	"image/color"
+
+	"github.com/boombuler/barcode/utils"
+)
+
+// The boombuler datamatrix encoder does not write FNC1, which GS1 DataMatrix requires, so GS1 codes are
+// encoded here. Only the ASCII encodation and the square ECC 200 sizes are supported.
+
+const (
+	dataMatrixFNC1 = 232
+	dataMatrixPad  = 129
+)
+
+// dataMatrixSize is a square ECC 200 symbol with its data regions, error correction codewords and blocks.
+type dataMatrixSize struct {
+	size, regions, ecc, blocks int
+}
+
+var dataMatrixSizes = []dataMatrixSize{
+	{10, 1, 5, 1}, {12, 1, 7, 1}, {14, 1, 10, 1}, {16, 1, 12, 1}, {18, 1, 14, 1}, {20, 1, 18, 1},
+	{22, 1, 20, 1}, {24, 1, 24, 1}, {26, 1, 28, 1}, {32, 2, 36, 1}, {36, 2, 42, 1}, {40, 2, 48, 1},
+	{44, 2, 56, 1}, {48, 2, 68, 1}, {52, 2, 84, 2}, {64, 4, 112, 2}, {72, 4, 144, 4}, {80, 4, 192, 4},
+	{88, 4, 224, 4}, {96, 4, 272, 4}, {104, 4, 336, 6}, {120, 6, 408, 6}, {132, 6, 496, 8}, {144, 6, 620, 10},
+}
+
+var dataMatrixRS = utils.NewReedSolomonEncoder(utils.NewGaloisField(301, 256, 1))
+
+func (s dataMatrixSize) regionSize() int {
+	return (s.size - 2*s.regions) / s.regions
+}
+
+func (s dataMatrixSize) matrixSize() int {
+	return s.regionSize() * s.regions
+}
+
+func (s dataMatrixSize) dataCodewords() int {
+	return s.matrixSize()*s.matrixSize()/8 - s.ecc
+}
+
+// encodeGS1DataMatrix encodes a GS1 code as a DataMatrix starting with FNC1.
+func encodeGS1DataMatrix(code string) (image.Image, error) {
+	data, err := getGS1Data(code)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeDataMatrix(getDataMatrixCodewords(data))
+}
+
+// getDataMatrixCodewords encodes the data with the ASCII encodation, pairs of digits share a codeword.
+func getDataMatrixCodewords(data string) []int {
+	runes := []rune(data)
+	var codewords []int
+
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case runes[i] == gs1FNC1:
+			codewords = append(codewords, dataMatrixFNC1)
+		case isDigit(runes[i]) && i+1 < len(runes) && isDigit(runes[i+1]):
+			codewords = append(codewords, 130+int(runes[i]-'0')*10+int(runes[i+1]-'0'))
+			i++
+		default:
+			codewords = append(codewords, int(runes[i])+1)
+		}
+	}
+
+	return codewords
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func encodeDataMatrix(codewords []int) (image.Image, error) {
+	var size *dataMatrixSize
+	for i := range dataMatrixSizes {
+		if dataMatrixSizes[i].dataCodewords() >= len(codewords) {
+			size = &dataMatrixSizes[i]
+			break
+		}
+	}
+
+	if size == nil {
+		return nil, errors.New("datamatrix has too much data to encode")
+	}
+
+	codewords = addDataMatrixPadding(codewords, size.dataCodewords())
+	codewords = addDataMatrixECC(codewords, size)
+
+	return renderDataMatrix(placeDataMatrix(codewords, size.matrixSize()), size), nil
+}
+
+// addDataMatrixPadding fills the symbol capacity with the pad codeword, randomized after the first one.
+func addDataMatrixPadding(codewords []int, capacity int) []int {
+	if len(codewords) < capacity {
+		codewords = append(codewords, dataMatrixPad)
+	}
+
+	for len(codewords) < capacity {
+		random := (149*(len(codewords)+1))%253 + 1
+		codewords = append(codewords, (dataMatrixPad+random)%254)
+	}
+
+	return codewords
+}
+
+// addDataMatrixECC appends the error correction codewords, the codewords are interleaved between the blocks.
+func addDataMatrixECC(codewords []int, size *dataMatrixSize) []int {
+	dataCount := len(codewords)
+	eccPerBlock := size.ecc / size.blocks
+	result := append(codewords, make([]int, size.ecc)...)
+
+	for block := 0; block < size.blocks; block++ {
+		var blockData []int
+		for i := block; i < dataCount; i += size.blocks {
+			blockData = append(blockData, codewords[i])
+		}
+
+		for i, ecc := range dataMatrixRS.Encode(blockData, eccPerBlock) {
+			result[dataCount+block+i*size.blocks] = ecc
+		}
+	}
+
+	return result
+}
+
+// dataMatrixPlacement places the codewords in the data regions following the ECC 200 diagonal pattern.
+type dataMatrixPlacement struct {
+	size    int
+	modules []bool
+	placed  []bool
+}
+
+func placeDataMatrix(codewords []int, size int) []bool {
+	p := &dataMatrixPlacement{size: size, modules: make([]bool, size*size), placed: make([]bool, size*size)}
+	index := 0
+	next := func() int {
+		codeword := codewords[index]
+		index++
+		return codeword
+	}
+
+	row, col := 4, 0
+	for row < size || col < size {
+		if row == size && col == 0 {
+			p.corner(next(), [8][2]int{{size - 1, 0}, {size - 1, 1}, {size - 1, 2}, {0, size - 2}, {0, size - 1},
+				{1, size - 1}, {2, size - 1}, {3, size - 1}})
+		}
+		if row == size-2 && col == 0 && size%4 != 0 {
+			p.corner(next(), [8][2]int{{size - 3, 0}, {size - 2, 0}, {size - 1, 0}, {0, size - 4}, {0, size - 3},
+				{0, size - 2}, {0, size - 1}, {1, size - 1}})
+		}
+		if row == size-2 && col == 0 && size%8 == 4 {
+			p.corner(next(), [8][2]int{{size - 3, 0}, {size - 2, 0}, {size - 1, 0}, {0, size - 2}, {0, size - 1},
+				{1, size - 1}, {2, size - 1}, {3, size - 1}})
+		}
+		if row == size+4 && col == 2 && size%8 == 0 {
+			p.corner(next(), [8][2]int{{size - 1, 0}, {size - 1, size - 1}, {0, size - 3}, {0, size - 2},
+				{0, size - 1}, {1, size - 3}, {1, size - 2}, {1, size - 1}})
+		}
+
+		for {
+			if row < size && col >= 0 && !p.placed[row*size+col] {
+				p.utah(row, col, next())
+			}
+			row, col = row-2, col+2
+			if row < 0 || col >= size {
+				break
+			}
+		}
+		row, col = row+1, col+3
+
+		for {
+			if row >= 0 && col < size && !p.placed[row*size+col] {
+				p.utah(row, col, next())
+			}
+			row, col = row+2, col-2
+			if row >= size || col < 0 {
+				break
+			}
+		}
+		row, col = row+3, col+1
+	}
+
+	// The bottom right corner is fixed when it is not used by the codewords.
+	if !p.placed[size*size-1] {
+		p.modules[size*size-1] = true
+		p.modules[(size-2)*size+size-2] = true
+	}
+
+	return p.modules
+}
+
+// utah places the eight bits of a codeword in the standard shape, which has its last bit at row and col.
+func (p *dataMatrixPlacement) utah(row, col, codeword int) {
+	p.corner(codeword, [8][2]int{{row - 2, col - 2}, {row - 2, col - 1}, {row - 1, col - 2}, {row - 1, col - 1},
+		{row - 1, col}, {row, col - 2}, {row, col - 1}, {row, col}})
+}
+
+// corner places the bits of a codeword, from the most significant one, in the positions sent.
+func (p *dataMatrixPlacement) corner(codeword int, positions [8][2]int) {
+	for bit, position := range positions {
+		row, col := position[0], position[1]
+		if row < 0 {
+			row += p.size
+			col += 4 - (p.size+4)%8
+		}
+		if col < 0 {
+			col += p.size
+			row += 4 - (p.size+4)%8
+		}
+
+		p.placed[row*p.size+col] = true
+		p.modules[row*p.size+col] = codeword&(1<<(7-bit)) != 0
+	}
+}
+
+// renderDataMatrix surrounds each data region with the finder pattern, solid at the left and bottom sides
+// and alternating at the top and right sides.
+func renderDataMatrix(modules []bool, size *dataMatrixSize) image.Image {
+	img := image.NewGray(image.Rect(0, 0, size.size, size.size))
+	regionSize := size.regionSize()
+
+	for y := 0; y < size.size; y++ {
+		for x := 0; x < size.size; x++ {
+			regionX, regionY := x%(regionSize+2), y%(regionSize+2)
+
+			var dark bool
+			switch {
+			case regionX == 0 || regionY == regionSize+1:
+				dark = true
+			case regionY == 0:
+				dark = x%2 == 0
+			case regionX == regionSize+1:
+				dark = y%2 == 1
+			default:
+				matrixX := x/(regionSize+2)*regionSize + regionX - 1
+				matrixY := y/(regionSize+2)*regionSize + regionY - 1
+				dark = modules[matrixY*size.matrixSize()+matrixX]
+			}
+
+			if dark {
+				img.SetGray(x, y, color.Gray{Y: 0})
+			} else {
+				img.SetGray(x, y, color.Gray{Y: 255})
+			}
+		}
+	}
+
+	return img
+}
@@ -0,0 +1,245 @@
+package code
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	libBarcode "github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+)
+
+// gs1Charset is the set of characters accepted by alphanumeric GS1 values (GS1 AI encodable character set 82).
+const gs1Charset = "!\"%&'()*+,-./0123456789:;<=>?ABCDEFGHIJKLMNOPQRSTUVWXYZ_abcdefghijklmnopqrstuvwxyz"
+
+// gs1FNC1 marks the start of a GS1 code and the end of variable length values.
+const gs1FNC1 = code128.FNC1
+
+// gs1AI is the format of the value of an application identifier.
+type gs1AI struct {
+	// length is the length of a fixed length value or the maximum length of a variable length value.
+	length     int
+	variable   bool
+	numeric    bool
+	checkDigit bool
+	date       bool
+}
+
+var gs1Date = gs1AI{length: 6, numeric: true, date: true}
+
+func gs1Numeric(length int) gs1AI {
+	return gs1AI{length: length, numeric: true}
+}
+
+func gs1CheckDigit(length int) gs1AI {
+	return gs1AI{length: length, numeric: true, checkDigit: true}
+}
+
+func gs1VarNumeric(length int) gs1AI {
+	return gs1AI{length: length, variable: true, numeric: true}
+}
+
+func gs1Alphanumeric(length int) gs1AI {
+	return gs1AI{length: length, variable: true}
+}
+
+// gs1AIs are the formats of the supported application identifiers, the measures (31nn to 36nn and 39nn)
+// have a decimal point indicator as the last digit and are resolved by getGS1AI.
+var gs1AIs = map[string]gs1AI{
+	"00": gs1CheckDigit(18), "01": gs1CheckDigit(14), "02": gs1CheckDigit(14),
+	"10": gs1Alphanumeric(20), "11": gs1Date, "12": gs1Date, "13": gs1Date, "15": gs1Date, "16": gs1Date, "17": gs1Date,
+	"20": gs1Numeric(2), "21": gs1Alphanumeric(20), "22": gs1Alphanumeric(20), "235": gs1Alphanumeric(28),
+	"240": gs1Alphanumeric(30), "241": gs1Alphanumeric(30), "242": gs1VarNumeric(6),
+	"250": gs1Alphanumeric(30), "251": gs1Alphanumeric(30), "254": gs1Alphanumeric(20),
+	"30": gs1VarNumeric(8), "37": gs1VarNumeric(8),
+	"400": gs1Alphanumeric(30), "401": gs1Alphanumeric(30), "402": gs1CheckDigit(17), "403": gs1Alphanumeric(30),
+	"410": gs1CheckDigit(13), "411": gs1CheckDigit(13), "412": gs1CheckDigit(13), "413": gs1CheckDigit(13),
+	"414": gs1CheckDigit(13), "415": gs1CheckDigit(13), "416": gs1CheckDigit(13), "417": gs1CheckDigit(13),
+	"420": gs1Alphanumeric(20), "421": gs1Alphanumeric(12), "422": gs1Numeric(3), "423": gs1VarNumeric(15),
+	"424": gs1Numeric(3), "425": gs1VarNumeric(15), "426": gs1Numeric(3),
+	"7003": gs1Numeric(10), "8004": gs1Alphanumeric(30), "8005": gs1Numeric(6), "8008": gs1VarNumeric(12),
+	"8018": gs1CheckDigit(18), "8020": gs1Alphanumeric(25), "90": gs1Alphanumeric(30),
+}
+
+// gs1PredefinedLengths are the prefixes of the application identifiers with a predefined length,
+// their values are not followed by FNC1 even when other elements come after them.
+var gs1PredefinedLengths = []string{
+	"00", "01", "02", "03", "04", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20",
+	"31", "32", "33", "34", "35", "36", "41",
+}
+
+func getGS1AI(ai string) (gs1AI, bool) {
+	if format, ok := gs1AIs[ai]; ok {
+		return format, true
+	}
+
+	if len(ai) == 2 && ai >= "91" && ai <= "99" {
+		return gs1Alphanumeric(90), true
+	}
+
+	if len(ai) != 4 || !isDigits(ai) {
+		return gs1AI{}, false
+	}
+
+	switch {
+	case ai[:3] >= "310" && ai[:3] <= "316", ai[:3] >= "320" && ai[:3] <= "369":
+		return gs1Numeric(6), true
+	case ai[:3] == "390" || ai[:3] == "392":
+		return gs1VarNumeric(15), true
+	case ai[:3] == "391" || ai[:3] == "393":
+		return gs1VarNumeric(18), true
+	}
+
+	return gs1AI{}, false
+}
+
+// parseGS1 reads a GS1 code written with the application identifiers in parentheses, ex: (01)09501101530003(10)AB12.
+func parseGS1(code string) ([]entity.GS1Element, error) {
+	var elements []entity.GS1Element
+	for code != "" {
+		ai, length := getGS1AIPrefix(code)
+		if ai == "" {
+			return nil, fmt.Errorf("gs1 application identifier must be in parentheses at %q", code)
+		}
+
+		code = code[length:]
+		end := getGS1ValueEnd(code)
+		elements = append(elements, entity.GS1Element{AI: ai, Value: code[:end]})
+		code = code[end:]
+	}
+
+	return elements, nil
+}
+
+// getGS1AIPrefix returns the digits in parentheses at the start of the code and the length of the prefix,
+// the application identifier is empty when the code does not start with one.
+func getGS1AIPrefix(code string) (string, int) {
+	end := strings.Index(code, ")")
+	if !strings.HasPrefix(code, "(") || end < 0 {
+		return "", 0
+	}
+
+	ai := code[1:end]
+	if len(ai) < 2 || len(ai) > 4 || !isDigits(ai) {
+		return "", 0
+	}
+
+	return ai, end + 1
+}
+
+// getGS1ValueEnd returns where the value ends, which is the start of the next application identifier.
+func getGS1ValueEnd(value string) int {
+	for i := 1; i < len(value); i++ {
+		if value[i] != '(' {
+			continue
+		}
+
+		if ai, _ := getGS1AIPrefix(value[i:]); ai != "" {
+			return i
+		}
+	}
+
+	return len(value)
+}
+
+func validateGS1(elements []entity.GS1Element) error {
+	if len(elements) == 0 {
+		return errors.New("gs1 code must have at least one application identifier")
+	}
+
+	for _, element := range elements {
+		format, ok := getGS1AI(element.AI)
+		if !ok {
+			return fmt.Errorf("gs1 application identifier (%s) is not supported", element.AI)
+		}
+
+		value := element.Value
+
+		if format.variable && (value == "" || len(value) > format.length) {
+			return fmt.Errorf("gs1 (%s) must have from 1 to %d characters", element.AI, format.length)
+		}
+
+		if !format.variable && len(value) != format.length {
+			return fmt.Errorf("gs1 (%s) must have %d characters", element.AI, format.length)
+		}
+
+		if format.numeric && !isDigits(value) {
+			return fmt.Errorf("gs1 (%s) only accepts digits", element.AI)
+		}
+
+		if !containsOnly(value, gs1Charset) {
+			return fmt.Errorf("gs1 (%s) has characters outside the GS1 character set", element.AI)
+		}
+
+		if format.checkDigit && getCheckDigit(value[:len(value)-1]) != value[len(value)-1] {
+			return fmt.Errorf("gs1 (%s) check digit does not match", element.AI)
+		}
+
+		if format.date && !isGS1Date(value) {
+			return fmt.Errorf("gs1 (%s) must be a date in the format YYMMDD", element.AI)
+		}
+	}
+
+	return nil
+}
+
+// isGS1Date checks a YYMMDD date, the day can be 00 to indicate the last day of the month.
+func isGS1Date(value string) bool {
+	month, day := value[2:4], value[4:6]
+	return month >= "01" && month <= "12" && day <= "31"
+}
+
+// GetGS1Data returns the data encoded by GS1 codes, which starts with FNC1 and has FNC1 after each variable
+// length value that is not the last one. The components send this data to the encoders, so the values are
+// never split again and can have parentheses.
+func GetGS1Data(elements []entity.GS1Element) (string, error) {
+	if err := validateGS1(elements); err != nil {
+		return "", err
+	}
+
+	var data strings.Builder
+	data.WriteRune(gs1FNC1)
+	for i, element := range elements {
+		data.WriteString(element.AI + element.Value)
+		if i < len(elements)-1 && !hasGS1PredefinedLength(element.AI) {
+			data.WriteRune(gs1FNC1)
+		}
+	}
+
+	return data.String(), nil
+}
+
+// getGS1Data returns the data of a code, codes starting with FNC1 were built by GetGS1Data and are returned as is,
+// the other ones have the application identifiers in parentheses.
+func getGS1Data(code string) (string, error) {
+	if strings.HasPrefix(code, string(gs1FNC1)) {
+		return code, nil
+	}
+
+	elements, err := parseGS1(code)
+	if err != nil {
+		return "", err
+	}
+
+	return GetGS1Data(elements)
+}
+
+func hasGS1PredefinedLength(ai string) bool {
+	for _, prefix := range gs1PredefinedLengths {
+		if strings.HasPrefix(ai, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func encodeGS1128(code string) (libBarcode.Barcode, error) {
+	data, err := getGS1Data(code)
+	if err != nil {
+		return nil, err
+	}
+
+	return code128.Encode(data)
+}
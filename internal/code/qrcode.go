@@ -0,0 +1,118 @@
+package code
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/jpeg" // registers the jpeg decoder used by logos.
+	"image/png"
+
+	libBarcode "github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/qr"
+	xdraw "golang.org/x/image/draw"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/qrlevel"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// qrLogoModulePixels is the size of each module when a logo is drawn, so the logo keeps enough resolution.
+const qrLogoModulePixels = 10
+
+var qrLevels = map[qrlevel.Type]qr.ErrorCorrectionLevel{
+	qrlevel.L: qr.L,
+	qrlevel.M: qr.M,
+	qrlevel.Q: qr.Q,
+	qrlevel.H: qr.H,
+}
+
+// getQrLevel returns the error correction level of the qrcode, qr.M is the default.
+func getQrLevel(prop *props.QrCode) qr.ErrorCorrectionLevel {
+	if prop == nil {
+		return qr.M
+	}
+
+	if level, ok := qrLevels[prop.ErrorCorrection]; ok {
+		return level
+	}
+	return qr.M
+}
+
+// getStyledQrImage draws the qrcode with its colors, quiet zone and logo.
+func (c *code) getStyledQrImage(qrCode libBarcode.Barcode, prop *props.QrCode) (*entity.Image, error) {
+	modulePixels := 1
+	if prop.Logo != nil {
+		modulePixels = qrLogoModulePixels
+	}
+
+	foreground := toNRGBA(prop.Color, color.NRGBA{A: 255})
+	background := toNRGBA(prop.BackgroundColor, color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+
+	modules := qrCode.Bounds().Dx()
+	size := (modules + 2*prop.QuietZone) * modulePixels
+	img := image.NewNRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(img, img.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+
+	for y := 0; y < modules; y++ {
+		for x := 0; x < modules; x++ {
+			if r, _, _, _ := qrCode.At(x, y).RGBA(); r != 0 {
+				continue
+			}
+
+			left, top := (x+prop.QuietZone)*modulePixels, (y+prop.QuietZone)*modulePixels
+			module := image.Rect(left, top, left+modulePixels, top+modulePixels)
+			draw.Draw(img, module, image.NewUniform(foreground), image.Point{}, draw.Src)
+		}
+	}
+
+	if prop.Logo != nil {
+		if err := drawQrLogo(img, prop.Logo, modules*modulePixels, modulePixels, background); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return &entity.Image{
+		Bytes:      buf.Bytes(),
+		Extension:  extension.Png,
+		Dimensions: &entity.Dimensions{Width: float64(size), Height: float64(size)},
+	}, nil
+}
+
+// drawQrLogo draws the logo in the center of the code over a padding with the background color.
+func drawQrLogo(img *image.NRGBA, logo *props.QrLogo, codeSize, padding int, background color.NRGBA) error {
+	decoded, _, err := image.Decode(bytes.NewReader(logo.Bytes))
+	if err != nil {
+		return err
+	}
+
+	bounds := decoded.Bounds()
+	if bounds.Dx() == 0 || bounds.Dy() == 0 {
+		return errors.New("qrcode logo must have width and height")
+	}
+
+	width := int(float64(codeSize) * logo.Percent / 100)
+	height := width * bounds.Dy() / bounds.Dx()
+
+	center := img.Bounds().Dx() / 2
+	area := image.Rect(center-width/2, center-height/2, center-width/2+width, center-height/2+height)
+
+	draw.Draw(img, area.Inset(-padding), image.NewUniform(background), image.Point{}, draw.Src)
+	xdraw.BiLinear.Scale(img, area, decoded, bounds, xdraw.Over, nil)
+	return nil
+}
+
+func toNRGBA(c *props.Color, defaultColor color.NRGBA) color.NRGBA {
+	if c == nil {
+		return defaultColor
+	}
+
+	return color.NRGBA{R: uint8(c.Red), G: uint8(c.Green), B: uint8(c.Blue), A: 255}
+}
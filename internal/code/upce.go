@@ -0,0 +1,106 @@
+package code
+
+import (
+	"errors"
+
+	libBarcode "github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/utils"
+)
+
+// upceOddDigits and upceEvenDigits are the left hand patterns of each digit with odd and even parity.
+var (
+	upceOddDigits = [10]string{
+		"0001101", "0011001", "0010011", "0111101", "0100011",
+		"0110001", "0101111", "0111011", "0110111", "0001011",
+	}
+	upceEvenDigits = [10]string{
+		"0100111", "0110011", "0011011", "0100001", "0011101",
+		"0111001", "0000101", "0010001", "0001001", "0010111",
+	}
+)
+
+// upceParities is the parity of the six digits of a UPC-E with number system 0, indexed by the check digit.
+// With number system 1 the parities are inverted. E means even parity and O means odd parity.
+var upceParities = [10]string{
+	"EEEOOO", "EEOEOO", "EEOOEO", "EEOOOE", "EOEEOO",
+	"EOOEEO", "EOOOEE", "EOEOEO", "EOEOOE", "EOOEOE",
+}
+
+// encodeUPCE encodes a zero suppressed UPC-E. The code can have 6 digits (number system 0 is assumed),
+// 7 digits with the number system or 8 digits with the number system and the check digit.
+func encodeUPCE(code string) (libBarcode.Barcode, error) {
+	if !isDigits(code) || len(code) < 6 || len(code) > 8 {
+		return nil, errors.New("upce must have 6, 7 or 8 digits")
+	}
+
+	if len(code) == 6 {
+		code = "0" + code
+	}
+
+	numberSystem := code[0]
+	if numberSystem != '0' && numberSystem != '1' {
+		return nil, errors.New("upce number system must be 0 or 1")
+	}
+
+	checkDigit := getCheckDigit(expandUPCE(code[:7]))
+	if len(code) == 8 && code[7] != checkDigit {
+		return nil, errors.New("upce check digit does not match")
+	}
+	code = code[:7] + string(checkDigit)
+
+	parities := upceParities[checkDigit-'0']
+	bars := new(utils.BitList)
+	addPattern(bars, "101")
+	for i, digit := range code[1:7] {
+		even := parities[i] == 'E'
+		if numberSystem == '1' {
+			even = !even
+		}
+
+		if even {
+			addPattern(bars, upceEvenDigits[digit-'0'])
+		} else {
+			addPattern(bars, upceOddDigits[digit-'0'])
+		}
+	}
+	addPattern(bars, "010101")
+
+	return utils.New1DCode("UPC-E", code, bars), nil
+}
+
+// expandUPCE converts the number system and the six digits of a UPC-E to the 11 digits of the equivalent UPC-A.
+func expandUPCE(code string) string {
+	numberSystem, digits := code[:1], code[1:7]
+
+	switch digits[5] {
+	case '0', '1', '2':
+		return numberSystem + digits[0:2] + digits[5:6] + "0000" + digits[2:5]
+	case '3':
+		return numberSystem + digits[0:3] + "00000" + digits[3:5]
+	case '4':
+		return numberSystem + digits[0:4] + "00000" + digits[4:5]
+	default:
+		return numberSystem + digits[0:5] + "0000" + digits[5:6]
+	}
+}
+
+// getCheckDigit calculates the modulo 10 check digit used by EAN and UPC codes, the digits are weighted
+// with 3 and 1 alternately starting from the rightmost one.
+func getCheckDigit(code string) byte {
+	sum := 0
+	for i, digit := range code {
+		value := int(digit - '0')
+		if (len(code)-1-i)%2 == 0 {
+			value *= 3
+		}
+		sum += value
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
+
+func addPattern(bars *utils.BitList, pattern string) {
+	for _, module := range pattern {
+		bars.AddBit(module == '1')
+	}
+}
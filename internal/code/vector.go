@@ -0,0 +1,114 @@
+package code
+
+import (
+	"fmt"
+	"image"
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// moduleRect is a rectangle of dark modules, measured in modules.
+type moduleRect struct {
+	x, y, width, height int
+}
+
+// getVectorImage draws the dark modules of a code as a svg path, each module is one unit wide and moduleHeight
+// units tall. Neighbour modules are merged in rectangles, so the path has far less shapes than modules.
+func getVectorImage(img image.Image, moduleHeight float64, quietZone int, color, backgroundColor *props.Color) *entity.Image {
+	bounds := img.Bounds()
+	width := float64(bounds.Dx() + 2*quietZone)
+	height := (float64(bounds.Dy()) + float64(2*quietZone)) * moduleHeight
+
+	if color == nil {
+		color = &props.BlackColor
+	}
+
+	var svg strings.Builder
+	svg.WriteString(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s">`,
+		formatUnit(width), formatUnit(height), formatUnit(width), formatUnit(height)))
+
+	if backgroundColor != nil {
+		svg.WriteString(fmt.Sprintf(`<rect width="%s" height="%s" fill="%s"/>`,
+			formatUnit(width), formatUnit(height), toSvgColor(backgroundColor)))
+	}
+
+	svg.WriteString(fmt.Sprintf(`<path fill="%s" d="`, toSvgColor(color)))
+	for _, rect := range getModuleRects(img) {
+		x := float64(rect.x + quietZone)
+		y := float64(rect.y+quietZone) * moduleHeight
+		svg.WriteString(fmt.Sprintf("M%s %sh%dv%sh-%dz", formatUnit(x), formatUnit(y), rect.width,
+			formatUnit(float64(rect.height)*moduleHeight), rect.width))
+	}
+	svg.WriteString(`"/></svg>`)
+
+	return &entity.Image{
+		Bytes:     []byte(svg.String()),
+		Extension: extension.Svg,
+		Dimensions: &entity.Dimensions{
+			Width:  width,
+			Height: height,
+		},
+	}
+}
+
+// getModuleRects merges each horizontal run of dark modules with the same run of the rows below it,
+// the bars of a barcode and the blocks of a matrix become a single rectangle.
+func getModuleRects(img image.Image) []moduleRect {
+	bounds := img.Bounds()
+	var rects []moduleRect
+	open := make(map[[2]int]int)
+
+	for y := 0; y < bounds.Dy(); y++ {
+		next := make(map[[2]int]int)
+		for _, run := range getDarkRuns(img, y) {
+			if index, ok := open[run]; ok {
+				rects[index].height++
+				next[run] = index
+				continue
+			}
+
+			rects = append(rects, moduleRect{x: run[0], y: y, width: run[1], height: 1})
+			next[run] = len(rects) - 1
+		}
+		open = next
+	}
+
+	return rects
+}
+
+// getDarkRuns returns the start and the width of each sequence of dark modules in a row.
+func getDarkRuns(img image.Image, y int) [][2]int {
+	bounds := img.Bounds()
+	var runs [][2]int
+
+	start := -1
+	for x := 0; x <= bounds.Dx(); x++ {
+		dark := x < bounds.Dx() && isDark(img, bounds.Min.X+x, bounds.Min.Y+y)
+		if dark && start < 0 {
+			start = x
+		}
+		if !dark && start >= 0 {
+			runs = append(runs, [2]int{start, x - start})
+			start = -1
+		}
+	}
+
+	return runs
+}
+
+func isDark(img image.Image, x, y int) bool {
+	r, g, b, _ := img.At(x, y).RGBA()
+	return r+g+b < 3*0x8000
+}
+
+func toSvgColor(color *props.Color) string {
+	return fmt.Sprintf("rgb(%d,%d,%d)", color.Red, color.Green, color.Blue)
+}
+
+func formatUnit(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -6,9 +6,11 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/border"
 	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
+	"github.com/johnfercher/maroto/v2/pkg/consts/charttype"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/formfield"
 	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
 	"github.com/johnfercher/maroto/v2/pkg/consts/orientation"
 	"github.com/johnfercher/maroto/v2/pkg/core"
@@ -80,6 +82,44 @@ func RectProp() props.Rect {
 	return prop
 }
 
+// QrCodeProp is responsible to give a valid props.QrCode.
+func QrCodeProp() props.QrCode {
+	prop := props.QrCode{
+		Top:     10,
+		Left:    10,
+		Percent: 98,
+		Center:  false,
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// PDF417Prop is responsible to give a valid props.PDF417.
+func PDF417Prop() props.PDF417 {
+	prop := props.PDF417{
+		Top:                  10,
+		Left:                 10,
+		Percent:              98,
+		Center:               false,
+		ErrorCorrectionLevel: 4,
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// AztecProp is responsible to give a valid props.Aztec.
+func AztecProp() props.Aztec {
+	prop := props.Aztec{
+		Top:                    10,
+		Left:                   10,
+		Percent:                98,
+		Center:                 false,
+		ErrorCorrectionPercent: 33,
+	}
+	prop.MakeValid()
+	return prop
+}
+
 // ConfigEntity is responsible to give a valid entity.Config.
 func ConfigEntity() entity.Config {
 	return entity.Config{
@@ -177,6 +217,78 @@ func LineProp() props.Line {
 	return prop
 }
 
+// ShapeProp is responsible to give a valid props.Shape.
+func ShapeProp() props.Shape {
+	colorProp := ColorProp()
+	prop := props.Shape{
+		StrokeColor: &colorProp,
+		FillColor:   &props.RedColor,
+		StrokeWidth: 0.5,
+		DashPattern: []float64{2, 1},
+		Radius:      2,
+		ArrowSize:   4,
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// ChartProp is responsible to give a valid props.Chart.
+func ChartProp() props.Chart {
+	colorProp := ColorProp()
+	prop := props.Chart{
+		Type:       charttype.Line,
+		Left:       5,
+		Top:        3,
+		Percent:    80,
+		Proportion: props.Proportion{Width: 4, Height: 3},
+		Colors:     []props.Color{colorProp},
+		AxisColor:  &colorProp,
+		Text:       props.Text{Size: 7, Color: &colorProp},
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// ChartEntity is responsible to give a valid entity.Chart.
+func ChartEntity() entity.Chart {
+	return entity.Chart{
+		Labels: []string{"jan", "feb", "mar"},
+		Series: []entity.Series{
+			{Name: "sales", Values: []float64{10, 25, 17}},
+			{Name: "costs", Values: []float64{8, -4, 12}},
+		},
+	}
+}
+
+// FormFieldProp is responsible to give a valid props.FormField.
+func FormFieldProp() props.FormField {
+	colorProp := ColorProp()
+	prop := props.FormField{
+		Required:        true,
+		MultiLine:       true,
+		Lines:           4,
+		MaxLength:       200,
+		Size:            12,
+		Color:           &colorProp,
+		Align:           align.Center,
+		BorderColor:     &colorProp,
+		BorderThickness: 0.5,
+		BackgroundColor: &props.WhiteColor,
+	}
+	prop.MakeValid()
+	return prop
+}
+
+// FormFieldEntity is responsible to give a valid entity.FormField.
+func FormFieldEntity() entity.FormField {
+	return entity.FormField{
+		Type:    formfield.RadioGroup,
+		Name:    "plan",
+		Value:   "premium",
+		Options: []string{"basic", "premium"},
+	}
+}
+
 // SignatureProp is responsible to give a valid props.Signature.
 func SignatureProp() props.Signature {
 	textProp := TextProp()
@@ -0,0 +1,192 @@
+// Package merge implements the merge of the PDFs generated from chunks of pages of the same document.
+package merge
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+)
+
+// Chunks merges the PDFs generated from chunks of pages of the same document. The images found in more than
+// one chunk are embedded once and the form fields keep their names, since a name is unique in the document,
+// a name used in more than one chunk returns the same error returned when a name is added twice.
+func Chunks(pdfs ...[]byte) ([]byte, error) {
+	readers := make([]io.ReadSeeker, len(pdfs))
+	for i, pdf := range pdfs {
+		readers[i] = bytes.NewReader(pdf)
+	}
+
+	var buf bytes.Buffer
+	if err := mergeChunks(readers, &buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// mergeChunks merges the PDFs as api.MergeRaw does, but moves the form fields of each PDF to the root of the form.
+func mergeChunks(readers []io.ReadSeeker, writer io.Writer) error {
+	if len(readers) == 0 {
+		return errors.New("there are no pdfs to merge")
+	}
+
+	conf := api.LoadConfiguration()
+	conf.WriteXRefStream = false
+	conf.Cmd = model.MERGECREATE
+	conf.ValidationMode = model.ValidationRelaxed
+	conf.CreateBookmarks = false
+
+	ctxDest, err := readContext(readers[0], conf)
+	if err != nil {
+		return err
+	}
+	ctxDest.EnsureVersionForWriting()
+
+	for i, reader := range readers[1:] {
+		ctxSource, err := readContext(reader, conf)
+		if err != nil {
+			return err
+		}
+
+		if ctxSource.Version() == model.V20 {
+			return pdfcpu.ErrUnsupportedVersion
+		}
+
+		_, fields, err := getFormFields(ctxDest)
+		if err != nil {
+			return err
+		}
+
+		if err := pdfcpu.MergeXRefTables(strconv.Itoa(i), ctxSource, ctxDest, false, false); err != nil {
+			return err
+		}
+
+		if err := flattenFormFields(ctxDest, len(fields)); err != nil {
+			return err
+		}
+	}
+
+	// The optimization removes the duplicated fonts and images, so an image drawn in many PDFs is embedded once.
+	if err := api.OptimizeContext(ctxDest); err != nil {
+		return err
+	}
+
+	return api.WriteContext(ctxDest, writer)
+}
+
+func readContext(reader io.ReadSeeker, conf *model.Configuration) (*model.Context, error) {
+	ctx, err := api.ReadContext(reader, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := api.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	return ctx, nil
+}
+
+// flattenFormFields moves the form fields of the merged PDF to the root of the form. pdfcpu adds them below a new
+// parent field named with a number, which changes their names. A name already used returns the same error returned
+// when a field is added twice to a document.
+func flattenFormFields(ctx *model.Context, previous int) error {
+	form, fields, err := getFormFields(ctx)
+	if err != nil || len(fields) != previous+1 {
+		return err
+	}
+
+	parent, err := ctx.DereferenceDict(fields[previous])
+	if err != nil {
+		return err
+	}
+
+	kidsObj, found := parent.Find("Kids")
+	if !found || len(parent) != 2 {
+		return nil
+	}
+
+	kids, err := ctx.DereferenceArray(kidsObj)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]bool)
+	for _, field := range fields[:previous] {
+		name, err := getFieldName(ctx, field)
+		if err != nil {
+			return err
+		}
+		names[name] = true
+	}
+
+	for _, kid := range kids {
+		name, err := getFieldName(ctx, kid)
+		if err != nil {
+			return err
+		}
+
+		if names[name] {
+			return fmt.Errorf("form field %s is added more than once", name)
+		}
+		names[name] = true
+	}
+
+	for _, kid := range kids {
+		d, err := ctx.DereferenceDict(kid)
+		if err != nil {
+			return err
+		}
+		d.Delete("Parent")
+	}
+
+	form["Fields"] = append(fields[:previous], kids...)
+	return nil
+}
+
+// getFormFields returns the AcroForm of the PDF and its root fields.
+func getFormFields(ctx *model.Context) (types.Dict, types.Array, error) {
+	root, err := ctx.Catalog()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	formObj, found := root.Find("AcroForm")
+	if !found {
+		return nil, nil, nil
+	}
+
+	form, err := ctx.DereferenceDict(formObj)
+	if err != nil || form == nil {
+		return nil, nil, err
+	}
+
+	fieldsObj, found := form.Find("Fields")
+	if !found {
+		return form, nil, nil
+	}
+
+	fields, err := ctx.DereferenceArray(fieldsObj)
+	return form, fields, err
+}
+
+func getFieldName(ctx *model.Context, field types.Object) (string, error) {
+	d, err := ctx.DereferenceDict(field)
+	if err != nil {
+		return "", err
+	}
+
+	name, found := d.Find("T")
+	if !found {
+		return "", nil
+	}
+
+	return ctx.DereferenceStringOrHexLiteral(name, model.V10, nil)
+}
@@ -0,0 +1,125 @@
+package merge_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/internal/merge"
+	"github.com/johnfercher/maroto/v2/pkg/components/form"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+)
+
+func TestChunks(t *testing.T) {
+	t.Run("when pdfs are valid, should merge them", func(t *testing.T) {
+		// Arrange
+		doc1 := newTextPDF(t, "text1")
+		doc2 := newTextPDF(t, "text2")
+
+		// Act
+		pdf, err := merge.Chunks(doc1, doc2)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.InDelta(t, len(doc1)+len(doc2), len(pdf), 500)
+	})
+	t.Run("when there are no pdfs, should return error", func(t *testing.T) {
+		// Act
+		pdf, err := merge.Chunks()
+
+		// Assert
+		assert.Nil(t, pdf)
+		assert.NotNil(t, err)
+	})
+	t.Run("when pdfs have the same image, should embed the image once", func(t *testing.T) {
+		// Arrange
+		doc1 := newImagePDF(t)
+		doc2 := newImagePDF(t)
+
+		// Act
+		pdf, err := merge.Chunks(doc1, doc2)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 1, getImagesQuantity(t, pdf))
+	})
+	t.Run("when pdfs have form fields, should keep the names of the fields", func(t *testing.T) {
+		// Arrange
+		doc1 := newFormPDF(t, "name")
+		doc2 := newFormPDF(t, "email")
+
+		// Act
+		pdf, err := merge.Chunks(doc1, doc2)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{"name", "email"}, getFieldNames(t, pdf))
+	})
+	t.Run("when pdfs have form fields with the same name, should return error", func(t *testing.T) {
+		// Arrange
+		doc1 := newFormPDF(t, "name")
+		doc2 := newFormPDF(t, "name")
+
+		// Act
+		pdf, err := merge.Chunks(doc1, doc2)
+
+		// Assert
+		assert.EqualError(t, err, "form field name is added more than once")
+		assert.Nil(t, pdf)
+	})
+}
+
+func newTextPDF(t *testing.T, value string) []byte {
+	m := maroto.New()
+	m.AddRows(text.NewRow(10, value))
+	doc, err := m.Generate()
+	assert.Nil(t, err)
+	return doc.GetBytes()
+}
+
+func newImagePDF(t *testing.T) []byte {
+	m := maroto.New()
+	m.AddRows(image.NewFromFileRow(40, "../../docs/assets/images/biplane.jpg"))
+	doc, err := m.Generate()
+	assert.Nil(t, err)
+	return doc.GetBytes()
+}
+
+func getImagesQuantity(t *testing.T, pdf []byte) int {
+	ctx, err := api.ReadContext(bytes.NewReader(pdf), model.NewDefaultConfiguration())
+	assert.Nil(t, err)
+
+	quantity := 0
+	for _, entry := range ctx.Table {
+		streamDict, ok := entry.Object.(types.StreamDict)
+		if ok && streamDict.Subtype() != nil && *streamDict.Subtype() == "Image" {
+			quantity++
+		}
+	}
+	return quantity
+}
+
+func newFormPDF(t *testing.T, name string) []byte {
+	m := maroto.New()
+	m.AddRows(form.NewTextFieldRow(10, name, "value"))
+	doc, err := m.Generate()
+	assert.Nil(t, err)
+	return doc.GetBytes()
+}
+
+func getFieldNames(t *testing.T, pdf []byte) []string {
+	fields, err := api.FormFields(bytes.NewReader(pdf), model.NewDefaultConfiguration())
+	assert.Nil(t, err)
+
+	var names []string
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+	return names
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/loader"
 )
 
 // Dependencies is the dependencies provider for gofpdf
@@ -20,6 +21,9 @@ type Dependencies struct {
 	Code       core.Code
 	Image      core.Image
 	Line       core.Line
+	Shape      core.Shape
+	Chart      core.Chart
+	Form       core.Form
 	Cache      cache.Cache
 	CellWriter cellwriter.CellWriter
 	Cfg        *entity.Config
@@ -27,7 +31,7 @@ type Dependencies struct {
 
 // Builder is the dependencies builder for gofpdf
 type Builder interface {
-	Build(cfg *entity.Config, cache cache.Cache) *Dependencies
+	Build(cfg *entity.Config, cache cache.Cache, imageMetrics *ImageMetrics) *Dependencies
 }
 
 type builder struct{}
@@ -37,8 +41,8 @@ func NewBuilder() *builder {
 	return &builder{}
 }
 
-// Build create a new Dependencies
-func (b *builder) Build(cfg *entity.Config, cache cache.Cache) *Dependencies {
+// Build create a new Dependencies, the image metrics may be shared by the dependencies of many chunks.
+func (b *builder) Build(cfg *entity.Config, cache cache.Cache, imageMetrics *ImageMetrics) *Dependencies {
 	fpdf := gofpdfwrapper.NewCustom(&gofpdf.InitType{
 		OrientationStr: "P",
 		UnitStr:        "mm",
@@ -50,7 +54,12 @@ func (b *builder) Build(cfg *entity.Config, cache cache.Cache) *Dependencies {
 	})
 
 	for _, font := range cfg.CustomFonts {
-		fpdf.AddUTF8FontFromBytes(font.Family, string(font.Style), font.Bytes)
+		fontBytes, err := loadFont(font, cfg.Loader)
+		if err != nil {
+			fpdf.SetError(err)
+			break
+		}
+		fpdf.AddUTF8FontFromBytes(font.Family, string(font.Style), fontBytes)
 	}
 
 	if cfg.DisableAutoPageBreak {
@@ -66,8 +75,16 @@ func (b *builder) Build(cfg *entity.Config, cache cache.Cache) *Dependencies {
 	math := math.New()
 	code := code.New()
 	text := NewText(fpdf, math, font)
-	image := NewImage(fpdf, math)
+	var optimizer *imageOptimizer
+	if cfg.ImageOptimization != nil {
+		optimizer = NewImageOptimizer(cfg.ImageOptimization, imageMetrics)
+	}
+
+	image := NewImage(fpdf, math, optimizer)
 	line := NewLine(fpdf)
+	shape := NewShape(fpdf)
+	chart := NewChart(fpdf, font, text)
+	form := NewForm(fpdf, font, text)
 	cellWriter := cellwriter.NewBuilder().
 		Build(fpdf)
 
@@ -78,8 +95,24 @@ func (b *builder) Build(cfg *entity.Config, cache cache.Cache) *Dependencies {
 		Code:       code,
 		Image:      image,
 		Line:       line,
+		Shape:      shape,
+		Chart:      chart,
+		Form:       form,
 		CellWriter: cellWriter,
 		Cfg:        cfg,
 		Cache:      cache,
 	}
 }
+
+// loadFont returns the font bytes, reading them through the loader when only the font file is defined.
+func loadFont(font *entity.CustomFont, fileLoader loader.Loader) ([]byte, error) {
+	if font.Bytes != nil || font.File == "" {
+		return font.Bytes, nil
+	}
+
+	if fileLoader == nil {
+		fileLoader = loader.New()
+	}
+
+	return fileLoader.Load(font.File)
+}
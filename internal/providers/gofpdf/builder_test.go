@@ -1,15 +1,23 @@
 package gofpdf_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
+	"testing/fstest"
 
 	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/config"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/repository"
+	"golang.org/x/image/font/gofont/goregular"
 
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewBuilder(t *testing.T) {
@@ -47,7 +55,7 @@ func TestBuilder_Build(t *testing.T) {
 		}
 
 		// Act
-		dep := sut.Build(cfg, nil)
+		dep := sut.Build(cfg, nil, nil)
 
 		// Assert
 		assert.NotNil(t, dep)
@@ -77,9 +85,61 @@ func TestBuilder_Build(t *testing.T) {
 		}
 
 		// Act
-		dep := sut.Build(cfg, nil)
+		dep := sut.Build(cfg, nil, nil)
 
 		// Assert
 		assert.NotNil(t, dep)
 	})
+	t.Run("when custom font has only file, should read it through loader", func(t *testing.T) {
+		// Arrange
+		sut := gofpdf.NewBuilder()
+		font := fixture.FontProp()
+		loader := mocks.NewLoader(t)
+		loader.EXPECT().Load("s3://bucket/arial.ttf").Return(nil, errors.New("timeout"))
+		cfg := &entity.Config{
+			Dimensions: &entity.Dimensions{
+				Width:  100,
+				Height: 200,
+			},
+			Margins: &entity.Margins{
+				Left:   10,
+				Top:    10,
+				Right:  10,
+				Bottom: 10,
+			},
+			DefaultFont: &font,
+			CustomFonts: []*entity.CustomFont{
+				{
+					Family: fontfamily.Arial,
+					File:   "s3://bucket/arial.ttf",
+				},
+			},
+			Loader: loader,
+		}
+
+		// Act
+		dep := sut.Build(cfg, nil, nil)
+
+		// Assert
+		assert.True(t, dep.Fpdf.Err())
+	})
+	t.Run("when repository font has only file, should read it through config loader", func(t *testing.T) {
+		// Arrange
+		sut := gofpdf.NewBuilder()
+		fsys := fstest.MapFS{"fonts/go.ttf": &fstest.MapFile{Data: goregular.TTF}}
+		customFonts, err := repository.New().AddUTF8Font("go", fontstyle.Normal, "/fonts/go.ttf").Load()
+		require.NoError(t, err)
+		cfg := config.NewBuilder().
+			WithFileSystem(fsys).
+			WithCustomFonts(customFonts).
+			Build()
+
+		// Act
+		dep := sut.Build(cfg, nil, nil)
+		dep.Fpdf.SetFont("go", "", 10)
+
+		// Assert
+		assert.Empty(t, customFonts[0].Bytes)
+		assert.False(t, dep.Fpdf.Err())
+	})
 }
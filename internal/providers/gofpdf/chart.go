@@ -0,0 +1,504 @@
+package gofpdf
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/charttype"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	chartTicks         = 4
+	chartGridThickness = 0.1
+	chartBarPadding    = 0.2
+	chartMaxArc        = 90.0
+)
+
+type chart struct {
+	pdf              gofpdfwrapper.Fpdf
+	font             core.Font
+	text             core.Text
+	defaultDrawColor *props.Color
+	defaultFillColor *props.Color
+	defaultThickness float64
+}
+
+// axis is the value range of the vertical axis of bar and line charts.
+type axis struct {
+	min  float64
+	max  float64
+	step float64
+}
+
+// NewChart create a Chart.
+func NewChart(pdf gofpdfwrapper.Fpdf, font core.Font, text core.Text) *chart {
+	return &chart{
+		pdf:              pdf,
+		font:             font,
+		text:             text,
+		defaultDrawColor: &props.BlackColor,
+		defaultFillColor: &props.WhiteColor,
+		defaultThickness: linestyle.DefaultLineThickness,
+	}
+}
+
+// Add draws a chart inside a cell.
+func (c *chart) Add(data *entity.Chart, cell *entity.Cell, prop *props.Chart) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	area := c.getArea(cell, prop)
+	labelHeight := c.font.GetHeight(prop.Text.Family, prop.Text.Style, prop.Text.Size)
+
+	if !prop.HideLegend {
+		legendHeight := c.addLegend(data, area, prop, labelHeight)
+		area.Height -= legendHeight
+	}
+
+	var err error
+	if prop.Type.IsCircular() {
+		err = c.addCircular(data, area, prop)
+	} else {
+		err = c.addCartesian(data, area, prop, labelHeight)
+	}
+
+	c.pdf.SetDrawColor(c.defaultDrawColor.Red, c.defaultDrawColor.Green, c.defaultDrawColor.Blue)
+	c.pdf.SetFillColor(c.defaultFillColor.Red, c.defaultFillColor.Green, c.defaultFillColor.Blue)
+	c.pdf.SetLineWidth(c.defaultThickness)
+
+	return err
+}
+
+// getArea returns the space of the cell used by the chart, keeping the chart proportion.
+func (c *chart) getArea(cell *entity.Cell, prop *props.Chart) *entity.Cell {
+	proportion := prop.Proportion.Height / prop.Proportion.Width
+	width := cell.Width * prop.Percent / 100
+	height := width * proportion
+
+	if height > cell.Height {
+		height = cell.Height
+		width = height / proportion
+	}
+
+	if prop.Center {
+		return &entity.Cell{
+			X:      cell.X + (cell.Width-width)/2,
+			Y:      cell.Y + (cell.Height-height)/2,
+			Width:  width,
+			Height: height,
+		}
+	}
+
+	return &entity.Cell{
+		X:      cell.X + prop.Left,
+		Y:      cell.Y + prop.Top,
+		Width:  width,
+		Height: height,
+	}
+}
+
+// addLegend draws the legend in the bottom of the area and returns its height.
+func (c *chart) addLegend(data *entity.Chart, area *entity.Cell, prop *props.Chart, labelHeight float64) float64 {
+	var names []string
+	var colors []*props.Color
+	if prop.Type.IsCircular() {
+		for i, label := range data.Labels {
+			names = append(names, label)
+			colors = append(colors, prop.GetColor(i))
+		}
+	} else {
+		for i := range data.Series {
+			names = append(names, data.Series[i].Name)
+			colors = append(colors, c.getSeriesColor(data, i, prop))
+		}
+	}
+
+	c.font.SetFont(prop.Text.Family, prop.Text.Style, prop.Text.Size)
+
+	swatch := labelHeight * 0.7
+	lineHeight := labelHeight * 1.5
+	spacing := swatch
+
+	// Break the legend entries in lines that fit the area width
+	var lines [][]int
+	var line []int
+	lineWidth := 0.0
+	for i, name := range names {
+		entryWidth := swatch + spacing/2 + c.pdf.GetStringWidth(name) + spacing
+		if len(line) > 0 && lineWidth+entryWidth > area.Width {
+			lines = append(lines, line)
+			line = nil
+			lineWidth = 0
+		}
+		line = append(line, i)
+		lineWidth += entryWidth
+	}
+	lines = append(lines, line)
+
+	legendHeight := float64(len(lines)) * lineHeight
+	y := area.Y + area.Height - legendHeight
+
+	labelProp := prop.Text
+	labelProp.Align = align.Left
+
+	for index, line := range lines {
+		x := area.X
+		lineY := y + float64(index)*lineHeight + (lineHeight-labelHeight)/2
+		for _, i := range line {
+			c.font.SetFont(prop.Text.Family, prop.Text.Style, prop.Text.Size)
+			textWidth := c.pdf.GetStringWidth(names[i])
+
+			c.setFillColor(colors[i])
+			c.rect(x, lineY+(labelHeight-swatch)/2, swatch, swatch)
+			x += swatch + spacing/2
+
+			c.text.Add(names[i], &entity.Cell{X: x, Y: lineY - labelHeight*0.15, Width: textWidth + 1, Height: labelHeight}, &labelProp)
+			x += textWidth + spacing
+		}
+	}
+
+	return legendHeight
+}
+
+func (c *chart) addCartesian(data *entity.Chart, area *entity.Cell, prop *props.Chart, labelHeight float64) error {
+	ax := getAxis(data, prop.Type)
+
+	plot := &entity.Cell{X: area.X, Y: area.Y, Width: area.Width, Height: area.Height}
+	if !prop.HideAxes {
+		plot.Y += labelHeight / 2
+		plot.Height -= labelHeight/2 + labelHeight*1.5
+		labelWidth := c.addValueLabels(ax, plot, prop, labelHeight)
+		plot.X += labelWidth
+		plot.Width -= labelWidth
+	}
+
+	if plot.Width <= 0 || plot.Height <= 0 {
+		return errors.New("chart area is too small")
+	}
+
+	if !prop.HideAxes {
+		c.addGrid(ax, plot, prop)
+		c.addCategoryLabels(data, plot, prop, labelHeight)
+	}
+
+	switch prop.Type {
+	case charttype.StackedBar:
+		c.addStackedBars(data, ax, plot, prop)
+	case charttype.Line:
+		c.addLines(data, ax, plot, prop)
+	default:
+		c.addBars(data, ax, plot, prop)
+	}
+
+	if !prop.HideAxes {
+		c.addAxes(ax, plot, prop)
+	}
+
+	return nil
+}
+
+// addValueLabels draws the labels of the vertical axis on the left of the plot and returns their width.
+func (c *chart) addValueLabels(ax *axis, plot *entity.Cell, prop *props.Chart, labelHeight float64) float64 {
+	c.font.SetFont(prop.Text.Family, prop.Text.Style, prop.Text.Size)
+
+	values := ax.getValues()
+	labels := make([]string, len(values))
+	maxWidth := 0.0
+	for i, value := range values {
+		labels[i] = formatAxisValue(value, ax.step)
+		maxWidth = math.Max(maxWidth, c.pdf.GetStringWidth(labels[i]))
+	}
+
+	labelProp := prop.Text
+	labelProp.Align = align.Right
+
+	for i, value := range values {
+		cell := &entity.Cell{X: plot.X, Y: ax.getY(value, plot) - labelHeight*0.6, Width: maxWidth + 0.5, Height: labelHeight}
+		c.text.Add(labels[i], cell, &labelProp)
+	}
+
+	return maxWidth + 1.5
+}
+
+func (c *chart) addCategoryLabels(data *entity.Chart, plot *entity.Cell, prop *props.Chart, labelHeight float64) {
+	groupWidth := plot.Width / float64(len(data.Labels))
+
+	labelProp := prop.Text
+	labelProp.Align = align.Center
+
+	for i, label := range data.Labels {
+		cell := &entity.Cell{
+			X:      plot.X + float64(i)*groupWidth,
+			Y:      plot.Y + plot.Height + labelHeight*0.25,
+			Width:  groupWidth,
+			Height: labelHeight,
+		}
+		c.text.Add(label, cell, &labelProp)
+	}
+}
+
+func (c *chart) addGrid(ax *axis, plot *entity.Cell, prop *props.Chart) {
+	c.pdf.SetDrawColor(prop.AxisColor.Red, prop.AxisColor.Green, prop.AxisColor.Blue)
+	c.pdf.SetLineWidth(chartGridThickness)
+
+	// values too far apart overflow the axis step, which leaves no grid line to draw.
+	values := ax.getValues()
+	if len(values) < 2 {
+		return
+	}
+
+	for _, value := range values[1:] {
+		y := ax.getY(value, plot)
+		c.line(plot.X, y, plot.X+plot.Width, y)
+	}
+}
+
+func (c *chart) addAxes(ax *axis, plot *entity.Cell, prop *props.Chart) {
+	c.pdf.SetDrawColor(prop.AxisColor.Red, prop.AxisColor.Green, prop.AxisColor.Blue)
+	c.pdf.SetLineWidth(c.defaultThickness)
+
+	zero := ax.getY(0, plot)
+	c.line(plot.X, plot.Y, plot.X, plot.Y+plot.Height)
+	c.line(plot.X, zero, plot.X+plot.Width, zero)
+}
+
+func (c *chart) addBars(data *entity.Chart, ax *axis, plot *entity.Cell, prop *props.Chart) {
+	groupWidth := plot.Width / float64(len(data.Labels))
+	barWidth := groupWidth * (1 - chartBarPadding) / float64(len(data.Series))
+	zero := ax.getY(0, plot)
+
+	for i := range data.Series {
+		c.setFillColor(c.getSeriesColor(data, i, prop))
+		for j, value := range data.Series[i].Values {
+			x := plot.X + float64(j)*groupWidth + groupWidth*chartBarPadding/2 + float64(i)*barWidth
+			y := ax.getY(value, plot)
+			c.rect(x, math.Min(y, zero), barWidth, math.Abs(zero-y))
+		}
+	}
+}
+
+func (c *chart) addStackedBars(data *entity.Chart, ax *axis, plot *entity.Cell, prop *props.Chart) {
+	groupWidth := plot.Width / float64(len(data.Labels))
+	barWidth := groupWidth * (1 - chartBarPadding*2)
+
+	for j := range data.Labels {
+		positive, negative := 0.0, 0.0
+		x := plot.X + float64(j)*groupWidth + groupWidth*chartBarPadding
+
+		for i := range data.Series {
+			value := data.Series[i].Values[j]
+			start := &positive
+			if value < 0 {
+				start = &negative
+			}
+
+			from := ax.getY(*start, plot)
+			*start += value
+			to := ax.getY(*start, plot)
+
+			c.setFillColor(c.getSeriesColor(data, i, prop))
+			c.rect(x, math.Min(from, to), barWidth, math.Abs(from-to))
+		}
+	}
+}
+
+func (c *chart) addLines(data *entity.Chart, ax *axis, plot *entity.Cell, prop *props.Chart) {
+	groupWidth := plot.Width / float64(len(data.Labels))
+	c.pdf.SetLineWidth(prop.LineThickness)
+
+	for i := range data.Series {
+		color := c.getSeriesColor(data, i, prop)
+		c.pdf.SetDrawColor(color.Red, color.Green, color.Blue)
+		c.setFillColor(color)
+
+		var lastX, lastY float64
+		for j, value := range data.Series[i].Values {
+			x := plot.X + float64(j)*groupWidth + groupWidth/2
+			y := ax.getY(value, plot)
+			if j > 0 {
+				c.line(lastX, lastY, x, y)
+			}
+			c.circle(x, y, prop.LineThickness*1.5)
+			lastX, lastY = x, y
+		}
+	}
+}
+
+func (c *chart) addCircular(data *entity.Chart, area *entity.Cell, prop *props.Chart) error {
+	values := data.Series[0].Values
+
+	total := 0.0
+	for _, value := range values {
+		if value < 0 {
+			return fmt.Errorf("%s chart with negative value %v", prop.Type, value)
+		}
+		total += value
+	}
+
+	if total == 0 {
+		return fmt.Errorf("%s chart without values", prop.Type)
+	}
+
+	left, top, _, _ := c.pdf.GetMargins()
+	radius := math.Min(area.Width, area.Height) / 2 * 0.95
+	x := left + area.X + area.Width/2
+	y := top + area.Y + area.Height/2
+
+	innerRadius := 0.0
+	if prop.Type == charttype.Donut {
+		innerRadius = radius * prop.HolePercent / 100
+	}
+
+	// Slices are drawn clockwise from the top of the circle
+	end := 90.0
+	for i, value := range values {
+		if value == 0 {
+			continue
+		}
+
+		start := end - value/total*360
+		c.setFillColor(prop.GetColor(i))
+		c.addSlice(x, y, radius, innerRadius, start, end)
+		end = start
+	}
+
+	return nil
+}
+
+func (c *chart) addSlice(x, y, radius, innerRadius, start, end float64) {
+	if innerRadius == 0 {
+		c.pdf.MoveTo(x, y)
+	} else {
+		c.pdf.MoveTo(x+innerRadius*math.Cos(start*math.Pi/180), y-innerRadius*math.Sin(start*math.Pi/180))
+	}
+
+	c.arcTo(x, y, radius, start, end)
+
+	if innerRadius > 0 {
+		c.arcTo(x, y, innerRadius, end, start)
+	}
+
+	c.pdf.ClosePath()
+	c.pdf.DrawPath("F")
+}
+
+// arcTo draws an arc split in pieces which can be precisely represented by bézier curves.
+func (c *chart) arcTo(x, y, radius, start, end float64) {
+	pieces := math.Ceil(math.Abs(end-start) / chartMaxArc)
+	step := (end - start) / pieces
+
+	for i := 0.0; i < pieces; i++ {
+		c.pdf.ArcTo(x, y, radius, radius, 0, start+i*step, start+(i+1)*step)
+	}
+}
+
+func (c *chart) getSeriesColor(data *entity.Chart, index int, prop *props.Chart) *props.Color {
+	if data.Series[index].Color != nil {
+		return data.Series[index].Color
+	}
+
+	return prop.GetColor(index)
+}
+
+func (c *chart) setFillColor(color *props.Color) {
+	c.pdf.SetFillColor(color.Red, color.Green, color.Blue)
+}
+
+func (c *chart) rect(x, y, width, height float64) {
+	left, top, _, _ := c.pdf.GetMargins()
+	c.pdf.Rect(left+x, top+y, width, height, "F")
+}
+
+func (c *chart) line(x1, y1, x2, y2 float64) {
+	left, top, _, _ := c.pdf.GetMargins()
+	c.pdf.Line(left+x1, top+y1, left+x2, top+y2)
+}
+
+func (c *chart) circle(x, y, radius float64) {
+	left, top, _, _ := c.pdf.GetMargins()
+	c.pdf.Circle(left+x, top+y, radius, "F")
+}
+
+// getAxis returns the range of the vertical axis rounded to a readable step.
+func getAxis(data *entity.Chart, chartType charttype.Type) *axis {
+	minValue, maxValue := 0.0, 0.0
+
+	if chartType == charttype.StackedBar {
+		for j := range data.Labels {
+			positive, negative := 0.0, 0.0
+			for _, s := range data.Series {
+				if s.Values[j] > 0 {
+					positive += s.Values[j]
+				} else {
+					negative += s.Values[j]
+				}
+			}
+			minValue = math.Min(minValue, negative)
+			maxValue = math.Max(maxValue, positive)
+		}
+	} else {
+		for _, s := range data.Series {
+			for _, value := range s.Values {
+				minValue = math.Min(minValue, value)
+				maxValue = math.Max(maxValue, value)
+			}
+		}
+	}
+
+	if maxValue == minValue {
+		maxValue = minValue + 1
+	}
+
+	step := getNiceStep((maxValue - minValue) / chartTicks)
+
+	return &axis{
+		min:  math.Floor(minValue/step) * step,
+		max:  math.Ceil(maxValue/step) * step,
+		step: step,
+	}
+}
+
+// getValues returns the values where the axis is labeled.
+func (a *axis) getValues() []float64 {
+	var values []float64
+	for i := 0.0; a.min+i*a.step <= a.max+a.step/2; i++ {
+		values = append(values, a.min+i*a.step)
+	}
+
+	return values
+}
+
+// getY returns the vertical position of a value inside the plot.
+func (a *axis) getY(value float64, plot *entity.Cell) float64 {
+	return plot.Y + plot.Height - (value-a.min)/(a.max-a.min)*plot.Height
+}
+
+// getNiceStep rounds a step to 1, 2 or 5 times a power of ten.
+func getNiceStep(raw float64) float64 {
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	normalized := raw / magnitude
+
+	switch {
+	case normalized <= 1:
+		return magnitude
+	case normalized <= 2:
+		return 2 * magnitude
+	case normalized <= 5:
+		return 5 * magnitude
+	default:
+		return 10 * magnitude
+	}
+}
+
+func formatAxisValue(value, step float64) string {
+	decimals := int(math.Max(0, -math.Floor(math.Log10(step))))
+	return fmt.Sprintf("%.*f", decimals, value)
+}
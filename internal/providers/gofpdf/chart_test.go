@@ -0,0 +1,147 @@
+package gofpdf_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/consts/charttype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewChart(t *testing.T) {
+	// Act
+	sut := gofpdf.NewChart(nil, nil, nil)
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*gofpdf.chart", fmt.Sprintf("%T", sut))
+}
+
+func TestChart_Add(t *testing.T) {
+	t.Run("when series does not match labels, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		data := fixture.ChartEntity()
+		data.Series[0].Values = []float64{1}
+		prop := fixture.ChartProp()
+
+		sut := gofpdf.NewChart(mocks.NewFpdf(t), mocks.NewFont(t), mocks.NewText(t))
+
+		// Act
+		err := sut.Add(&data, &cell, &prop)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when pie chart has negative values, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		data := fixture.ChartEntity()
+		data.Series = data.Series[1:]
+		prop := fixture.ChartProp()
+		prop.Type = charttype.Pie
+		prop.HideLegend = true
+
+		font := mocks.NewFont(t)
+		font.EXPECT().GetHeight(prop.Text.Family, prop.Text.Style, prop.Text.Size).Return(3.0)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().SetLineWidth(0.2)
+
+		sut := gofpdf.NewChart(pdf, font, mocks.NewText(t))
+
+		// Act
+		err := sut.Add(&data, &cell, &prop)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when pie chart is valid, should draw a slice for each value", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		data := fixture.ChartEntity()
+		prop := fixture.ChartProp()
+		prop.Type = charttype.Pie
+		prop.HideLegend = true
+
+		font := mocks.NewFont(t)
+		font.EXPECT().GetHeight(prop.Text.Family, prop.Text.Style, prop.Text.Size).Return(3.0)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		pdf.EXPECT().SetFillColor(prop.Colors[0].Red, prop.Colors[0].Green, prop.Colors[0].Blue)
+		pdf.EXPECT().MoveTo(mock.Anything, mock.Anything)
+		pdf.EXPECT().ArcTo(mock.Anything, mock.Anything, mock.Anything, mock.Anything, 0.0, mock.Anything, mock.Anything)
+		pdf.EXPECT().ClosePath()
+		pdf.EXPECT().DrawPath("F")
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().SetLineWidth(0.2)
+
+		sut := gofpdf.NewChart(pdf, font, mocks.NewText(t))
+
+		// Act
+		err := sut.Add(&data, &cell, &prop)
+
+		// Assert
+		assert.Nil(t, err)
+		pdf.AssertNumberOfCalls(t, "MoveTo", 3)
+		pdf.AssertNumberOfCalls(t, "DrawPath", 3)
+	})
+	t.Run("when values are too far apart to split the axis, should draw the bars without grid", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		data := fixture.ChartEntity()
+		data.Series = data.Series[:1]
+		data.Series[0].Values = []float64{math.MaxFloat64, -math.MaxFloat64, 0}
+		prop := fixture.ChartProp()
+		prop.Type = charttype.Bar
+		prop.HideLegend = true
+
+		font := mocks.NewFont(t)
+		font.EXPECT().GetHeight(prop.Text.Family, prop.Text.Style, prop.Text.Size).Return(3.0)
+		font.EXPECT().SetFont(mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add(mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10).Maybe()
+		pdf.EXPECT().GetStringWidth(mock.Anything).Return(5.0).Maybe()
+		pdf.EXPECT().SetDrawColor(mock.Anything, mock.Anything, mock.Anything).Maybe()
+		pdf.EXPECT().SetFillColor(mock.Anything, mock.Anything, mock.Anything).Maybe()
+		pdf.EXPECT().SetLineWidth(mock.Anything).Maybe()
+		pdf.EXPECT().Line(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		pdf.EXPECT().Rect(mock.Anything, mock.Anything, mock.Anything, mock.Anything, "F").Maybe()
+
+		sut := gofpdf.NewChart(pdf, font, text)
+
+		// Act
+		err := sut.Add(&data, &cell, &prop)
+
+		// Assert
+		assert.Nil(t, err)
+	})
+	t.Run("when values are not finite, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		data := fixture.ChartEntity()
+		data.Series[0].Values[0] = math.NaN()
+		prop := fixture.ChartProp()
+
+		sut := gofpdf.NewChart(mocks.NewFpdf(t), mocks.NewFont(t), mocks.NewText(t))
+
+		// Act
+		err := sut.Add(&data, &cell, &prop)
+
+		// Assert
+		assert.EqualError(t, err, "series sales has the value NaN, but values must be finite numbers")
+	})
+}
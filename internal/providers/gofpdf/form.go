@@ -0,0 +1,114 @@
+package gofpdf
+
+import (
+	"errors"
+	"math"
+
+	"github.com/johnfercher/maroto/v2/internal/acroform"
+	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/formfield"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	// mmToPoint converts the millimeters of gofpdf to the points of the PDF.
+	mmToPoint = 72 / 25.4
+	// radioLabelLeft is the space between a radio button and its option.
+	radioLabelLeft = 1.5
+)
+
+type form struct {
+	pdf    gofpdfwrapper.Fpdf
+	font   core.Font
+	text   core.Text
+	fields []*acroform.Field
+}
+
+// NewForm create a Form.
+func NewForm(pdf gofpdfwrapper.Fpdf, font core.Font, text core.Text) *form {
+	return &form{
+		pdf:  pdf,
+		font: font,
+		text: text,
+	}
+}
+
+// Add keeps the field to be written in the AcroForm of the document, in the current page. Checkboxes are squares
+// on the left of the cell and radio groups split the cell in a row for each option.
+func (f *form) Add(field *entity.FormField, cell *entity.Cell, prop *props.FormField) error {
+	if err := field.Validate(); err != nil {
+		return err
+	}
+
+	if cell.Width <= 0 || cell.Height <= 0 {
+		return errors.New("form field cell without area")
+	}
+
+	data := *field
+	fieldProp := *prop
+	formField := &acroform.Field{Data: &data, Prop: &fieldProp}
+
+	switch field.Type {
+	case formfield.RadioGroup:
+		f.addRadioGroup(formField, cell)
+	case formfield.CheckBox:
+		size := math.Min(cell.Width, cell.Height)
+		formField.Widgets = []acroform.Widget{f.getWidget(cell.X, cell.Y+(cell.Height-size)/2, size, size)}
+	default:
+		formField.Widgets = []acroform.Widget{f.getWidget(cell.X, cell.Y, cell.Width, cell.Height)}
+	}
+
+	f.fields = append(f.fields, formField)
+	return nil
+}
+
+// addRadioGroup adds a widget for each option of the group, the options are written on the right of the buttons.
+func (f *form) addRadioGroup(formField *acroform.Field, cell *entity.Cell) {
+	field, prop := formField.Data, formField.Prop
+
+	fontHeight := f.font.GetHeight(fontfamily.Helvetica, fontstyle.Normal, prop.Size)
+	rowHeight := cell.Height / float64(len(field.Options))
+	size := math.Min(rowHeight, fontHeight)
+
+	for i, option := range field.Options {
+		y := cell.Y + float64(i)*rowHeight
+		widget := f.getWidget(cell.X, y+(rowHeight-size)/2, size, size)
+		widget.Option = option
+		formField.Widgets = append(formField.Widgets, widget)
+
+		labelCell := &entity.Cell{X: cell.X + size + radioLabelLeft, Y: y, Width: cell.Width - size - radioLabelLeft, Height: rowHeight}
+		f.text.Add(option, labelCell, &props.Text{
+			Family: fontfamily.Helvetica,
+			Style:  fontstyle.Normal,
+			Size:   prop.Size,
+			Color:  prop.Color,
+			Align:  align.Left,
+			Top:    math.Max(rowHeight-fontHeight, 0) / 2,
+		})
+	}
+}
+
+// Apply writes the fields in the AcroForm of the document.
+func (f *form) Apply(pdf []byte) ([]byte, error) {
+	return acroform.Write(pdf, f.fields)
+}
+
+// getWidget converts an area of the page in millimeters from the top left corner to points from the bottom left
+// corner.
+func (f *form) getWidget(x, y, width, height float64) acroform.Widget {
+	left, top, _, _ := f.pdf.GetMargins()
+	_, pageHeight := f.pdf.GetPageSize()
+
+	return acroform.Widget{
+		Page:   f.pdf.PageNo(),
+		X:      (left + x) * mmToPoint,
+		Y:      (pageHeight - top - y - height) * mmToPoint,
+		Width:  width * mmToPoint,
+		Height: height * mmToPoint,
+	}
+}
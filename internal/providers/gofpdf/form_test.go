@@ -0,0 +1,151 @@
+package gofpdf_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	gofpdf2 "github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/formfield"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestNewForm(t *testing.T) {
+	// Act
+	sut := gofpdf2.NewForm(nil, nil, nil)
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*gofpdf.form", fmt.Sprintf("%T", sut))
+}
+
+func TestForm_Add(t *testing.T) {
+	t.Run("when field is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		field := fixture.FormFieldEntity()
+		field.Name = ""
+		prop := fixture.FormFieldProp()
+
+		sut := gofpdf2.NewForm(mocks.NewFpdf(t), mocks.NewFont(t), mocks.NewText(t))
+
+		// Act
+		err := sut.Add(&field, &cell, &prop)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when cell has no area, should return error", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{Width: 100}
+		field := fixture.FormFieldEntity()
+		prop := fixture.FormFieldProp()
+
+		sut := gofpdf2.NewForm(mocks.NewFpdf(t), mocks.NewFont(t), mocks.NewText(t))
+
+		// Act
+		err := sut.Add(&field, &cell, &prop)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when field is a radio group, should write the options on the right of the buttons", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		field := fixture.FormFieldEntity()
+		prop := fixture.FormFieldProp()
+
+		pdf := newFormFpdf(t)
+
+		font := mocks.NewFont(t)
+		font.EXPECT().GetHeight(fontfamily.Helvetica, fontstyle.Normal, prop.Size).Return(4)
+
+		labelProp := &props.Text{
+			Family: fontfamily.Helvetica,
+			Style:  fontstyle.Normal,
+			Size:   prop.Size,
+			Color:  prop.Color,
+			Align:  align.Left,
+			Top:    35.5,
+		}
+		text := mocks.NewText(t)
+		text.EXPECT().Add("basic", &entity.Cell{X: 15.5, Y: 15, Width: 94.5, Height: 75}, labelProp)
+		text.EXPECT().Add("premium", &entity.Cell{X: 15.5, Y: 90, Width: 94.5, Height: 75}, labelProp)
+
+		sut := gofpdf2.NewForm(pdf, font, text)
+
+		// Act
+		err := sut.Add(&field, &cell, &prop)
+
+		// Assert
+		assert.Nil(t, err)
+		text.AssertNumberOfCalls(t, "Add", 2)
+	})
+}
+
+func TestForm_Apply(t *testing.T) {
+	t.Run("when there are no fields, should return the same pdf", func(t *testing.T) {
+		// Arrange
+		pdf := newFormPDF(t)
+		sut := gofpdf2.NewForm(mocks.NewFpdf(t), mocks.NewFont(t), mocks.NewText(t))
+
+		// Act
+		bytes, err := sut.Apply(pdf)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, pdf, bytes)
+	})
+	t.Run("when fields were added, should write them in the form", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.FormFieldProp()
+		textField := entity.FormField{Type: formfield.Text, Name: "name", Value: "Maria Silva"}
+		checkBox := entity.FormField{Type: formfield.CheckBox, Name: "accept", Checked: true}
+
+		sut := gofpdf2.NewForm(newFormFpdf(t), mocks.NewFont(t), mocks.NewText(t))
+		_ = sut.Add(&textField, &cell, &prop)
+		_ = sut.Add(&checkBox, &cell, &prop)
+
+		// Act
+		pdf, err := sut.Apply(newFormPDF(t))
+
+		// Assert
+		assert.Nil(t, err)
+		fields, err := api.FormFields(bytes.NewReader(pdf), model.NewDefaultConfiguration())
+		assert.Nil(t, err)
+		assert.Len(t, fields, 2)
+		assert.Equal(t, "name", fields[0].Name)
+		assert.Equal(t, "Maria Silva", fields[0].V)
+		assert.Equal(t, "accept", fields[1].Name)
+		assert.Equal(t, "Yes", fields[1].V)
+	})
+}
+
+func newFormFpdf(t *testing.T) *mocks.Fpdf {
+	pdf := mocks.NewFpdf(t)
+	pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+	pdf.EXPECT().GetPageSize().Return(210, 297)
+	pdf.EXPECT().PageNo().Return(1)
+	return pdf
+}
+
+func newFormPDF(t *testing.T) []byte {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.AddPage()
+
+	var buffer bytes.Buffer
+	assert.Nil(t, pdf.Output(&buffer))
+	return buffer.Bytes()
+}
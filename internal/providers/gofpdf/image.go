@@ -2,57 +2,87 @@ package gofpdf
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"errors"
+	"fmt"
+	goimage "image"
+	_ "image/jpeg"
+	_ "image/png"
+	"strings"
+	"unicode/utf16"
 
 	"github.com/google/uuid"
 	"github.com/jung-kurt/gofpdf"
 
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+	"github.com/johnfercher/maroto/v2/internal/svg"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/clip"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/objectfit"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
 type image struct {
-	pdf  gofpdfwrapper.Fpdf
-	math core.Math
+	pdf       gofpdfwrapper.Fpdf
+	math      core.Math
+	optimizer *imageOptimizer
+	grayscale map[uuid.UUID]*entity.Image
 }
 
-// NewImage create an Image.
-func NewImage(pdf gofpdfwrapper.Fpdf, math core.Math) *image {
+// NewImage create an Image, images are embedded without changes when the optimizer is nil.
+func NewImage(pdf gofpdfwrapper.Fpdf, math core.Math, optimizer *imageOptimizer) *image {
 	return &image{
-		pdf,
-		math,
+		pdf:       pdf,
+		math:      math,
+		optimizer: optimizer,
+		grayscale: make(map[uuid.UUID]*entity.Image),
 	}
 }
 
-// GetImageInfo is responsible for loading the image in PDF and returning its information
-func (s image) GetImageInfo(img *entity.Image, extension extension.Type) (*gofpdf.ImageInfoType, uuid.UUID) {
-	imageID, _ := uuid.NewRandom()
+// GetDimensions returns the size in pixels of a jpg or png image. The image is not loaded in PDF,
+// so only the images which are drawn, after being optimized, are embedded.
+func (s image) GetDimensions(img *entity.Image) (*entity.Dimensions, error) {
+	config, _, err := goimage.DecodeConfig(bytes.NewReader(img.Bytes))
+	if err != nil {
+		return nil, err
+	}
 
-	info := s.pdf.RegisterImageOptionsReader(
-		imageID.String(),
-		gofpdf.ImageOptions{
-			ReadDpi:   false,
-			ImageType: string(extension),
-		},
-		bytes.NewReader(img.Bytes),
-	)
-	return info, imageID
+	return &entity.Dimensions{Width: float64(config.Width), Height: float64(config.Height)}, nil
 }
 
 // Add use a byte array to add image to PDF.
 func (s *image) Add(img *entity.Image, cell *entity.Cell, margins *entity.Margins,
-	prop *props.Rect, extension extension.Type, flow bool,
+	prop *props.Rect, ext extension.Type, flow bool,
 ) error {
-	imageID, _ := uuid.NewRandom()
+	if ext == extension.Svg {
+		s.beginAltText(prop.AltText)
+		defer s.endAltText(prop.AltText)
+		return s.addSvgToPdf(img, cell, margins, prop)
+	}
+
+	if prop.Grayscale {
+		gray, err := s.toGrayscale(img, ext)
+		if err != nil {
+			return err
+		}
+		img, ext = gray, gray.Extension
+	}
+
+	if s.optimizer != nil {
+		img, ext = s.optimize(img, cell, prop, ext)
+	}
+
+	imageID := getImageID(img.Bytes, ext)
 
 	info := s.pdf.RegisterImageOptionsReader(
 		imageID.String(),
 		gofpdf.ImageOptions{
 			ReadDpi:   false,
-			ImageType: string(extension),
+			ImageType: string(ext),
 		},
 		bytes.NewReader(img.Bytes),
 	)
@@ -61,24 +91,286 @@ func (s *image) Add(img *entity.Image, cell *entity.Cell, margins *entity.Margin
 		return errors.New("could not register image options, maybe path/name is wrong")
 	}
 
+	s.beginAltText(prop.AltText)
 	s.addImageToPdf(imageID.String(), info, cell, margins, prop, flow)
+	s.endAltText(prop.AltText)
 	return nil
 }
 
+// beginAltText marks the drawing as a span with the alternative description, which is read by screen readers.
+func (s *image) beginAltText(altText string) {
+	if altText == "" {
+		return
+	}
+
+	s.pdf.RawWriteStr(fmt.Sprintf("/Span <</Alt %s>> BDC", toPdfTextString(altText)))
+}
+
+func (s *image) endAltText(altText string) {
+	if altText == "" {
+		return
+	}
+
+	s.pdf.RawWriteStr("EMC")
+}
+
+// toPdfTextString encodes the text as a hexadecimal UTF-16BE string, which keeps any character readable.
+func toPdfTextString(text string) string {
+	var builder strings.Builder
+	builder.WriteString("<FEFF")
+	for _, unit := range utf16.Encode([]rune(text)) {
+		builder.WriteString(fmt.Sprintf("%04X", unit))
+	}
+	builder.WriteString(">")
+
+	return builder.String()
+}
+
+// getImageID derives the image name from its content, so each distinct image is registered once
+// and every placement references it.
+func getImageID(content []byte, ext extension.Type) uuid.UUID {
+	sum := sha256.Sum256(content)
+	return uuid.NewSHA1(uuid.Nil, append([]byte(ext), sum[:]...))
+}
+
+// toGrayscale converts the image once, repeated placements reuse the converted image.
+func (s *image) toGrayscale(img *entity.Image, ext extension.Type) (*entity.Image, error) {
+	imageID := getImageID(img.Bytes, ext)
+	if gray, ok := s.grayscale[imageID]; ok {
+		return gray, nil
+	}
+
+	gray, err := toGrayscale(&entity.Image{Bytes: img.Bytes, Extension: ext})
+	if err != nil {
+		return nil, err
+	}
+
+	s.grayscale[imageID] = gray
+	return gray, nil
+}
+
+// optimize resamples the image to the size it will be printed.
+func (s *image) optimize(img *entity.Image, cell *entity.Cell, prop *props.Rect, ext extension.Type) (*entity.Image, extension.Type) {
+	config, _, err := goimage.DecodeConfig(bytes.NewReader(img.Bytes))
+	if err != nil {
+		return img, ext
+	}
+
+	imageCell, _ := s.getImageCells(&entity.Dimensions{Width: float64(config.Width), Height: float64(config.Height)}, cell, prop)
+
+	optimized := s.optimizer.Optimize(&entity.Image{Bytes: img.Bytes, Extension: ext}, imageCell.Width, imageCell.Height)
+	return optimized, optimized.Extension
+}
+
 func (s *image) addImageToPdf(imageLabel string, info *gofpdf.ImageInfoType, cell *entity.Cell, margins *entity.Margins,
 	prop *props.Rect, flow bool,
 ) {
-	dimensions := s.math.Resize(&entity.Dimensions{
-		Width:  info.Width(),
-		Height: info.Height(),
-	}, cell.GetDimensions(), prop.Percent, prop.JustReferenceWidth)
+	rectCell, clipCell := s.getImageCells(&entity.Dimensions{Width: info.Width(), Height: info.Height()}, cell, prop)
 
-	rectCell := &entity.Cell{X: prop.Left, Y: prop.Top, Width: dimensions.Width, Height: dimensions.Height}
-
-	if prop.Center {
-		rectCell = s.math.GetInnerCenterCell(dimensions, cell.GetDimensions())
+	region := clipCell
+	if region == nil {
+		region = rectCell
 	}
 
+	clipped := s.startClip(cell.X+region.X+margins.Left, cell.Y+region.Y+margins.Top, region.Width, region.Height,
+		prop, clipCell != nil)
+	s.setOpacity(prop.Opacity)
+
 	s.pdf.Image(imageLabel, cell.X+rectCell.X+margins.Left, cell.Y+rectCell.Y+margins.Top,
 		rectCell.Width, rectCell.Height, flow, "", 0, "")
+
+	s.resetOpacity(prop.Opacity)
+	if clipped {
+		s.pdf.ClipEnd()
+	}
+}
+
+// startClip masks the drawing with the clip shape inside the region, without a shape the region is clipped
+// only when it is forced. It returns whether a clip was started.
+func (s *image) startClip(x, y, width, height float64, prop *props.Rect, force bool) bool {
+	smallestSide := min(width, height)
+
+	switch prop.Clip {
+	case clip.Circle:
+		s.pdf.ClipCircle(x+width/2, y+height/2, smallestSide/2, false)
+	case clip.RoundedRect:
+		radius := prop.CornerRadius
+		if radius == 0 {
+			radius = smallestSide / 10
+		}
+		s.pdf.ClipRoundedRect(x, y, width, height, min(radius, smallestSide/2), false)
+	default:
+		if !force {
+			return false
+		}
+		s.pdf.ClipRect(x, y, width, height, false)
+	}
+
+	return true
+}
+
+func (s *image) setOpacity(opacity float64) {
+	if opacity > 0 && opacity < 1 {
+		s.pdf.SetAlpha(opacity, "Normal")
+	}
+}
+
+func (s *image) resetOpacity(opacity float64) {
+	if opacity > 0 && opacity < 1 {
+		s.pdf.SetAlpha(1, "Normal")
+	}
+}
+
+// addSvgToPdf draws the paths of a svg image as vector graphics.
+func (s *image) addSvgToPdf(img *entity.Image, cell *entity.Cell, margins *entity.Margins, prop *props.Rect) error {
+	svgImage, err := svg.Parse(img.Bytes)
+	if err != nil {
+		return err
+	}
+
+	rectCell, clipCell := s.getImageCells(&entity.Dimensions{Width: svgImage.Width, Height: svgImage.Height}, cell, prop)
+
+	x := cell.X + rectCell.X + margins.Left
+	y := cell.Y + rectCell.Y + margins.Top
+	scaleX := rectCell.Width / svgImage.Width
+	scaleY := rectCell.Height / svgImage.Height
+
+	// Shapes outside the svg viewport are not visible.
+	if clipCell == nil {
+		clipCell = rectCell
+	}
+	s.startClip(cell.X+clipCell.X+margins.Left, cell.Y+clipCell.Y+margins.Top, clipCell.Width, clipCell.Height, prop, true)
+	s.setOpacity(prop.Opacity)
+	for _, path := range svgImage.Paths {
+		s.addSvgPath(path, x, y, scaleX, scaleY, prop.Grayscale)
+	}
+	s.resetOpacity(prop.Opacity)
+	s.pdf.ClipEnd()
+
+	s.pdf.SetDrawColor(props.BlackColor.Red, props.BlackColor.Green, props.BlackColor.Blue)
+	s.pdf.SetFillColor(props.WhiteColor.Red, props.WhiteColor.Green, props.WhiteColor.Blue)
+	s.pdf.SetLineWidth(linestyle.DefaultLineThickness)
+
+	return nil
+}
+
+func (s *image) addSvgPath(path svg.Path, x, y, scaleX, scaleY float64, grayscale bool) {
+	style := ""
+	if path.Fill != nil {
+		style += "F"
+		fill := path.Fill
+		if grayscale {
+			fill = toGrayColor(fill)
+		}
+		s.pdf.SetFillColor(fill.Red, fill.Green, fill.Blue)
+	}
+
+	if path.Stroke != nil {
+		style += "D"
+		stroke := path.Stroke
+		if grayscale {
+			stroke = toGrayColor(stroke)
+		}
+		s.pdf.SetDrawColor(stroke.Red, stroke.Green, stroke.Blue)
+		s.pdf.SetLineWidth(path.StrokeWidth * scaleX)
+	}
+
+	if path.EvenOdd && path.Fill != nil {
+		style += "*"
+	}
+
+	toX := func(point svg.Point) float64 { return x + point.X*scaleX }
+	toY := func(point svg.Point) float64 { return y + point.Y*scaleY }
+
+	for _, command := range path.Commands {
+		switch command.Type {
+		case svg.MoveTo:
+			s.pdf.MoveTo(toX(command.Points[0]), toY(command.Points[0]))
+		case svg.LineTo:
+			s.pdf.LineTo(toX(command.Points[0]), toY(command.Points[0]))
+		case svg.CurveTo:
+			s.pdf.CurveBezierCubicTo(toX(command.Points[0]), toY(command.Points[0]), toX(command.Points[1]),
+				toY(command.Points[1]), toX(command.Points[2]), toY(command.Points[2]))
+		case svg.Close:
+			s.pdf.ClosePath()
+		}
+	}
+
+	s.pdf.DrawPath(style)
+}
+
+// getImageCells returns the cell where the whole image is drawn and the cell that clips it, both relative
+// to the cell. The clip cell is nil when the whole image is visible.
+func (s *image) getImageCells(dimensions *entity.Dimensions, cell *entity.Cell, prop *props.Rect) (*entity.Cell, *entity.Cell) {
+	crop := prop.Crop
+	if crop == nil {
+		crop = &props.Crop{Width: 100, Height: 100}
+	}
+
+	visible := &entity.Dimensions{Width: dimensions.Width * crop.Width / 100, Height: dimensions.Height * crop.Height / 100}
+
+	box := s.math.Resize(visible, cell.GetDimensions(), prop.Percent, prop.JustReferenceWidth)
+	if prop.Fit == objectfit.Cover || prop.Fit == objectfit.Fill {
+		box = &entity.Dimensions{Width: cell.Width * prop.Percent / 100, Height: cell.Height * prop.Percent / 100}
+	}
+
+	boxCell := &entity.Cell{Width: box.Width, Height: box.Height}
+	if prop.Center {
+		boxCell = s.math.GetInnerCenterCell(box, cell.GetDimensions())
+	} else {
+		boxCell.X = getOffset(prop.Align, align.Right, cell.Width-box.Width, prop.Left)
+		boxCell.Y = getOffset(prop.VerticalAlign, align.Bottom, cell.Height-box.Height, prop.Top)
+	}
+
+	// The visible region of the image, which overflows the box when the image covers it.
+	visibleCell := boxCell
+	if prop.Fit == objectfit.Cover {
+		scale := max(box.Width/visible.Width, box.Height/visible.Height)
+		width, height := visible.Width*scale, visible.Height*scale
+
+		visibleCell = &entity.Cell{
+			X:      boxCell.X + getCoverOffset(prop.Align, align.Left, align.Right, box.Width-width),
+			Y:      boxCell.Y + getCoverOffset(prop.VerticalAlign, align.Top, align.Bottom, box.Height-height),
+			Width:  width,
+			Height: height,
+		}
+	}
+
+	imageCell := visibleCell
+	if prop.Crop != nil {
+		width, height := visibleCell.Width*100/crop.Width, visibleCell.Height*100/crop.Height
+		imageCell = &entity.Cell{
+			X:      visibleCell.X - width*crop.Left/100,
+			Y:      visibleCell.Y - height*crop.Top/100,
+			Width:  width,
+			Height: height,
+		}
+	}
+
+	if prop.Fit != objectfit.Cover && prop.Crop == nil {
+		return imageCell, nil
+	}
+
+	return imageCell, boxCell
+}
+
+// getOffset returns the position of a rectangle inside the free space according to the alignment.
+func getOffset(alignment, end align.Type, free, start float64) float64 {
+	switch alignment {
+	case end:
+		return free
+	case align.Center, align.Middle:
+		return free / 2
+	default:
+		return start
+	}
+}
+
+// getCoverOffset returns the position of an image that overflows the box, by default the image is centered.
+func getCoverOffset(alignment, start, end align.Type, free float64) float64 {
+	if alignment == start {
+		return 0
+	}
+
+	return getOffset(alignment, end, free, free/2)
 }
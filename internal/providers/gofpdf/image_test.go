@@ -3,12 +3,22 @@ package gofpdf_test
 import (
 	"bytes"
 	"fmt"
+	goimage "image"
+	"image/color"
+	"image/png"
+	"io"
 	"testing"
 
 	gofpdf2 "github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
 
 	"github.com/johnfercher/maroto/v2/internal/fixture"
 	"github.com/johnfercher/maroto/v2/internal/math"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/clip"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/objectfit"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/jung-kurt/gofpdf"
 	"github.com/stretchr/testify/mock"
 
@@ -17,7 +27,7 @@ import (
 )
 
 func TestNewImage(t *testing.T) {
-	image := gofpdf2.NewImage(mocks.NewFpdf(t), mocks.NewMath(t))
+	image := gofpdf2.NewImage(mocks.NewFpdf(t), mocks.NewMath(t), nil)
 
 	assert.NotNil(t, image)
 	assert.Equal(t, fmt.Sprintf("%T", image), "*gofpdf.image")
@@ -38,7 +48,7 @@ func TestImage_Add(t *testing.T) {
 		pdf := mocks.NewFpdf(t)
 		pdf.EXPECT().RegisterImageOptionsReader(mock.Anything, options, bytes.NewReader(img.Bytes)).Return(nil)
 
-		image := gofpdf2.NewImage(pdf, mocks.NewMath(t))
+		image := gofpdf2.NewImage(pdf, mocks.NewMath(t), nil)
 
 		// Act
 		err := image.Add(&img, &cell, &margins, &rect, img.Extension, true)
@@ -63,7 +73,7 @@ func TestImage_Add(t *testing.T) {
 
 		m := math.New()
 
-		image := gofpdf2.NewImage(pdf, m)
+		image := gofpdf2.NewImage(pdf, m, nil)
 
 		// Act
 		err := image.Add(&img, &cell, &margins, &rect, img.Extension, true)
@@ -89,7 +99,7 @@ func TestImage_Add(t *testing.T) {
 
 		m := math.New()
 
-		image := gofpdf2.NewImage(pdf, m)
+		image := gofpdf2.NewImage(pdf, m, nil)
 
 		// Act
 		err := image.Add(&img, &cell, &margins, &rect, img.Extension, true)
@@ -97,46 +107,338 @@ func TestImage_Add(t *testing.T) {
 		// Assert
 		assert.Nil(t, err)
 	})
-}
-
-func TestImage_GetImageInfo(t *testing.T) {
-	t.Run("when RegisterImageOptionsReader return nil, should return nil", func(t *testing.T) {
+	t.Run("when same image is added twice, should register it under the same content name", func(t *testing.T) {
 		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := fixture.RectProp()
 		img := fixture.ImageEntity()
-		options := gofpdf.ImageOptions{
-			ReadDpi:   false,
-			ImageType: string(img.Extension),
+		other := entity.Image{Bytes: []byte{4, 5, 6}, Extension: extension.Png}
+		var names []string
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().RegisterImageOptionsReader(mock.Anything, mock.Anything, mock.Anything).Return(&gofpdf.ImageInfoType{})
+		pdf.EXPECT().Image(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, true, "", 0, "").
+			Run(func(name string, _, _, _, _ float64, _ bool, _ string, _ int, _ string) {
+				names = append(names, name)
+			})
+
+		image := gofpdf2.NewImage(pdf, math.New(), nil)
+
+		// Act
+		_ = image.Add(&img, &cell, &margins, &rect, img.Extension, true)
+		_ = image.Add(&img, &cell, &margins, &rect, img.Extension, true)
+		_ = image.Add(&other, &cell, &margins, &rect, other.Extension, true)
+
+		// Assert
+		assert.Len(t, names, 3)
+		assert.Equal(t, names[0], names[1])
+		assert.NotEqual(t, names[0], names[2])
+	})
+	t.Run("when image is svg, should draw paths as vector", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := fixture.RectProp()
+		img := entity.Image{
+			Bytes:     []byte(`<svg viewBox="0 0 10 10"><rect width="5" height="5" fill="#ff0000" stroke="blue"/></svg>`),
+			Extension: extension.Svg,
 		}
 
 		pdf := mocks.NewFpdf(t)
-		pdf.EXPECT().RegisterImageOptionsReader(mock.Anything, options, bytes.NewReader(img.Bytes)).Return(nil)
+		pdf.EXPECT().ClipRect(30.0, 35.0, 98.0, 98.0, false)
+		pdf.EXPECT().SetFillColor(255, 0, 0)
+		pdf.EXPECT().SetDrawColor(0, 0, 255)
+		pdf.EXPECT().SetLineWidth(9.8)
+		pdf.EXPECT().MoveTo(30.0, 35.0)
+		pdf.EXPECT().LineTo(79.0, 35.0)
+		pdf.EXPECT().LineTo(79.0, 84.0)
+		pdf.EXPECT().LineTo(30.0, 84.0)
+		pdf.EXPECT().ClosePath()
+		pdf.EXPECT().DrawPath("FD")
+		pdf.EXPECT().ClipEnd()
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().SetLineWidth(0.2)
 
-		image := gofpdf2.NewImage(pdf, mocks.NewMath(t))
+		image := gofpdf2.NewImage(pdf, math.New(), nil)
 
 		// Act
-		info, _ := image.GetImageInfo(&img, img.Extension)
+		err := image.Add(&img, &cell, &margins, &rect, extension.Svg, false)
 
 		// Assert
-		assert.Nil(t, info)
+		assert.Nil(t, err)
+		pdf.AssertNotCalled(t, "RegisterImageOptionsReader", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("when svg is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := fixture.RectProp()
+		img := entity.Image{Bytes: []byte("<svg><path d=\"M 0 0 X\"/></svg>"), Extension: extension.Svg}
+
+		image := gofpdf2.NewImage(mocks.NewFpdf(t), math.New(), nil)
+
+		// Act
+		err := image.Add(&img, &cell, &margins, &rect, extension.Svg, false)
+
+		// Assert
+		assert.NotNil(t, err)
 	})
+}
+
+func TestImage_Add_Fit(t *testing.T) {
+	// svg is an image with 2:1 proportion filled by a black rectangle.
+	svg := entity.Image{
+		Bytes:     []byte(`<svg viewBox="0 0 20 10"><rect width="20" height="10"/></svg>`),
+		Extension: extension.Svg,
+	}
+
+	expectRectangle := func(pdf *mocks.Fpdf, left, top, right, bottom float64) {
+		pdf.EXPECT().SetFillColor(0, 0, 0)
+		pdf.EXPECT().MoveTo(left, top)
+		pdf.EXPECT().LineTo(right, top)
+		pdf.EXPECT().LineTo(right, bottom)
+		pdf.EXPECT().LineTo(left, bottom)
+		pdf.EXPECT().ClosePath()
+		pdf.EXPECT().DrawPath("F")
+		pdf.EXPECT().ClipEnd()
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().SetLineWidth(0.2)
+	}
 
-	t.Run("when RegisterImageOptionsReader return info, should return info", func(t *testing.T) {
+	t.Run("when fit is cover, should fill the cell and clip the overflow", func(t *testing.T) {
 		// Arrange
-		img := fixture.ImageEntity()
-		options := gofpdf.ImageOptions{
-			ReadDpi:   false,
-			ImageType: string(img.Extension),
-		}
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := props.Rect{Fit: objectfit.Cover}
+		rect.MakeValid()
 
 		pdf := mocks.NewFpdf(t)
-		pdf.EXPECT().RegisterImageOptionsReader(mock.Anything, options, bytes.NewReader(img.Bytes)).Return(&gofpdf.ImageInfoType{})
+		pdf.EXPECT().ClipRect(20.0, 25.0, 100.0, 150.0, false)
+		expectRectangle(pdf, -80, 25, 220, 175)
+
+		image := gofpdf2.NewImage(pdf, math.New(), nil)
+
+		// Act
+		err := image.Add(&svg, &cell, &margins, &rect, extension.Svg, false)
+
+		// Assert
+		assert.Nil(t, err)
+	})
+	t.Run("when fit is cover and align is left, should keep the left side", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := props.Rect{Fit: objectfit.Cover, Align: align.Left}
+		rect.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().ClipRect(20.0, 25.0, 100.0, 150.0, false)
+		expectRectangle(pdf, 20, 25, 320, 175)
+
+		image := gofpdf2.NewImage(pdf, math.New(), nil)
+
+		// Act
+		err := image.Add(&svg, &cell, &margins, &rect, extension.Svg, false)
+
+		// Assert
+		assert.Nil(t, err)
+	})
+	t.Run("when fit is fill and anchored to right bottom, should stretch inside the anchored region", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := props.Rect{Fit: objectfit.Fill, Percent: 50, Align: align.Right, VerticalAlign: align.Bottom}
+		rect.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().ClipRect(70.0, 100.0, 50.0, 75.0, false)
+		expectRectangle(pdf, 70, 100, 120, 175)
+
+		image := gofpdf2.NewImage(pdf, math.New(), nil)
+
+		// Act
+		err := image.Add(&svg, &cell, &margins, &rect, extension.Svg, false)
+
+		// Assert
+		assert.Nil(t, err)
+	})
+	t.Run("when crop is set, should fit the region and clip the rest of the image", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := props.Rect{Crop: &props.Crop{Left: 50, Width: 50}}
+		rect.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().ClipRect(20.0, 25.0, 100.0, 100.0, false)
+		expectRectangle(pdf, -80, 25, 120, 125)
+
+		image := gofpdf2.NewImage(pdf, math.New(), nil)
 
-		image := gofpdf2.NewImage(pdf, mocks.NewMath(t))
+		// Act
+		err := image.Add(&svg, &cell, &margins, &rect, extension.Svg, false)
+
+		// Assert
+		assert.Nil(t, err)
+	})
+}
+
+func TestImage_Add_Effects(t *testing.T) {
+	// svg is an image with 2:1 proportion filled by a red rectangle.
+	svg := entity.Image{
+		Bytes:     []byte(`<svg viewBox="0 0 20 10"><rect width="20" height="10" fill="red"/></svg>`),
+		Extension: extension.Svg,
+	}
+
+	expectRectangle := func(pdf *mocks.Fpdf, red, green, blue int) {
+		pdf.EXPECT().SetFillColor(red, green, blue)
+		pdf.EXPECT().MoveTo(20.0, 25.0)
+		pdf.EXPECT().LineTo(120.0, 25.0)
+		pdf.EXPECT().LineTo(120.0, 75.0)
+		pdf.EXPECT().LineTo(20.0, 75.0)
+		pdf.EXPECT().ClosePath()
+		pdf.EXPECT().DrawPath("F")
+		pdf.EXPECT().ClipEnd()
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().SetLineWidth(0.2)
+	}
+
+	t.Run("when clip is circle and opacity is set, should clip a circle and draw transparent", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := props.Rect{Clip: clip.Circle, Opacity: 0.5}
+		rect.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().ClipCircle(70.0, 50.0, 25.0, false)
+		pdf.EXPECT().SetAlpha(0.5, "Normal")
+		pdf.EXPECT().SetAlpha(1.0, "Normal")
+		expectRectangle(pdf, 255, 0, 0)
+
+		image := gofpdf2.NewImage(pdf, math.New(), nil)
 
 		// Act
-		info, _ := image.GetImageInfo(&img, img.Extension)
+		err := image.Add(&svg, &cell, &margins, &rect, extension.Svg, false)
 
 		// Assert
-		assert.NotNil(t, info)
+		assert.Nil(t, err)
+	})
+	t.Run("when clip is rounded rect without radius, should use a tenth of the smallest side", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := props.Rect{Clip: clip.RoundedRect}
+		rect.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().ClipRoundedRect(20.0, 25.0, 100.0, 50.0, 5.0, false)
+		expectRectangle(pdf, 255, 0, 0)
+
+		image := gofpdf2.NewImage(pdf, math.New(), nil)
+
+		// Act
+		err := image.Add(&svg, &cell, &margins, &rect, extension.Svg, false)
+
+		// Assert
+		assert.Nil(t, err)
+	})
+	t.Run("when alt text is set, should mark the image with the alternative description", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := props.Rect{AltText: "Logo é"}
+		rect.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().RawWriteStr("/Span <</Alt <FEFF004C006F0067006F002000E9>>> BDC")
+		pdf.EXPECT().ClipRect(20.0, 25.0, 100.0, 50.0, false)
+		expectRectangle(pdf, 255, 0, 0)
+		pdf.EXPECT().RawWriteStr("EMC")
+
+		image := gofpdf2.NewImage(pdf, math.New(), nil)
+
+		// Act
+		err := image.Add(&svg, &cell, &margins, &rect, extension.Svg, false)
+
+		// Assert
+		assert.Nil(t, err)
+	})
+	t.Run("when svg is grayscale, should draw colors as gray", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := props.Rect{Grayscale: true}
+		rect.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().ClipRect(20.0, 25.0, 100.0, 50.0, false)
+		expectRectangle(pdf, 76, 76, 76)
+
+		image := gofpdf2.NewImage(pdf, math.New(), nil)
+
+		// Act
+		err := image.Add(&svg, &cell, &margins, &rect, extension.Svg, false)
+
+		// Assert
+		assert.Nil(t, err)
+	})
+	t.Run("when png is grayscale, should register a gray image keeping transparency", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		margins := fixture.MarginsEntity()
+		rect := props.Rect{Grayscale: true}
+		rect.MakeValid()
+		img := entity.Image{Bytes: encode(t, png.Encode), Extension: extension.Png}
+		var registered goimage.Image
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().RegisterImageOptionsReader(mock.Anything, mock.Anything, mock.Anything).
+			Run(func(_ string, _ gofpdf.ImageOptions, reader io.Reader) {
+				registered, _ = png.Decode(reader)
+			}).
+			Return(&gofpdf.ImageInfoType{})
+		pdf.EXPECT().Image(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, false, "", 0, "")
+
+		image := gofpdf2.NewImage(pdf, math.New(), nil)
+
+		// Act
+		err := image.Add(&img, &cell, &margins, &rect, img.Extension, false)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, color.NRGBA{R: 76, G: 76, B: 76, A: 255}, registered.At(1, 1))
+		assert.Equal(t, uint8(0), registered.At(0, 0).(color.NRGBA).A)
+	})
+}
+
+func TestImage_GetDimensions(t *testing.T) {
+	t.Run("when image is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		img := fixture.ImageEntity()
+		image := gofpdf2.NewImage(mocks.NewFpdf(t), mocks.NewMath(t), nil)
+
+		// Act
+		dimensions, err := image.GetDimensions(&img)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, dimensions)
+	})
+	t.Run("when image is valid, should return its size without loading it in pdf", func(t *testing.T) {
+		// Arrange
+		img := entity.Image{Bytes: encode(t, png.Encode), Extension: extension.Png}
+		image := gofpdf2.NewImage(mocks.NewFpdf(t), mocks.NewMath(t), nil)
+
+		// Act
+		dimensions, err := image.GetDimensions(&img)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, &entity.Dimensions{Width: 3, Height: 2}, dimensions)
 	})
 }
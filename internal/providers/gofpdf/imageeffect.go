@@ -0,0 +1,63 @@
+package gofpdf
+
+import (
+	"bytes"
+	goimage "image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// grayscaleJPEGQuality is high enough to avoid visible loss when a jpeg is re-encoded in gray.
+const grayscaleJPEGQuality = 95
+
+// toGrayscale converts a jpeg or png image to shades of gray, the transparency of png images is kept.
+func toGrayscale(img *entity.Image) (*entity.Image, error) {
+	decoded, _, err := goimage.Decode(bytes.NewReader(img.Bytes))
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	bounds := decoded.Bounds()
+
+	if img.Extension == extension.Jpg || img.Extension == extension.Jpeg {
+		gray := goimage.NewGray(bounds)
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				gray.Set(x, y, decoded.At(x, y))
+			}
+		}
+
+		if err := jpeg.Encode(&buffer, gray, &jpeg.Options{Quality: grayscaleJPEGQuality}); err != nil {
+			return nil, err
+		}
+
+		return &entity.Image{Bytes: buffer.Bytes(), Extension: extension.Jpg}, nil
+	}
+
+	gray := goimage.NewNRGBA(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			pixel := color.NRGBAModel.Convert(decoded.At(x, y)).(color.NRGBA)
+			luminance := color.GrayModel.Convert(color.RGBA{R: pixel.R, G: pixel.G, B: pixel.B, A: 255}).(color.Gray).Y
+			gray.SetNRGBA(x, y, color.NRGBA{R: luminance, G: luminance, B: luminance, A: pixel.A})
+		}
+	}
+
+	if err := png.Encode(&buffer, gray); err != nil {
+		return nil, err
+	}
+
+	return &entity.Image{Bytes: buffer.Bytes(), Extension: extension.Png}, nil
+}
+
+// toGrayColor converts a color to the gray with the same luminance.
+func toGrayColor(c *props.Color) *props.Color {
+	luminance := int(color.GrayModel.Convert(color.RGBA{R: uint8(c.Red), G: uint8(c.Green), B: uint8(c.Blue), A: 255}).(color.Gray).Y)
+	return &props.Color{Red: luminance, Green: luminance, Blue: luminance}
+}
@@ -0,0 +1,197 @@
+package gofpdf
+
+import (
+	"bytes"
+	goimage "image"
+	"image/jpeg"
+	"image/png"
+	"math"
+	"sync"
+
+	"github.com/google/uuid"
+	"golang.org/x/image/draw"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/metrics"
+)
+
+const mmPerInch = 25.4
+
+// ImageMetrics accumulates the size of the images before and after the optimization,
+// it is shared by the providers of all chunks.
+type ImageMetrics struct {
+	mutex        sync.Mutex
+	images       map[uuid.UUID]bool
+	originalSize int
+	embeddedSize int
+}
+
+// NewImageMetrics create an ImageMetrics.
+func NewImageMetrics() *ImageMetrics {
+	return &ImageMetrics{
+		images: make(map[uuid.UUID]bool),
+	}
+}
+
+// Add registers the size of an image before and after the optimization,
+// an embedded image is counted once even when it is placed many times.
+func (i *ImageMetrics) Add(imageID uuid.UUID, originalSize, embeddedSize int) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if i.images[imageID] {
+		return
+	}
+
+	i.images[imageID] = true
+	i.originalSize += originalSize
+	i.embeddedSize += embeddedSize
+}
+
+// Reset clears the registered images, so a generation does not report the images of the previous ones.
+func (i *ImageMetrics) Reset() {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.images = make(map[uuid.UUID]bool)
+	i.originalSize = 0
+	i.embeddedSize = 0
+}
+
+// GetSizeMetrics returns the original, embedded and saved sizes, or nil when no image was optimized.
+func (i *ImageMetrics) GetSizeMetrics() []metrics.SizeMetric {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if i.originalSize == 0 {
+		return nil
+	}
+
+	return []metrics.SizeMetric{
+		{Key: "images_original_size", Size: metrics.Size{Value: float64(i.originalSize), Scale: metrics.Byte}},
+		{Key: "images_embedded_size", Size: metrics.Size{Value: float64(i.embeddedSize), Scale: metrics.Byte}},
+		{Key: "images_saved_size", Size: metrics.Size{Value: float64(i.originalSize - i.embeddedSize), Scale: metrics.Byte}},
+	}
+}
+
+type optimizationKey struct {
+	imageID uuid.UUID
+	width   float64
+	height  float64
+}
+
+type optimizedImage struct {
+	image        *entity.Image
+	imageID      uuid.UUID
+	originalSize int
+}
+
+type imageOptimizer struct {
+	cfg       *entity.ImageOptimization
+	metrics   *ImageMetrics
+	optimized map[optimizationKey]*optimizedImage
+}
+
+// NewImageOptimizer create an image optimizer, which downsamples and re-encodes images before being embedded.
+func NewImageOptimizer(cfg *entity.ImageOptimization, metrics *ImageMetrics) *imageOptimizer {
+	return &imageOptimizer{
+		cfg:       cfg,
+		metrics:   metrics,
+		optimized: make(map[optimizationKey]*optimizedImage),
+	}
+}
+
+// Optimize resamples the image to the max dpi at the printed size in millimeters and re-encodes it,
+// the original image is returned when the optimization does not reduce its size.
+// Repeated placements of the same image at the same size reuse the first result.
+func (o *imageOptimizer) Optimize(img *entity.Image, width, height float64) *entity.Image {
+	isJpeg := img.Extension == extension.Jpg || img.Extension == extension.Jpeg
+	if !isJpeg && img.Extension != extension.Png {
+		return img
+	}
+
+	key := optimizationKey{imageID: getImageID(img.Bytes, img.Extension), width: width, height: height}
+	optimized, ok := o.optimized[key]
+	if !ok {
+		optimized = o.getOptimizedImage(img, key.imageID, width, height, isJpeg)
+		o.optimized[key] = optimized
+	}
+
+	// The metrics are added on every placement, as they are reset by each generation of the document.
+	if o.metrics != nil {
+		o.metrics.Add(optimized.imageID, optimized.originalSize, len(optimized.image.Bytes))
+	}
+
+	return optimized.image
+}
+
+func (o *imageOptimizer) getOptimizedImage(img *entity.Image, imageID uuid.UUID, width, height float64,
+	isJpeg bool,
+) *optimizedImage {
+	optimized := o.optimize(img, width, height, isJpeg)
+	if optimized == nil || len(optimized.Bytes) >= len(img.Bytes) {
+		return &optimizedImage{image: img, imageID: imageID, originalSize: len(img.Bytes)}
+	}
+
+	return &optimizedImage{
+		image:        optimized,
+		imageID:      getImageID(optimized.Bytes, optimized.Extension),
+		originalSize: len(img.Bytes),
+	}
+}
+
+func (o *imageOptimizer) optimize(img *entity.Image, width, height float64, isJpeg bool) *entity.Image {
+	config, _, err := goimage.DecodeConfig(bytes.NewReader(img.Bytes))
+	if err != nil {
+		return nil
+	}
+
+	targetWidth := int(math.Ceil(width / mmPerInch * o.cfg.MaxDPI))
+	targetHeight := int(math.Ceil(height / mmPerInch * o.cfg.MaxDPI))
+
+	resample := o.cfg.MaxDPI > 0 && targetWidth > 0 && targetHeight > 0 &&
+		config.Width > targetWidth && config.Height > targetHeight
+	reencode := o.cfg.JPEGQuality > 0 && isJpeg
+	if !resample && !reencode {
+		return nil
+	}
+
+	decoded, _, err := goimage.Decode(bytes.NewReader(img.Bytes))
+	if err != nil {
+		return nil
+	}
+
+	if resample {
+		resampled := goimage.NewRGBA(goimage.Rect(0, 0, targetWidth, targetHeight))
+		draw.BiLinear.Scale(resampled, resampled.Bounds(), decoded, decoded.Bounds(), draw.Src, nil)
+		decoded = resampled
+	}
+
+	var buffer bytes.Buffer
+
+	// Photos are stored as jpeg, images with transparency keep the png format.
+	if isJpeg || (o.cfg.JPEGQuality > 0 && isOpaque(decoded)) {
+		quality := o.cfg.JPEGQuality
+		if quality == 0 {
+			quality = jpeg.DefaultQuality
+		}
+
+		if err := jpeg.Encode(&buffer, decoded, &jpeg.Options{Quality: quality}); err != nil {
+			return nil
+		}
+
+		return &entity.Image{Bytes: buffer.Bytes(), Extension: extension.Jpg}
+	}
+
+	if err := png.Encode(&buffer, decoded); err != nil {
+		return nil
+	}
+
+	return &entity.Image{Bytes: buffer.Bytes(), Extension: extension.Png}
+}
+
+func isOpaque(img goimage.Image) bool {
+	opaque, ok := img.(interface{ Opaque() bool })
+	return ok && opaque.Opaque()
+}
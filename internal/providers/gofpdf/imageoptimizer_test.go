@@ -0,0 +1,152 @@
+package gofpdf_test
+
+import (
+	"bytes"
+	goimage "image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/metrics"
+)
+
+func TestImageOptimizer_Optimize(t *testing.T) {
+	t.Run("when image is larger than max dpi, should downsample it", func(t *testing.T) {
+		// Arrange
+		img := &entity.Image{Bytes: noisyImage(t, 400, 400, png.Encode), Extension: extension.Png}
+		imageMetrics := gofpdf.NewImageMetrics()
+		sut := gofpdf.NewImageOptimizer(&entity.ImageOptimization{MaxDPI: 100}, imageMetrics)
+
+		// Act
+		optimized := sut.Optimize(img, 25.4, 25.4)
+
+		// Assert
+		config, _, err := goimage.DecodeConfig(bytes.NewReader(optimized.Bytes))
+		assert.Nil(t, err)
+		assert.Equal(t, 100, config.Width)
+		assert.Equal(t, 100, config.Height)
+		assert.Equal(t, extension.Png, optimized.Extension)
+		assert.Less(t, len(optimized.Bytes), len(img.Bytes))
+	})
+	t.Run("when image is smaller than max dpi, should keep it", func(t *testing.T) {
+		// Arrange
+		img := &entity.Image{Bytes: noisyImage(t, 50, 50, png.Encode), Extension: extension.Png}
+		sut := gofpdf.NewImageOptimizer(&entity.ImageOptimization{MaxDPI: 300}, nil)
+
+		// Act
+		optimized := sut.Optimize(img, 25.4, 25.4)
+
+		// Assert
+		assert.Equal(t, img, optimized)
+	})
+	t.Run("when jpeg quality is defined, should re-encode jpeg", func(t *testing.T) {
+		// Arrange
+		content := noisyImage(t, 100, 100, func(writer io.Writer, img goimage.Image) error {
+			return jpeg.Encode(writer, img, &jpeg.Options{Quality: 100})
+		})
+		img := &entity.Image{Bytes: content, Extension: extension.Jpg}
+		sut := gofpdf.NewImageOptimizer(&entity.ImageOptimization{JPEGQuality: 30}, nil)
+
+		// Act
+		optimized := sut.Optimize(img, 100, 100)
+
+		// Assert
+		assert.Equal(t, extension.Jpg, optimized.Extension)
+		assert.Less(t, len(optimized.Bytes), len(img.Bytes))
+	})
+	t.Run("when image was optimized before the metrics reset, should add it again to the metrics", func(t *testing.T) {
+		// Arrange
+		img := &entity.Image{Bytes: noisyImage(t, 400, 400, png.Encode), Extension: extension.Png}
+		imageMetrics := gofpdf.NewImageMetrics()
+		sut := gofpdf.NewImageOptimizer(&entity.ImageOptimization{MaxDPI: 100}, imageMetrics)
+		sut.Optimize(img, 25.4, 25.4)
+		expected := imageMetrics.GetSizeMetrics()
+		imageMetrics.Reset()
+
+		// Act
+		sut.Optimize(img, 25.4, 25.4)
+
+		// Assert
+		assert.Equal(t, expected, imageMetrics.GetSizeMetrics())
+	})
+	t.Run("when image is not raster, should keep it", func(t *testing.T) {
+		// Arrange
+		img := &entity.Image{Bytes: []byte("<svg></svg>"), Extension: extension.Svg}
+		imageMetrics := gofpdf.NewImageMetrics()
+		sut := gofpdf.NewImageOptimizer(&entity.ImageOptimization{MaxDPI: 10, JPEGQuality: 10}, imageMetrics)
+
+		// Act
+		optimized := sut.Optimize(img, 10, 10)
+
+		// Assert
+		assert.Equal(t, img, optimized)
+		assert.Nil(t, imageMetrics.GetSizeMetrics())
+	})
+}
+
+func TestImageMetrics_GetSizeMetrics(t *testing.T) {
+	t.Run("when no image was added, should return nil", func(t *testing.T) {
+		// Arrange
+		sut := gofpdf.NewImageMetrics()
+
+		// Act
+		sizeMetrics := sut.GetSizeMetrics()
+
+		// Assert
+		assert.Nil(t, sizeMetrics)
+	})
+	t.Run("when images were added, should return sums and savings counting each image once", func(t *testing.T) {
+		// Arrange
+		sut := gofpdf.NewImageMetrics()
+		sut.Add(uuid.MustParse("00000000-0000-0000-0000-000000000001"), 1000, 400)
+		sut.Add(uuid.MustParse("00000000-0000-0000-0000-000000000002"), 500, 500)
+		sut.Add(uuid.MustParse("00000000-0000-0000-0000-000000000001"), 1000, 400)
+
+		// Act
+		sizeMetrics := sut.GetSizeMetrics()
+
+		// Assert
+		assert.Equal(t, []metrics.SizeMetric{
+			{Key: "images_original_size", Size: metrics.Size{Value: 1500, Scale: metrics.Byte}},
+			{Key: "images_embedded_size", Size: metrics.Size{Value: 900, Scale: metrics.Byte}},
+			{Key: "images_saved_size", Size: metrics.Size{Value: 600, Scale: metrics.Byte}},
+		}, sizeMetrics)
+	})
+}
+
+func TestImageMetrics_Reset(t *testing.T) {
+	// Arrange
+	imageID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	sut := gofpdf.NewImageMetrics()
+	sut.Add(imageID, 1000, 400)
+
+	// Act
+	sut.Reset()
+
+	// Assert
+	assert.Nil(t, sut.GetSizeMetrics())
+	sut.Add(imageID, 1000, 400)
+	assert.Len(t, sut.GetSizeMetrics(), 3)
+}
+
+func noisyImage(t *testing.T, width, height int, encoder func(writer io.Writer, img goimage.Image) error) []byte {
+	img := goimage.NewRGBA(goimage.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * 7), G: uint8(y * 13), B: uint8(x * y), A: 255})
+		}
+	}
+
+	var buffer bytes.Buffer
+	assert.Nil(t, encoder(&buffer, img))
+
+	return buffer.Bytes()
+}
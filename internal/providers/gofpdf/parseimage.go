@@ -1,19 +1,106 @@
 package gofpdf
 
 import (
+	"bytes"
 	"errors"
+	goimage "image"
+	"image/gif"
+	"image/png"
+
+	"golang.org/x/image/bmp"
+	"golang.org/x/image/tiff"
+	"golang.org/x/image/webp"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 )
 
-func FromBytes(bytes []byte, ext extension.Type) (*entity.Image, error) {
+// decoders are the formats which cannot be embedded directly and are converted to png.
+var decoders = map[extension.Type]func(reader *bytes.Reader) (goimage.Image, error){
+	extension.Gif:  func(reader *bytes.Reader) (goimage.Image, error) { return gif.Decode(reader) },
+	extension.Bmp:  func(reader *bytes.Reader) (goimage.Image, error) { return bmp.Decode(reader) },
+	extension.Tiff: func(reader *bytes.Reader) (goimage.Image, error) { return tiff.Decode(reader) },
+	extension.Webp: func(reader *bytes.Reader) (goimage.Image, error) { return webp.Decode(reader) },
+}
+
+// FromBytes creates an image from bytes, the format is detected by the content and the extension
+// is only used when the content is not recognized. Gif, bmp, tiff and webp images are converted to png.
+func FromBytes(content []byte, ext extension.Type) (*entity.Image, error) {
+	if detected, ok := detectExtension(content); ok {
+		ext = detected
+	}
+
 	if !ext.IsValid() {
 		return nil, errors.New("invalid image format")
 	}
 
+	decode, ok := decoders[ext]
+	if !ok {
+		return &entity.Image{
+			Bytes:     content,
+			Extension: ext,
+		}, nil
+	}
+
+	pngBytes, err := toPng(content, decode)
+	if err != nil {
+		return nil, err
+	}
+
 	return &entity.Image{
-		Bytes:     bytes,
-		Extension: ext,
+		Bytes:     pngBytes,
+		Extension: extension.Png,
 	}, nil
 }
+
+// detectExtension identifies the image format by its magic bytes.
+func detectExtension(content []byte) (extension.Type, bool) {
+	switch {
+	case bytes.HasPrefix(content, []byte{0xFF, 0xD8, 0xFF}):
+		return extension.Jpg, true
+	case bytes.HasPrefix(content, []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1A, '\n'}):
+		return extension.Png, true
+	case bytes.HasPrefix(content, []byte("GIF87a")), bytes.HasPrefix(content, []byte("GIF89a")):
+		return extension.Gif, true
+	case bytes.HasPrefix(content, []byte("BM")):
+		return extension.Bmp, true
+	case bytes.HasPrefix(content, []byte("II*\x00")), bytes.HasPrefix(content, []byte("MM\x00*")):
+		return extension.Tiff, true
+	case len(content) >= 12 && bytes.HasPrefix(content, []byte("RIFF")) && bytes.Equal(content[8:12], []byte("WEBP")):
+		return extension.Webp, true
+	case isSvg(content):
+		return extension.Svg, true
+	}
+
+	return "", false
+}
+
+// isSvg checks if the content is a xml document with a svg element near its beginning.
+func isSvg(content []byte) bool {
+	content = bytes.TrimPrefix(content, []byte("\xEF\xBB\xBF"))
+	content = bytes.TrimLeft(content, " \t\r\n")
+	if !bytes.HasPrefix(content, []byte("<")) {
+		return false
+	}
+
+	const headerSize = 1024
+	if len(content) > headerSize {
+		content = content[:headerSize]
+	}
+
+	return bytes.Contains(content, []byte("<svg"))
+}
+
+func toPng(content []byte, decode func(reader *bytes.Reader) (goimage.Image, error)) ([]byte, error) {
+	img, err := decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
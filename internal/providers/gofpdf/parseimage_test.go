@@ -1,14 +1,29 @@
 package gofpdf_test
 
 import (
+	"bytes"
+	goimage "image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
 	"testing"
 
+	"golang.org/x/image/bmp"
+	"golang.org/x/image/tiff"
+
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/stretchr/testify/assert"
 )
 
+// webpImage is a 1x1 lossless webp image.
+var webpImage = []byte{
+	0x52, 0x49, 0x46, 0x46, 0x1a, 0x0, 0x0, 0x0, 0x57, 0x45, 0x42, 0x50, 0x56, 0x50, 0x38, 0x4c, 0xd,
+	0x0, 0x0, 0x0, 0x2f, 0x0, 0x0, 0x0, 0x10, 0x7, 0x10, 0x11, 0x11, 0x88, 0x88, 0xfe, 0x7, 0x0,
+}
+
 func TestFromBytes(t *testing.T) {
 	t.Run("when extension is not valid, should return error", func(t *testing.T) {
 		// Act
@@ -26,4 +41,105 @@ func TestFromBytes(t *testing.T) {
 		assert.NotNil(t, img)
 		assert.Nil(t, err)
 	})
+	t.Run("when content is png and extension is wrong, should detect png", func(t *testing.T) {
+		// Arrange
+		content := encode(t, png.Encode)
+
+		// Act
+		img, err := gofpdf.FromBytes(content, extension.Jpg)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, extension.Png, img.Extension)
+		assert.Equal(t, content, img.Bytes)
+	})
+	t.Run("when content is jpg and extension is missing, should detect jpg", func(t *testing.T) {
+		// Act
+		img, err := gofpdf.FromBytes([]byte{0xFF, 0xD8, 0xFF, 0xE0}, "")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, extension.Jpg, img.Extension)
+	})
+	t.Run("when content is svg and extension is missing, should detect svg", func(t *testing.T) {
+		// Act
+		img, err := gofpdf.FromBytes([]byte("\n<?xml version=\"1.0\"?>\n<svg width=\"1\"></svg>"), "")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, extension.Svg, img.Extension)
+	})
+	t.Run("when content is gif, should convert to png", func(t *testing.T) {
+		// Arrange
+		content := encode(t, func(writer io.Writer, img goimage.Image) error {
+			return gif.Encode(writer, img, nil)
+		})
+
+		// Act
+		img, err := gofpdf.FromBytes(content, "")
+
+		// Assert
+		assert.Nil(t, err)
+		assertPng(t, img.Bytes)
+		assert.Equal(t, extension.Png, img.Extension)
+	})
+	t.Run("when content is bmp, should convert to png", func(t *testing.T) {
+		// Arrange
+		content := encode(t, bmp.Encode)
+
+		// Act
+		img, err := gofpdf.FromBytes(content, extension.Png)
+
+		// Assert
+		assert.Nil(t, err)
+		assertPng(t, img.Bytes)
+		assert.Equal(t, extension.Png, img.Extension)
+	})
+	t.Run("when content is tiff, should convert to png", func(t *testing.T) {
+		// Arrange
+		content := encode(t, func(writer io.Writer, img goimage.Image) error {
+			return tiff.Encode(writer, img, nil)
+		})
+
+		// Act
+		img, err := gofpdf.FromBytes(content, extension.Tiff)
+
+		// Assert
+		assert.Nil(t, err)
+		assertPng(t, img.Bytes)
+		assert.Equal(t, extension.Png, img.Extension)
+	})
+	t.Run("when content is webp, should convert to png", func(t *testing.T) {
+		// Act
+		img, err := gofpdf.FromBytes(webpImage, extension.Webp)
+
+		// Assert
+		assert.Nil(t, err)
+		assertPng(t, img.Bytes)
+		assert.Equal(t, extension.Png, img.Extension)
+	})
+	t.Run("when content has gif signature but is corrupted, should return error", func(t *testing.T) {
+		// Act
+		img, err := gofpdf.FromBytes([]byte("GIF89a corrupted"), extension.Gif)
+
+		// Assert
+		assert.Nil(t, img)
+		assert.NotNil(t, err)
+	})
+}
+
+func encode(t *testing.T, encoder func(writer io.Writer, img goimage.Image) error) []byte {
+	img := goimage.NewRGBA(goimage.Rect(0, 0, 3, 2))
+	img.Set(1, 1, color.RGBA{R: 255, A: 255})
+
+	var buffer bytes.Buffer
+	assert.Nil(t, encoder(&buffer, img))
+
+	return buffer.Bytes()
+}
+
+func assertPng(t *testing.T, content []byte) {
+	img, err := png.Decode(bytes.NewReader(content))
+	assert.Nil(t, err)
+	assert.NotNil(t, img)
 }
@@ -2,6 +2,7 @@ package gofpdf
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -13,7 +14,9 @@ import (
 	"github.com/johnfercher/maroto/v2/internal/merror"
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/cellwriter"
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+	"github.com/johnfercher/maroto/v2/internal/svg"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/objectfit"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
@@ -26,6 +29,9 @@ type provider struct {
 	code       core.Code
 	image      core.Image
 	line       core.Line
+	shape      core.Shape
+	chart      core.Chart
+	form       core.Form
 	cache      cache.Cache
 	cellWriter cellwriter.CellWriter
 	cfg        *entity.Config
@@ -40,6 +46,9 @@ func New(dep *Dependencies) core.Provider {
 		code:       dep.Code,
 		image:      dep.Image,
 		line:       dep.Line,
+		shape:      dep.Shape,
+		chart:      dep.Chart,
+		form:       dep.Form,
 		cellWriter: dep.CellWriter,
 		cfg:        dep.Cfg,
 		cache:      dep.Cache,
@@ -54,6 +63,10 @@ func (g *provider) GetLinesQuantity(text string, textProp *props.Text, colWidth
 	return g.text.GetLinesQuantity(text, textProp, colWidth)
 }
 
+func (g *provider) GetStringWidth(text string, textProp *props.Text) float64 {
+	return g.text.GetStringWidth(text, textProp)
+}
+
 func (g *provider) GetFontHeight(prop *props.Font) float64 {
 	return g.font.GetHeight(prop.Family, prop.Style, prop.Size)
 }
@@ -62,28 +75,86 @@ func (g *provider) AddLine(cell *entity.Cell, prop *props.Line) {
 	g.line.Add(cell, prop)
 }
 
+func (g *provider) AddRectangle(cell *entity.Cell, prop *props.Shape) {
+	g.shape.AddRectangle(cell, prop)
+}
+
+func (g *provider) AddEllipse(cell *entity.Cell, prop *props.Shape) {
+	g.shape.AddEllipse(cell, prop)
+}
+
+func (g *provider) AddPolygon(points []props.Point, cell *entity.Cell, prop *props.Shape) {
+	err := g.shape.AddPolygon(points, cell, prop)
+	if err != nil {
+		g.text.Add("could not draw polygon", cell, merror.DefaultErrorText)
+	}
+}
+
+func (g *provider) AddPolyline(points []props.Point, cell *entity.Cell, prop *props.Shape) {
+	err := g.shape.AddPolyline(points, cell, prop)
+	if err != nil {
+		g.text.Add("could not draw polyline", cell, merror.DefaultErrorText)
+	}
+}
+
+func (g *provider) AddBezier(points []props.Point, cell *entity.Cell, prop *props.Shape) {
+	err := g.shape.AddBezier(points, cell, prop)
+	if err != nil {
+		g.text.Add("could not draw bezier", cell, merror.DefaultErrorText)
+	}
+}
+
+func (g *provider) AddArrow(from, to props.Point, cell *entity.Cell, prop *props.Shape) {
+	err := g.shape.AddArrow(from, to, cell, prop)
+	if err != nil {
+		g.text.Add("could not draw arrow", cell, merror.DefaultErrorText)
+	}
+}
+
+func (g *provider) AddChart(chart *entity.Chart, cell *entity.Cell, prop *props.Chart) {
+	err := g.chart.Add(chart, cell, prop)
+	if err != nil {
+		g.fpdf.ClearError()
+		g.text.Add("could not draw chart", cell, merror.DefaultErrorText)
+	}
+}
+
+func (g *provider) AddFormField(field *entity.FormField, cell *entity.Cell, prop *props.FormField) {
+	if g.cfg != nil && g.cfg.Protection != nil {
+		g.text.Add("could not add form field to protected document", cell, merror.DefaultErrorText)
+		return
+	}
+
+	err := g.form.Add(field, cell, prop)
+	if err != nil {
+		g.text.Add("could not add form field", cell, merror.DefaultErrorText)
+	}
+}
+
 func (g *provider) AddMatrixCode(code string, cell *entity.Cell, prop *props.Rect) {
-	img, err := g.loadCode(code, "matrix-code-", g.code.GenDataMatrix)
+	generate, ext := g.matrixCodeGenerator(prop)
+	img, err := g.loadCode(code, g.getMatrixCodeImageName(prop), ext, generate)
 	if err != nil {
 		g.text.Add("could not generate matrixcode", cell, merror.DefaultErrorText)
 		return
 	}
 
-	err = g.image.Add(img, cell, g.cfg.Margins, prop, extension.Png, false)
+	err = g.image.Add(img, cell, g.cfg.Margins, prop, ext, false)
 	if err != nil {
 		g.fpdf.ClearError()
 		g.text.Add("could not add matrixcode to document", cell, merror.DefaultErrorText)
 	}
 }
 
-func (g *provider) AddQrCode(code string, cell *entity.Cell, prop *props.Rect) {
-	img, err := g.loadCode(code, "qr-code-", g.code.GenQr)
+func (g *provider) AddQrCode(code string, cell *entity.Cell, prop *props.QrCode) {
+	generate, ext := g.qrCodeGenerator(prop)
+	img, err := g.loadCode(code, g.getQrCodeImageName(prop), ext, generate)
 	if err != nil {
 		g.text.Add("could not generate qrcode", cell, merror.DefaultErrorText)
 		return
 	}
 
-	err = g.image.Add(img, cell, g.cfg.Margins, prop, extension.Png, false)
+	err = g.image.Add(img, cell, g.cfg.Margins, prop.ToRectProp(), ext, false)
 	if err != nil {
 		g.fpdf.ClearError()
 		g.text.Add("could not add qrcode to document", cell, merror.DefaultErrorText)
@@ -91,23 +162,85 @@ func (g *provider) AddQrCode(code string, cell *entity.Cell, prop *props.Rect) {
 }
 
 func (g *provider) AddBarCode(code string, cell *entity.Cell, prop *props.Barcode) {
-	image, err := g.cache.GetImage(g.getBarcodeImageName(fmt.Sprintf("bar-code-%s", code), prop), extension.Png)
-	if err != nil {
-		image, err = g.code.GenBar(code, cell, prop)
-	}
+	image, err := g.loadBarcode(code, cell, prop)
 	if err != nil {
 		g.text.Add("could not generate barcode", cell, merror.DefaultErrorText)
 		return
 	}
 
-	g.cache.AddImage(g.getBarcodeImageName(fmt.Sprintf("bar-code-%s", code), prop), image)
-	err = g.image.Add(image, cell, g.cfg.Margins, prop.ToRectProp(), extension.Png, false)
+	rectCell, rectProp := cell, prop.ToRectProp()
+	if prop.ModuleWidth > 0 {
+		rectCell, err = g.getBarcodeModuleCell(image, cell, prop)
+		if err != nil {
+			g.text.Add(err.Error(), cell, merror.DefaultErrorText)
+			return
+		}
+		rectProp = &props.Rect{Percent: 100, Fit: objectfit.Fill}
+	}
+
+	err = g.image.Add(image, rectCell, g.cfg.Margins, rectProp, getCodeExtension(prop.Vector), false)
 	if err != nil {
 		g.fpdf.ClearError()
 		g.text.Add("could not add barcode to document", cell, merror.DefaultErrorText)
 	}
 }
 
+// getBarcodeModuleCell returns the area of the bars of a barcode sized by module width, the barcode is not scaled,
+// so an error is returned when the cell is narrower than the bars with its quiet zones.
+func (g *provider) getBarcodeModuleCell(image *entity.Image, cell *entity.Cell, prop *props.Barcode) (*entity.Cell, error) {
+	if image.Dimensions == nil {
+		return nil, errors.New("could not read barcode modules")
+	}
+
+	modules := image.Dimensions.Width
+	width := modules * prop.ModuleWidth
+	quietZone := prop.QuietZone * prop.ModuleWidth
+	needed := width + 2*quietZone
+	if !prop.Center {
+		needed += prop.Left
+	}
+
+	if needed > cell.Width {
+		return nil, fmt.Errorf("barcode needs %.1fmm, column has %.1fmm", needed, cell.Width)
+	}
+
+	height := prop.GetModuleHeight(modules)
+	x, y := prop.Left+quietZone, prop.Top
+	if prop.Center {
+		x, y = (cell.Width-width)/2, (cell.Height-height)/2
+	}
+
+	return &entity.Cell{X: cell.X + x, Y: cell.Y + y, Width: width, Height: height}, nil
+}
+
+func (g *provider) AddPDF417(code string, cell *entity.Cell, prop *props.PDF417) {
+	img, err := g.loadCode(code, g.getPDF417ImageName(prop), extension.Png, g.pdf417Generator(prop))
+	if err != nil {
+		g.text.Add("could not generate pdf417", cell, merror.DefaultErrorText)
+		return
+	}
+
+	err = g.image.Add(img, cell, g.cfg.Margins, prop.ToRectProp(), extension.Png, false)
+	if err != nil {
+		g.fpdf.ClearError()
+		g.text.Add("could not add pdf417 to document", cell, merror.DefaultErrorText)
+	}
+}
+
+func (g *provider) AddAztec(code string, cell *entity.Cell, prop *props.Aztec) {
+	img, err := g.loadCode(code, g.getAztecImageName(prop), extension.Png, g.aztecGenerator(prop))
+	if err != nil {
+		g.text.Add("could not generate aztec", cell, merror.DefaultErrorText)
+		return
+	}
+
+	err = g.image.Add(img, cell, g.cfg.Margins, prop.ToRectProp(), extension.Png, false)
+	if err != nil {
+		g.fpdf.ClearError()
+		g.text.Add("could not add aztec to document", cell, merror.DefaultErrorText)
+	}
+}
+
 func (g *provider) AddImageFromFile(file string, cell *entity.Cell, prop *props.Rect) {
 	extensionStr := strings.ToLower(strings.TrimPrefix(filepath.Ext(file), "."))
 	image, err := g.loadImage(file, extensionStr)
@@ -126,7 +259,7 @@ func (g *provider) AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *prop
 		return
 	}
 
-	err = g.image.Add(img, cell, g.cfg.Margins, prop, extension, false)
+	err = g.image.Add(img, cell, g.cfg.Margins, prop, img.Extension, false)
 	if err != nil {
 		g.fpdf.ClearError()
 		g.text.Add("could not add image to document", cell, merror.DefaultErrorText)
@@ -140,7 +273,7 @@ func (g *provider) AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell,
 		return
 	}
 
-	err = g.image.Add(img, cell, g.cfg.Margins, prop, extension, true)
+	err = g.image.Add(img, cell, g.cfg.Margins, prop, img.Extension, true)
 	if err != nil {
 		g.fpdf.ClearError()
 		g.text.Add("could not add image to document", cell, merror.DefaultErrorText)
@@ -194,17 +327,12 @@ func (g *provider) SetMetadata(metadata *entity.Metadata) {
 // If the image cannot be loaded, an error is returned
 func (g *provider) GetDimensionsByImage(file string) (*entity.Dimensions, error) {
 	extensionStr := strings.ToLower(strings.TrimPrefix(filepath.Ext(file), "."))
-	img, err := g.loadImage(file, extensionStr)
+	image, err := g.loadImage(file, extensionStr)
 	if err != nil {
 		return nil, err
 	}
 
-	imgInfo, _ := g.image.GetImageInfo(img, extension.Type(extensionStr))
-
-	if imgInfo == nil {
-		return nil, errors.New("could not read image options, maybe path/name is wrong")
-	}
-	return &entity.Dimensions{Width: imgInfo.Width(), Height: imgInfo.Height()}, nil
+	return g.GetDimensionsByImageByte(image.Bytes, extension.Type(extensionStr))
 }
 
 // GetDimensionsByImageByte is responsible for obtaining the dimensions of an image
@@ -215,49 +343,90 @@ func (g *provider) GetDimensionsByImageByte(bytes []byte, extension extension.Ty
 		return nil, err
 	}
 
-	imgInfo, _ := g.image.GetImageInfo(img, extension)
-	if imgInfo == nil {
-		return nil, errors.New("could not read image options, maybe path/name is wrong")
+	return g.getImageDimensions(img, img.Extension)
+}
+
+// getImageDimensions returns the dimensions of a raster image or the proportions of a svg image.
+func (g *provider) getImageDimensions(img *entity.Image, ext extension.Type) (*entity.Dimensions, error) {
+	if ext == extension.Svg {
+		svgImage, err := svg.Parse(img.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return &entity.Dimensions{Width: svgImage.Width, Height: svgImage.Height}, nil
 	}
-	return &entity.Dimensions{Width: imgInfo.Width(), Height: imgInfo.Height()}, nil
+
+	return g.image.GetDimensions(img)
 }
 
 // GetDimensionsByMatrixCode is responsible for obtaining the dimensions of an MatrixCode
 // If the image cannot be loaded, an error is returned
-func (g *provider) GetDimensionsByMatrixCode(code string) (*entity.Dimensions, error) {
-	img, err := g.loadCode(code, "matrix-code-", g.code.GenDataMatrix)
+func (g *provider) GetDimensionsByMatrixCode(code string, prop *props.Rect) (*entity.Dimensions, error) {
+	generate, ext := g.matrixCodeGenerator(prop)
+	img, err := g.loadCode(code, g.getMatrixCodeImageName(prop), ext, generate)
 	if err != nil {
 		return nil, err
 	}
 
-	imgInfo, _ := g.image.GetImageInfo(img, extension.Png)
+	return g.getImageDimensions(img, ext)
+}
 
-	if imgInfo == nil {
-		return nil, errors.New("could not read image options, maybe path/name is wrong")
+// GetDimensionsByQrCode is responsible for obtaining the dimensions of an QrCode
+// If the image cannot be loaded, an error is returned
+func (g *provider) GetDimensionsByQrCode(code string, prop *props.QrCode) (*entity.Dimensions, error) {
+	generate, ext := g.qrCodeGenerator(prop)
+	img, err := g.loadCode(code, g.getQrCodeImageName(prop), ext, generate)
+	if err != nil {
+		return nil, err
 	}
-	return &entity.Dimensions{Width: imgInfo.Width(), Height: imgInfo.Height()}, nil
+
+	return g.getImageDimensions(img, ext)
 }
 
-// GetDimensionsByQrCode is responsible for obtaining the dimensions of an QrCode
+// GetDimensionsByBarCode is responsible for obtaining the dimensions of a Barcode, the width is the amount of modules
+// If the barcode cannot be generated, an error is returned
+func (g *provider) GetDimensionsByBarCode(code string, prop *props.Barcode) (*entity.Dimensions, error) {
+	image, err := g.loadBarcode(code, nil, prop)
+	if err != nil {
+		return nil, err
+	}
+
+	if image.Dimensions == nil {
+		return nil, errors.New("could not read barcode modules")
+	}
+	return image.Dimensions, nil
+}
+
+// GetDimensionsByPDF417 is responsible for obtaining the dimensions of a PDF417 code
 // If the image cannot be loaded, an error is returned
-func (g *provider) GetDimensionsByQrCode(code string) (*entity.Dimensions, error) {
-	img, err := g.loadCode(code, "qr-code-", g.code.GenQr)
+func (g *provider) GetDimensionsByPDF417(code string, prop *props.PDF417) (*entity.Dimensions, error) {
+	img, err := g.loadCode(code, g.getPDF417ImageName(prop), extension.Png, g.pdf417Generator(prop))
 	if err != nil {
 		return nil, err
 	}
 
-	imgInfo, _ := g.image.GetImageInfo(img, extension.Png)
-	if imgInfo == nil {
-		return nil, errors.New("could not read image options, maybe path/name is wrong")
+	return g.image.GetDimensions(img)
+}
+
+// GetDimensionsByAztec is responsible for obtaining the dimensions of an Aztec code
+// If the image cannot be loaded, an error is returned
+func (g *provider) GetDimensionsByAztec(code string, prop *props.Aztec) (*entity.Dimensions, error) {
+	img, err := g.loadCode(code, g.getAztecImageName(prop), extension.Png, g.aztecGenerator(prop))
+	if err != nil {
+		return nil, err
 	}
-	return &entity.Dimensions{Width: imgInfo.Width(), Height: imgInfo.Height()}, nil
+
+	return g.image.GetDimensions(img)
 }
 
 func (g *provider) GenerateBytes() ([]byte, error) {
 	var buffer bytes.Buffer
 	err := g.fpdf.Output(&buffer)
+	if err != nil || g.form == nil {
+		return buffer.Bytes(), err
+	}
 
-	return buffer.Bytes(), err
+	return g.form.Apply(buffer.Bytes())
 }
 
 func (g *provider) CreateCol(width, height float64, config *entity.Config, prop *props.Cell) {
@@ -268,17 +437,124 @@ func (g *provider) SetCompression(compression bool) {
 	g.fpdf.SetCompression(compression)
 }
 
+// getBarcodeImageName returns the cache name of a barcode, the props which change the generated image are part of it
+// since the same code generates different images for each size.
 func (g *provider) getBarcodeImageName(code string, prop *props.Barcode) string {
 	if prop == nil {
 		return code + string(barcode.Code128)
 	}
 
-	return code + string(prop.Type)
+	return fmt.Sprintf("%s%s-%g-%g-%g-%g-%g-", code, prop.Type, prop.Proportion.Width, prop.Proportion.Height,
+		prop.ModuleWidth, prop.Height, prop.QuietZone)
+}
+
+// getQrCodeImageName returns the cache prefix of a qrcode, the options are part of it
+// since the same code generates different images for each option.
+func (g *provider) getQrCodeImageName(prop *props.QrCode) string {
+	if prop == nil {
+		return "qr-code-"
+	}
+
+	logo := ""
+	if prop.Logo != nil {
+		logo = fmt.Sprintf("%x-%v", sha256.Sum256(prop.Logo.Bytes), prop.Logo.Percent)
+	}
+
+	return fmt.Sprintf("qr-code-%s-%d-%s-%s-%s-", prop.ErrorCorrection, prop.QuietZone,
+		prop.Color.ToString(), prop.BackgroundColor.ToString(), logo)
+}
+
+// qrCodeGenerator returns the function which generates a qrcode and the extension of the generated image.
+func (g *provider) qrCodeGenerator(prop *props.QrCode) (func(code string) (*entity.Image, error), extension.Type) {
+	if prop.Vector {
+		return func(code string) (*entity.Image, error) {
+			return g.code.GenVectorQr(code, prop)
+		}, extension.Svg
+	}
+
+	return func(code string) (*entity.Image, error) {
+		return g.code.GenQr(code, prop)
+	}, extension.Png
+}
+
+// matrixCodeGenerator returns the function which generates a matrixcode and the extension of the generated image.
+func (g *provider) matrixCodeGenerator(prop *props.Rect) (func(code string) (*entity.Image, error), extension.Type) {
+	if prop.Vector {
+		return func(code string) (*entity.Image, error) {
+			return g.code.GenVectorDataMatrix(code, prop)
+		}, extension.Svg
+	}
+
+	return func(code string) (*entity.Image, error) {
+		return g.code.GenDataMatrix(code, prop)
+	}, extension.Png
+}
+
+// getMatrixCodeImageName returns the cache prefix of a matrixcode, GS1 codes have their own prefix
+// since the same code generates a different image.
+func (g *provider) getMatrixCodeImageName(prop *props.Rect) string {
+	if prop.GS1 {
+		return "gs1-matrix-code-"
+	}
+
+	return "matrix-code-"
+}
+
+// getPDF417ImageName returns the cache prefix of a pdf417, the error correction level is part of it
+// since the same code generates different images for each level.
+func (g *provider) getPDF417ImageName(prop *props.PDF417) string {
+	return fmt.Sprintf("pdf417-%d-", prop.ErrorCorrectionLevel)
+}
+
+// getAztecImageName returns the cache prefix of an aztec, the error correction percent is part of it
+// since the same code generates different images for each percent.
+func (g *provider) getAztecImageName(prop *props.Aztec) string {
+	return fmt.Sprintf("aztec-%d-", prop.ErrorCorrectionPercent)
+}
+
+func (g *provider) pdf417Generator(prop *props.PDF417) func(code string) (*entity.Image, error) {
+	return func(code string) (*entity.Image, error) {
+		return g.code.GenPDF417(code, prop)
+	}
+}
+
+func (g *provider) aztecGenerator(prop *props.Aztec) func(code string) (*entity.Image, error) {
+	return func(code string) (*entity.Image, error) {
+		return g.code.GenAztec(code, prop)
+	}
+}
+
+// loadBarcode is responsible for loading a barcode from cache or generating it
+func (g *provider) loadBarcode(code string, cell *entity.Cell, prop *props.Barcode) (*entity.Image, error) {
+	name := g.getBarcodeImageName(fmt.Sprintf("bar-code-%s", code), prop)
+	image, err := g.cache.GetImage(name, getCodeExtension(prop.Vector))
+	if err != nil && prop.Vector {
+		image, err = g.code.GenVectorBar(code, prop)
+	} else if err != nil {
+		image, err = g.code.GenBar(code, cell, prop)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	g.cache.AddImage(name, image)
+	return image, nil
+}
+
+// getCodeExtension returns the extension of a generated code, vector codes are svg images.
+func getCodeExtension(vector bool) extension.Type {
+	if vector {
+		return extension.Svg
+	}
+
+	return extension.Png
 }
 
 // loadImage is responsible for loading an codes
-func (g *provider) loadCode(code, codeType string, generate func(code string) (*entity.Image, error)) (*entity.Image, error) {
-	image, err := g.cache.GetImage(codeType+code, extension.Png)
+func (g *provider) loadCode(code, codeType string, ext extension.Type,
+	generate func(code string) (*entity.Image, error),
+) (*entity.Image, error) {
+	image, err := g.cache.GetImage(codeType+code, ext)
 	if err != nil {
 		image, err = generate(code)
 	} else {
@@ -6,20 +6,21 @@ import (
 	"testing"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
 
 	"github.com/johnfercher/maroto/v2/internal/fixture"
 	"github.com/johnfercher/maroto/v2/internal/merror"
 	"github.com/johnfercher/maroto/v2/mocks"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/objectfit"
 	"github.com/johnfercher/maroto/v2/pkg/consts/protection"
+	"github.com/johnfercher/maroto/v2/pkg/consts/qrlevel"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
-	gpdf "github.com/jung-kurt/gofpdf"
 
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -57,6 +58,26 @@ func TestProvider_AddText(t *testing.T) {
 	text.AssertNumberOfCalls(t, "Add", 1)
 }
 
+func TestProvider_GetStringWidth(t *testing.T) {
+	// Arrange
+	prop := fixture.TextProp()
+
+	text := mocks.NewText(t)
+	text.EXPECT().GetStringWidth("10.", &prop).Return(6)
+
+	dep := &gofpdf.Dependencies{
+		Text: text,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	width := sut.GetStringWidth("10.", &prop)
+
+	// Assert
+	text.AssertNumberOfCalls(t, "GetStringWidth", 1)
+	assert.Equal(t, 6.0, width)
+}
+
 func TestProvider_GetTextHeight(t *testing.T) {
 	// Arrange
 	fontHeightToReturn := 10.0
@@ -98,6 +119,291 @@ func TestProvider_AddLine(t *testing.T) {
 	line.AssertNumberOfCalls(t, "Add", 1)
 }
 
+func TestProvider_AddRectangle(t *testing.T) {
+	// Arrange
+	cell := &entity.Cell{}
+	prop := fixture.ShapeProp()
+
+	shape := mocks.NewShape(t)
+	shape.EXPECT().AddRectangle(cell, &prop)
+
+	dep := &gofpdf.Dependencies{
+		Shape: shape,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.AddRectangle(cell, &prop)
+
+	// Assert
+	shape.AssertNumberOfCalls(t, "AddRectangle", 1)
+}
+
+func TestProvider_AddEllipse(t *testing.T) {
+	// Arrange
+	cell := &entity.Cell{}
+	prop := fixture.ShapeProp()
+
+	shape := mocks.NewShape(t)
+	shape.EXPECT().AddEllipse(cell, &prop)
+
+	dep := &gofpdf.Dependencies{
+		Shape: shape,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.AddEllipse(cell, &prop)
+
+	// Assert
+	shape.AssertNumberOfCalls(t, "AddEllipse", 1)
+}
+
+func TestProvider_AddPolygon(t *testing.T) {
+	t.Run("when polygon cannot be drawn, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.ShapeProp()
+		points := []props.Point{{X: 0, Y: 0}}
+
+		shape := mocks.NewShape(t)
+		shape.EXPECT().AddPolygon(points, cell, &prop).Return(errors.New("anyError"))
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add("could not draw polygon", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Shape: shape,
+			Text:  text,
+		}
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddPolygon(points, cell, &prop)
+
+		// Assert
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when polygon is drawn, should not apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.ShapeProp()
+		points := []props.Point{{X: 0, Y: 0}, {X: 100, Y: 0}, {X: 0, Y: 100}}
+
+		shape := mocks.NewShape(t)
+		shape.EXPECT().AddPolygon(points, cell, &prop).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Shape: shape,
+		}
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddPolygon(points, cell, &prop)
+
+		// Assert
+		shape.AssertNumberOfCalls(t, "AddPolygon", 1)
+	})
+}
+
+func TestProvider_AddPolyline(t *testing.T) {
+	// Arrange
+	cell := &entity.Cell{}
+	prop := fixture.ShapeProp()
+	points := []props.Point{{X: 0, Y: 0}}
+
+	shape := mocks.NewShape(t)
+	shape.EXPECT().AddPolyline(points, cell, &prop).Return(errors.New("anyError"))
+
+	text := mocks.NewText(t)
+	text.EXPECT().Add("could not draw polyline", cell, merror.DefaultErrorText)
+
+	dep := &gofpdf.Dependencies{
+		Shape: shape,
+		Text:  text,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.AddPolyline(points, cell, &prop)
+
+	// Assert
+	text.AssertNumberOfCalls(t, "Add", 1)
+}
+
+func TestProvider_AddBezier(t *testing.T) {
+	// Arrange
+	cell := &entity.Cell{}
+	prop := fixture.ShapeProp()
+	points := []props.Point{{X: 0, Y: 0}}
+
+	shape := mocks.NewShape(t)
+	shape.EXPECT().AddBezier(points, cell, &prop).Return(errors.New("anyError"))
+
+	text := mocks.NewText(t)
+	text.EXPECT().Add("could not draw bezier", cell, merror.DefaultErrorText)
+
+	dep := &gofpdf.Dependencies{
+		Shape: shape,
+		Text:  text,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.AddBezier(points, cell, &prop)
+
+	// Assert
+	text.AssertNumberOfCalls(t, "Add", 1)
+}
+
+func TestProvider_AddArrow(t *testing.T) {
+	// Arrange
+	cell := &entity.Cell{}
+	prop := fixture.ShapeProp()
+	point := props.Point{X: 10, Y: 10}
+
+	shape := mocks.NewShape(t)
+	shape.EXPECT().AddArrow(point, point, cell, &prop).Return(errors.New("anyError"))
+
+	text := mocks.NewText(t)
+	text.EXPECT().Add("could not draw arrow", cell, merror.DefaultErrorText)
+
+	dep := &gofpdf.Dependencies{
+		Shape: shape,
+		Text:  text,
+	}
+	sut := gofpdf.New(dep)
+
+	// Act
+	sut.AddArrow(point, point, cell, &prop)
+
+	// Assert
+	text.AssertNumberOfCalls(t, "Add", 1)
+}
+
+func TestProvider_AddChart(t *testing.T) {
+	t.Run("when chart cannot be drawn, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		data := fixture.ChartEntity()
+		prop := fixture.ChartProp()
+
+		chart := mocks.NewChart(t)
+		chart.EXPECT().Add(&data, cell, &prop).Return(errors.New("anyError"))
+
+		fpdf := mocks.NewFpdf(t)
+		fpdf.EXPECT().ClearError()
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add("could not draw chart", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Chart: chart,
+			Fpdf:  fpdf,
+			Text:  text,
+		}
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddChart(&data, cell, &prop)
+
+		// Assert
+		chart.AssertNumberOfCalls(t, "Add", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when chart is drawn, should not apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		data := fixture.ChartEntity()
+		prop := fixture.ChartProp()
+
+		chart := mocks.NewChart(t)
+		chart.EXPECT().Add(&data, cell, &prop).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Chart: chart,
+		}
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddChart(&data, cell, &prop)
+
+		// Assert
+		chart.AssertNumberOfCalls(t, "Add", 1)
+	})
+}
+
+func TestProvider_AddFormField(t *testing.T) {
+	t.Run("when document is protected, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		field := fixture.FormFieldEntity()
+		prop := fixture.FormFieldProp()
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add("could not add form field to protected document", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Form: mocks.NewForm(t),
+			Text: text,
+			Cfg:  &entity.Config{Protection: &entity.Protection{}},
+		}
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddFormField(&field, cell, &prop)
+
+		// Assert
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when field cannot be added, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		field := fixture.FormFieldEntity()
+		prop := fixture.FormFieldProp()
+
+		form := mocks.NewForm(t)
+		form.EXPECT().Add(&field, cell, &prop).Return(errors.New("anyError"))
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add("could not add form field", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Form: form,
+			Text: text,
+		}
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddFormField(&field, cell, &prop)
+
+		// Assert
+		form.AssertNumberOfCalls(t, "Add", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when field is added, should not apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		field := fixture.FormFieldEntity()
+		prop := fixture.FormFieldProp()
+
+		form := mocks.NewForm(t)
+		form.EXPECT().Add(&field, cell, &prop).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Form: form,
+			Cfg:  &entity.Config{},
+		}
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddFormField(&field, cell, &prop)
+
+		// Assert
+		form.AssertNumberOfCalls(t, "Add", 1)
+	})
+}
+
 // nolint: dupl
 func TestProvider_AddMatrixCode(t *testing.T) {
 	t.Run("when cannot find image on cache and cannot generate data matrix, should apply error message", func(t *testing.T) {
@@ -109,7 +415,7 @@ func TestProvider_AddMatrixCode(t *testing.T) {
 		cache.EXPECT().GetImage("matrix-code-code", extension.Png).Return(nil, errors.New("anyError1"))
 
 		code := mocks.NewCode(t)
-		code.EXPECT().GenDataMatrix(codeContent).Return(nil, errors.New("anyError2"))
+		code.EXPECT().GenDataMatrix(codeContent, &prop).Return(nil, errors.New("anyError2"))
 
 		text := mocks.NewText(t)
 		text.EXPECT().Add("could not generate matrixcode", cell, merror.DefaultErrorText)
@@ -234,7 +540,7 @@ func TestProvider_AddMatrixCode(t *testing.T) {
 		cache.EXPECT().AddImage("matrix-code-code", img).Return()
 
 		code := mocks.NewCode(t)
-		code.EXPECT().GenDataMatrix(codeContent).Return(img, nil)
+		code.EXPECT().GenDataMatrix(codeContent, &prop).Return(img, nil)
 
 		image := mocks.NewImage(t)
 		image.EXPECT().Add(img, cell, cfg.Margins, &prop, extension.Png, false).Return(nil)
@@ -255,6 +561,72 @@ func TestProvider_AddMatrixCode(t *testing.T) {
 		cache.AssertNumberOfCalls(t, "AddImage", 1)
 		image.AssertNumberOfCalls(t, "Add", 1)
 	})
+	t.Run("when vector is sent, should generate and add a svg matrix code", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		cfg := fixture.ConfigEntity()
+		prop := props.Rect{Vector: true}
+		img := &entity.Image{Bytes: []byte{1, 2, 3}, Extension: extension.Svg}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("matrix-code-code", extension.Svg).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().AddImage("matrix-code-code", img).Return()
+
+		code := mocks.NewCode(t)
+		code.EXPECT().GenVectorDataMatrix(codeContent, &prop).Return(img, nil)
+
+		image := mocks.NewImage(t)
+		image.EXPECT().Add(img, cell, cfg.Margins, &prop, extension.Svg, false).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Image: image,
+			Cfg:   &cfg,
+			Code:  code,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddMatrixCode(codeContent, cell, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "GenVectorDataMatrix", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when gs1 is sent, should cache the gs1 matrix code apart", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		cfg := fixture.ConfigEntity()
+		prop := props.Rect{GS1: true}
+		img := &entity.Image{Bytes: []byte{1, 2, 3}, Extension: extension.Png}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("gs1-matrix-code-code", extension.Png).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().AddImage("gs1-matrix-code-code", img).Return()
+
+		code := mocks.NewCode(t)
+		code.EXPECT().GenDataMatrix(codeContent, &prop).Return(img, nil)
+
+		image := mocks.NewImage(t)
+		image.EXPECT().Add(img, cell, cfg.Margins, &prop, extension.Png, false).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Image: image,
+			Cfg:   &cfg,
+			Code:  code,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddMatrixCode(codeContent, cell, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "GenDataMatrix", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+	})
 }
 
 // nolint: dupl
@@ -262,13 +634,13 @@ func TestProvider_AddQrCode(t *testing.T) {
 	t.Run("when cannot find image on cache and cannot generate qr code, should apply error message", func(t *testing.T) {
 		// Arrange
 		cell := &entity.Cell{}
-		prop := fixture.RectProp()
+		prop := fixture.QrCodeProp()
 
 		cache := mocks.NewCache(t)
-		cache.EXPECT().GetImage("qr-code-code", extension.Png).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().GetImage("qr-code-M-0----code", extension.Png).Return(nil, errors.New("anyError1"))
 
 		code := mocks.NewCode(t)
-		code.EXPECT().GenQr(codeContent).Return(nil, errors.New("anyError2"))
+		code.EXPECT().GenQr(codeContent, &prop).Return(nil, errors.New("anyError2"))
 
 		text := mocks.NewText(t)
 		text.EXPECT().Add("could not generate qrcode", cell, merror.DefaultErrorText)
@@ -292,12 +664,12 @@ func TestProvider_AddQrCode(t *testing.T) {
 	t.Run("when can find image on cache but cannot add image, should apply error message", func(t *testing.T) {
 		// Arrange
 		cell := &entity.Cell{}
-		prop := fixture.RectProp()
+		prop := fixture.QrCodeProp()
 
 		img := &entity.Image{Bytes: []byte{1, 2, 3}}
 
 		cache := mocks.NewCache(t)
-		cache.EXPECT().GetImage("qr-code-code", extension.Png).Return(img, nil)
+		cache.EXPECT().GetImage("qr-code-M-0----code", extension.Png).Return(img, nil)
 
 		code := mocks.NewCode(t)
 
@@ -314,7 +686,7 @@ func TestProvider_AddQrCode(t *testing.T) {
 		}
 
 		image := mocks.NewImage(t)
-		image.EXPECT().Add(img, cell, cfg.Margins, &prop, extension.Png, false).Return(errors.New("anyError"))
+		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Png, false).Return(errors.New("anyError"))
 
 		fpdf := mocks.NewFpdf(t)
 		fpdf.EXPECT().ClearError()
@@ -342,12 +714,12 @@ func TestProvider_AddQrCode(t *testing.T) {
 	t.Run("when can find image on cache and can add image, should not apply error message", func(t *testing.T) {
 		// Arrange
 		cell := &entity.Cell{}
-		prop := fixture.RectProp()
+		prop := fixture.QrCodeProp()
 
 		img := &entity.Image{Bytes: []byte{1, 2, 3}}
 
 		cache := mocks.NewCache(t)
-		cache.EXPECT().GetImage("qr-code-code", extension.Png).Return(img, nil)
+		cache.EXPECT().GetImage("qr-code-M-0----code", extension.Png).Return(img, nil)
 
 		code := mocks.NewCode(t)
 
@@ -361,7 +733,7 @@ func TestProvider_AddQrCode(t *testing.T) {
 		}
 
 		image := mocks.NewImage(t)
-		image.EXPECT().Add(img, cell, cfg.Margins, &prop, extension.Png, false).Return(nil)
+		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Png, false).Return(nil)
 
 		dep := &gofpdf.Dependencies{
 			Cache: cache,
@@ -383,19 +755,19 @@ func TestProvider_AddQrCode(t *testing.T) {
 	t.Run("when qrcode is generated with the code sent, it should generate qr code with the same code", func(t *testing.T) {
 		// Arrange
 		cell := &entity.Cell{}
-		prop := fixture.RectProp()
+		prop := fixture.QrCodeProp()
 		img := &entity.Image{Bytes: []byte{1, 2, 3}}
 		cfg := fixture.ConfigEntity()
 
 		cache := mocks.NewCache(t)
-		cache.EXPECT().GetImage("qr-code-code", extension.Png).Return(nil, errors.New("anyError1"))
-		cache.EXPECT().AddImage("qr-code-code", img).Return()
+		cache.EXPECT().GetImage("qr-code-M-0----code", extension.Png).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().AddImage("qr-code-M-0----code", img).Return()
 
 		code := mocks.NewCode(t)
-		code.EXPECT().GenQr(codeContent).Return(img, nil)
+		code.EXPECT().GenQr(codeContent, &prop).Return(img, nil)
 
 		image := mocks.NewImage(t)
-		image.EXPECT().Add(img, cell, cfg.Margins, &prop, extension.Png, false).Return(nil)
+		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Png, false).Return(nil)
 
 		dep := &gofpdf.Dependencies{
 			Cache: cache,
@@ -415,6 +787,39 @@ func TestProvider_AddQrCode(t *testing.T) {
 		cache.AssertNumberOfCalls(t, "AddImage", 1)
 		image.AssertNumberOfCalls(t, "Add", 1)
 	})
+	t.Run("when vector is sent, should generate and add a svg qrcode", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		cfg := fixture.ConfigEntity()
+		prop := props.QrCode{ErrorCorrection: qrlevel.M, Vector: true}
+		img := &entity.Image{Bytes: []byte{1, 2, 3}, Extension: extension.Svg}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("qr-code-M-0----code", extension.Svg).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().AddImage("qr-code-M-0----code", img).Return()
+
+		code := mocks.NewCode(t)
+		code.EXPECT().GenVectorQr(codeContent, &prop).Return(img, nil)
+
+		image := mocks.NewImage(t)
+		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Svg, false).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Image: image,
+			Cfg:   &cfg,
+			Code:  code,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddQrCode(codeContent, cell, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "GenVectorQr", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+	})
 }
 
 // nolint: dupl
@@ -425,7 +830,7 @@ func TestProvider_AddBarCode(t *testing.T) {
 		prop := fixture.BarcodeProp()
 
 		cache := mocks.NewCache(t)
-		cache.EXPECT().GetImage("bar-code-codecode128", extension.Png).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().GetImage("bar-code-codecode128-16-3.2-0-0-0-", extension.Png).Return(nil, errors.New("anyError1"))
 
 		code := mocks.NewCode(t)
 		code.EXPECT().GenBar(codeContent, cell, &prop).Return(nil, errors.New("anyError2"))
@@ -457,8 +862,8 @@ func TestProvider_AddBarCode(t *testing.T) {
 		img := &entity.Image{Bytes: []byte{1, 2, 3}}
 
 		cache := mocks.NewCache(t)
-		cache.EXPECT().GetImage("bar-code-codecode128", extension.Png).Return(img, nil)
-		cache.EXPECT().AddImage("bar-code-codecode128", img)
+		cache.EXPECT().GetImage("bar-code-codecode128-16-3.2-0-0-0-", extension.Png).Return(img, nil)
+		cache.EXPECT().AddImage("bar-code-codecode128-16-3.2-0-0-0-", img)
 
 		text := mocks.NewText(t)
 		text.EXPECT().Add("could not add barcode to document", cell, merror.DefaultErrorText)
@@ -489,90 +894,407 @@ func TestProvider_AddBarCode(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		sut.AddBarCode(codeContent, cell, &prop)
+		sut.AddBarCode(codeContent, cell, &prop)
+
+		// Assert
+		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		cache.AssertNumberOfCalls(t, "AddImage", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when can find image on cache and can add image, should not apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.BarcodeProp()
+
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("bar-code-codecode128-16-3.2-0-0-0-", extension.Png).Return(img, nil)
+		cache.EXPECT().AddImage("bar-code-codecode128-16-3.2-0-0-0-", img)
+
+		cfg := &entity.Config{
+			Margins: &entity.Margins{
+				Left:   10,
+				Top:    10,
+				Right:  10,
+				Bottom: 10,
+			},
+		}
+
+		image := mocks.NewImage(t)
+		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Png, false).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Image: image,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBarCode(codeContent, cell, &prop)
+
+		// Assert
+		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		cache.AssertNumberOfCalls(t, "AddImage", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when barcode is ean and everything is correct, should not apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.BarcodeProp()
+		prop.Type = barcode.EAN
+
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("bar-code-codeean-16-3.2-0-0-0-", extension.Png).Return(img, nil)
+		cache.EXPECT().AddImage("bar-code-codeean-16-3.2-0-0-0-", img)
+
+		cfg := &entity.Config{
+			Margins: &entity.Margins{
+				Left:   10,
+				Top:    10,
+				Right:  10,
+				Bottom: 10,
+			},
+		}
+
+		image := mocks.NewImage(t)
+		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Png, false).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Image: image,
+			Cfg:   cfg,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBarCode(codeContent, cell, &prop)
+
+		// Assert
+		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		cache.AssertNumberOfCalls(t, "AddImage", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when module width is sent, should draw the bars with the module width and the quiet zone", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 5, Y: 5, Width: 100, Height: 30}
+		cfg := fixture.ConfigEntity()
+		prop := props.Barcode{ModuleWidth: 0.5, Height: 15, QuietZone: 10}
+		prop.MakeValid()
+
+		img := &entity.Image{Bytes: []byte{1, 2, 3}, Dimensions: &entity.Dimensions{Width: 100, Height: 20}}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("bar-code-codecode128-1-0.2-0.5-15-10-", extension.Png).Return(img, nil)
+		cache.EXPECT().AddImage("bar-code-codecode128-1-0.2-0.5-15-10-", img)
+
+		barsCell := &entity.Cell{X: 10, Y: 5, Width: 50, Height: 15}
+		image := mocks.NewImage(t)
+		image.EXPECT().Add(img, barsCell, cfg.Margins, &props.Rect{Percent: 100, Fit: objectfit.Fill}, extension.Png, false).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Image: image,
+			Cfg:   &cfg,
+		}
+
+		// Act
+		gofpdf.New(dep).AddBarCode(codeContent, cell, &prop)
+
+		// Assert
+		image.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when module width is sent and column is too narrow, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 50, Height: 30}
+		prop := props.Barcode{ModuleWidth: 0.5, QuietZone: 10}
+		prop.MakeValid()
+
+		img := &entity.Image{Bytes: []byte{1, 2, 3}, Dimensions: &entity.Dimensions{Width: 100, Height: 20}}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("bar-code-codecode128-1-0.2-0.5-0-10-", extension.Png).Return(img, nil)
+		cache.EXPECT().AddImage("bar-code-codecode128-1-0.2-0.5-0-10-", img)
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add("barcode needs 60.0mm, column has 50.0mm", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Text:  text,
+			Image: mocks.NewImage(t),
+		}
+
+		// Act
+		gofpdf.New(dep).AddBarCode(codeContent, cell, &prop)
+
+		// Assert
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when module width is sent and left does not fit the column, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 60, Height: 30}
+		prop := props.Barcode{ModuleWidth: 0.5, QuietZone: 10, Left: 5}
+		prop.MakeValid()
+
+		img := &entity.Image{Bytes: []byte{1, 2, 3}, Dimensions: &entity.Dimensions{Width: 100, Height: 20}}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("bar-code-codecode128-1-0.2-0.5-0-10-", extension.Png).Return(img, nil)
+		cache.EXPECT().AddImage("bar-code-codecode128-1-0.2-0.5-0-10-", img)
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add("barcode needs 65.0mm, column has 60.0mm", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Text:  text,
+			Image: mocks.NewImage(t),
+		}
+
+		// Act
+		gofpdf.New(dep).AddBarCode(codeContent, cell, &prop)
+
+		// Assert
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when vector is sent, should generate and add a svg barcode", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		cfg := fixture.ConfigEntity()
+		prop := fixture.BarcodeProp()
+		prop.Vector = true
+		img := &entity.Image{Bytes: []byte{1, 2, 3}, Extension: extension.Svg}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("bar-code-codecode128-16-3.2-0-0-0-", extension.Svg).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().AddImage("bar-code-codecode128-16-3.2-0-0-0-", img).Return()
+
+		code := mocks.NewCode(t)
+		code.EXPECT().GenVectorBar(codeContent, &prop).Return(img, nil)
+
+		image := mocks.NewImage(t)
+		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Svg, false).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Image: image,
+			Cfg:   &cfg,
+			Code:  code,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddBarCode(codeContent, cell, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "GenVectorBar", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+	})
+}
+
+// nolint: dupl
+func TestProvider_AddPDF417(t *testing.T) {
+	t.Run("when cannot find image on cache and cannot generate pdf417, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.PDF417Prop()
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("pdf417-4-code", extension.Png).Return(nil, errors.New("anyError1"))
+
+		code := mocks.NewCode(t)
+		code.EXPECT().GenPDF417(codeContent, &prop).Return(nil, errors.New("anyError2"))
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add("could not generate pdf417", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Code:  code,
+			Text:  text,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddPDF417(codeContent, cell, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "GenPDF417", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when can find image on cache but cannot add image, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		cfg := fixture.ConfigEntity()
+		prop := fixture.PDF417Prop()
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("pdf417-4-code", extension.Png).Return(img, nil)
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add("could not add pdf417 to document", cell, merror.DefaultErrorText)
+
+		image := mocks.NewImage(t)
+		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Png, false).Return(errors.New("anyError"))
+
+		fpdf := mocks.NewFpdf(t)
+		fpdf.EXPECT().ClearError()
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Text:  text,
+			Image: image,
+			Fpdf:  fpdf,
+			Cfg:   &cfg,
+			Code:  mocks.NewCode(t),
+		}
+
+		// Act
+		gofpdf.New(dep).AddPDF417(codeContent, cell, &prop)
+
+		// Assert
+		image.AssertNumberOfCalls(t, "Add", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
+	})
+	t.Run("when pdf417 is generated, should cache it by code and error correction", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		cfg := fixture.ConfigEntity()
+		prop := fixture.PDF417Prop()
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("pdf417-4-code", extension.Png).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().AddImage("pdf417-4-code", img).Return()
+
+		code := mocks.NewCode(t)
+		code.EXPECT().GenPDF417(codeContent, &prop).Return(img, nil)
+
+		image := mocks.NewImage(t)
+		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Png, false).Return(nil)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Code:  code,
+			Cfg:   &cfg,
+			Image: image,
+		}
+
+		// Act
+		gofpdf.New(dep).AddPDF417(codeContent, cell, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "GenPDF417", 1)
+		cache.AssertNumberOfCalls(t, "AddImage", 1)
+		image.AssertNumberOfCalls(t, "Add", 1)
+	})
+}
+
+// nolint: dupl
+func TestProvider_AddAztec(t *testing.T) {
+	t.Run("when cannot find image on cache and cannot generate aztec, should apply error message", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{}
+		prop := fixture.AztecProp()
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("aztec-33-code", extension.Png).Return(nil, errors.New("anyError1"))
+
+		code := mocks.NewCode(t)
+		code.EXPECT().GenAztec(codeContent, &prop).Return(nil, errors.New("anyError2"))
+
+		text := mocks.NewText(t)
+		text.EXPECT().Add("could not generate aztec", cell, merror.DefaultErrorText)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Code:  code,
+			Text:  text,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		sut.AddAztec(codeContent, cell, &prop)
 
 		// Assert
-		cache.AssertNumberOfCalls(t, "GetImage", 1)
-		cache.AssertNumberOfCalls(t, "AddImage", 1)
-		image.AssertNumberOfCalls(t, "Add", 1)
+		code.AssertNumberOfCalls(t, "GenAztec", 1)
 		text.AssertNumberOfCalls(t, "Add", 1)
 	})
-	t.Run("when can find image on cache and can add image, should not apply error message", func(t *testing.T) {
+	t.Run("when can find image on cache but cannot add image, should apply error message", func(t *testing.T) {
 		// Arrange
 		cell := &entity.Cell{}
-		prop := fixture.BarcodeProp()
-
+		cfg := fixture.ConfigEntity()
+		prop := fixture.AztecProp()
 		img := &entity.Image{Bytes: []byte{1, 2, 3}}
 
 		cache := mocks.NewCache(t)
-		cache.EXPECT().GetImage("bar-code-codecode128", extension.Png).Return(img, nil)
-		cache.EXPECT().AddImage("bar-code-codecode128", img)
+		cache.EXPECT().GetImage("aztec-33-code", extension.Png).Return(img, nil)
 
-		cfg := &entity.Config{
-			Margins: &entity.Margins{
-				Left:   10,
-				Top:    10,
-				Right:  10,
-				Bottom: 10,
-			},
-		}
+		text := mocks.NewText(t)
+		text.EXPECT().Add("could not add aztec to document", cell, merror.DefaultErrorText)
 
 		image := mocks.NewImage(t)
-		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Png, false).Return(nil)
+		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Png, false).Return(errors.New("anyError"))
+
+		fpdf := mocks.NewFpdf(t)
+		fpdf.EXPECT().ClearError()
 
 		dep := &gofpdf.Dependencies{
 			Cache: cache,
+			Text:  text,
 			Image: image,
-			Cfg:   cfg,
+			Fpdf:  fpdf,
+			Cfg:   &cfg,
+			Code:  mocks.NewCode(t),
 		}
 
-		sut := gofpdf.New(dep)
-
 		// Act
-		sut.AddBarCode(codeContent, cell, &prop)
+		gofpdf.New(dep).AddAztec(codeContent, cell, &prop)
 
 		// Assert
-		cache.AssertNumberOfCalls(t, "GetImage", 1)
-		cache.AssertNumberOfCalls(t, "AddImage", 1)
 		image.AssertNumberOfCalls(t, "Add", 1)
+		text.AssertNumberOfCalls(t, "Add", 1)
 	})
-	t.Run("when barcode is ean and everything is correct, should not apply error message", func(t *testing.T) {
+	t.Run("when aztec is generated, should cache it by code and error correction", func(t *testing.T) {
 		// Arrange
 		cell := &entity.Cell{}
-		prop := fixture.BarcodeProp()
-		prop.Type = barcode.EAN
-
+		cfg := fixture.ConfigEntity()
+		prop := fixture.AztecProp()
 		img := &entity.Image{Bytes: []byte{1, 2, 3}}
 
 		cache := mocks.NewCache(t)
-		cache.EXPECT().GetImage("bar-code-codeean", extension.Png).Return(img, nil)
-		cache.EXPECT().AddImage("bar-code-codeean", img)
+		cache.EXPECT().GetImage("aztec-33-code", extension.Png).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().AddImage("aztec-33-code", img).Return()
 
-		cfg := &entity.Config{
-			Margins: &entity.Margins{
-				Left:   10,
-				Top:    10,
-				Right:  10,
-				Bottom: 10,
-			},
-		}
+		code := mocks.NewCode(t)
+		code.EXPECT().GenAztec(codeContent, &prop).Return(img, nil)
 
 		image := mocks.NewImage(t)
 		image.EXPECT().Add(img, cell, cfg.Margins, prop.ToRectProp(), extension.Png, false).Return(nil)
 
 		dep := &gofpdf.Dependencies{
 			Cache: cache,
+			Code:  code,
+			Cfg:   &cfg,
 			Image: image,
-			Cfg:   cfg,
 		}
 
-		sut := gofpdf.New(dep)
-
 		// Act
-		sut.AddBarCode(codeContent, cell, &prop)
+		gofpdf.New(dep).AddAztec(codeContent, cell, &prop)
 
 		// Assert
-		cache.AssertNumberOfCalls(t, "GetImage", 1)
+		code.AssertNumberOfCalls(t, "GenAztec", 1)
 		cache.AssertNumberOfCalls(t, "AddImage", 1)
 		image.AssertNumberOfCalls(t, "Add", 1)
 	})
@@ -736,22 +1458,46 @@ func TestProvider_SetMetadata(t *testing.T) {
 }
 
 func TestProvider_GenerateBytes(t *testing.T) {
-	// Arrange
-	fpdf := mocks.NewFpdf(t)
-	fpdf.EXPECT().Output(mock.Anything).Return(errors.New("anyError"))
+	t.Run("when output fails, should return error", func(t *testing.T) {
+		// Arrange
+		fpdf := mocks.NewFpdf(t)
+		fpdf.EXPECT().Output(mock.Anything).Return(errors.New("anyError"))
 
-	dep := &gofpdf.Dependencies{
-		Fpdf: fpdf,
-	}
-	sut := gofpdf.New(dep)
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+		}
+		sut := gofpdf.New(dep)
 
-	// Act
-	bytes, err := sut.GenerateBytes()
+		// Act
+		bytes, err := sut.GenerateBytes()
 
-	// Assert
-	assert.Nil(t, bytes)
-	assert.NotNil(t, err)
-	fpdf.AssertNumberOfCalls(t, "Output", 1)
+		// Assert
+		assert.Nil(t, bytes)
+		assert.NotNil(t, err)
+		fpdf.AssertNumberOfCalls(t, "Output", 1)
+	})
+	t.Run("when there is a form, should apply the form fields", func(t *testing.T) {
+		// Arrange
+		fpdf := mocks.NewFpdf(t)
+		fpdf.EXPECT().Output(mock.Anything).Return(nil)
+
+		form := mocks.NewForm(t)
+		form.EXPECT().Apply([]byte(nil)).Return([]byte{1, 2, 3}, nil)
+
+		dep := &gofpdf.Dependencies{
+			Fpdf: fpdf,
+			Form: form,
+		}
+		sut := gofpdf.New(dep)
+
+		// Act
+		bytes, err := sut.GenerateBytes()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{1, 2, 3}, bytes)
+		form.AssertNumberOfCalls(t, "Apply", 1)
+	})
 }
 
 func TestProvider_AddImageFromBytes(t *testing.T) {
@@ -972,7 +1718,7 @@ func TestProvider_GetDimensionsByMatrixCode(t *testing.T) {
 		cache.EXPECT().GetImage("matrix-code-code", extension.Png).Return(nil, errors.New("anyError1"))
 
 		code := mocks.NewCode(t)
-		code.EXPECT().GenDataMatrix(codeContent).Return(nil, errors.New("anyError2"))
+		code.EXPECT().GenDataMatrix(codeContent, &props.Rect{}).Return(nil, errors.New("anyError2"))
 
 		dep := &gofpdf.Dependencies{
 			Cache: cache,
@@ -982,7 +1728,7 @@ func TestProvider_GetDimensionsByMatrixCode(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		dimensions, err := sut.GetDimensionsByMatrixCode(codeContent)
+		dimensions, err := sut.GetDimensionsByMatrixCode(codeContent, &props.Rect{})
 
 		// Assert
 		cache.AssertNumberOfCalls(t, "GetImage", 1)
@@ -999,7 +1745,7 @@ func TestProvider_GetDimensionsByMatrixCode(t *testing.T) {
 		cache.EXPECT().AddImage("matrix-code-code", img)
 
 		code := mocks.NewCode(t)
-		code.EXPECT().GenDataMatrix(codeContent).Return(img, nil)
+		code.EXPECT().GenDataMatrix(codeContent, &props.Rect{}).Return(img, nil)
 
 		cfg := &entity.Config{
 			Margins: &entity.Margins{
@@ -1011,7 +1757,7 @@ func TestProvider_GetDimensionsByMatrixCode(t *testing.T) {
 		}
 
 		image := mocks.NewImage(t)
-		image.EXPECT().GetImageInfo(img, extension.Png).Return(&gpdf.ImageInfoType{}, uuid.UUID{})
+		image.EXPECT().GetDimensions(img).Return(&entity.Dimensions{}, nil)
 
 		dep := &gofpdf.Dependencies{
 			Cache: cache,
@@ -1023,7 +1769,7 @@ func TestProvider_GetDimensionsByMatrixCode(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		dimensions, err := sut.GetDimensionsByMatrixCode(codeContent)
+		dimensions, err := sut.GetDimensionsByMatrixCode(codeContent, &props.Rect{})
 
 		// Assert
 		cache.AssertNumberOfCalls(t, "GetImage", 1)
@@ -1042,7 +1788,7 @@ func TestProvider_GetDimensionsByMatrixCode(t *testing.T) {
 		cfg := &entity.Config{Margins: &entity.Margins{Left: 10, Top: 10, Right: 10, Bottom: 10}}
 
 		image := mocks.NewImage(t)
-		image.EXPECT().GetImageInfo(img, extension.Png).Return(&gpdf.ImageInfoType{}, uuid.UUID{})
+		image.EXPECT().GetDimensions(img).Return(&entity.Dimensions{}, nil)
 
 		dep := &gofpdf.Dependencies{
 			Cache: cache,
@@ -1054,7 +1800,7 @@ func TestProvider_GetDimensionsByMatrixCode(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		dimensions, err := sut.GetDimensionsByMatrixCode(codeContent)
+		dimensions, err := sut.GetDimensionsByMatrixCode(codeContent, &props.Rect{})
 
 		// Assert
 		cache.AssertNumberOfCalls(t, "GetImage", 1)
@@ -1068,12 +1814,13 @@ func TestProvider_GetDimensionsByMatrixCode(t *testing.T) {
 func TestProvider_GetDimensionsByQrCode(t *testing.T) {
 	t.Run("when cannot find image on cache and cannot generate qrCode, should return error", func(t *testing.T) {
 		// Arrange
+		prop := fixture.QrCodeProp()
 
 		cache := mocks.NewCache(t)
-		cache.EXPECT().GetImage("qr-code-code", extension.Png).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().GetImage("qr-code-M-0----code", extension.Png).Return(nil, errors.New("anyError1"))
 
 		code := mocks.NewCode(t)
-		code.EXPECT().GenQr(codeContent).Return(nil, errors.New("anyError2"))
+		code.EXPECT().GenQr(codeContent, &prop).Return(nil, errors.New("anyError2"))
 
 		dep := &gofpdf.Dependencies{
 			Cache: cache,
@@ -1083,7 +1830,7 @@ func TestProvider_GetDimensionsByQrCode(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		dimensions, err := sut.GetDimensionsByQrCode(codeContent)
+		dimensions, err := sut.GetDimensionsByQrCode(codeContent, &prop)
 
 		// Assert
 		cache.AssertNumberOfCalls(t, "GetImage", 1)
@@ -1093,14 +1840,15 @@ func TestProvider_GetDimensionsByQrCode(t *testing.T) {
 	})
 	t.Run("when cannot find image on cache but can generate qrCode, should return dimension", func(t *testing.T) {
 		// Arrange
+		prop := fixture.QrCodeProp()
 		img := &entity.Image{Bytes: []byte{1, 2, 3}}
 
 		cache := mocks.NewCache(t)
-		cache.EXPECT().GetImage("qr-code-code", extension.Png).Return(nil, errors.New("anyError1"))
-		cache.EXPECT().AddImage("qr-code-code", img)
+		cache.EXPECT().GetImage("qr-code-M-0----code", extension.Png).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().AddImage("qr-code-M-0----code", img)
 
 		code := mocks.NewCode(t)
-		code.EXPECT().GenQr(codeContent).Return(img, nil)
+		code.EXPECT().GenQr(codeContent, &prop).Return(img, nil)
 
 		cfg := &entity.Config{
 			Margins: &entity.Margins{
@@ -1112,7 +1860,7 @@ func TestProvider_GetDimensionsByQrCode(t *testing.T) {
 		}
 
 		image := mocks.NewImage(t)
-		image.EXPECT().GetImageInfo(img, extension.Png).Return(&gpdf.ImageInfoType{}, uuid.UUID{})
+		image.EXPECT().GetDimensions(img).Return(&entity.Dimensions{}, nil)
 
 		dep := &gofpdf.Dependencies{
 			Cache: cache,
@@ -1124,7 +1872,7 @@ func TestProvider_GetDimensionsByQrCode(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		dimensions, err := sut.GetDimensionsByQrCode(codeContent)
+		dimensions, err := sut.GetDimensionsByQrCode(codeContent, &prop)
 
 		// Assert
 		cache.AssertNumberOfCalls(t, "GetImage", 1)
@@ -1133,10 +1881,11 @@ func TestProvider_GetDimensionsByQrCode(t *testing.T) {
 		assert.Nil(t, err)
 	})
 	t.Run("when can find qrCode on cache, should return dimension", func(t *testing.T) {
+		prop := fixture.QrCodeProp()
 		img := &entity.Image{Bytes: []byte{1, 2, 3}}
 
 		cache := mocks.NewCache(t)
-		cache.EXPECT().GetImage("qr-code-code", extension.Png).Return(img, nil)
+		cache.EXPECT().GetImage("qr-code-M-0----code", extension.Png).Return(img, nil)
 
 		code := mocks.NewCode(t)
 
@@ -1150,7 +1899,7 @@ func TestProvider_GetDimensionsByQrCode(t *testing.T) {
 		}
 
 		image := mocks.NewImage(t)
-		image.EXPECT().GetImageInfo(img, extension.Png).Return(&gpdf.ImageInfoType{}, uuid.UUID{})
+		image.EXPECT().GetDimensions(img).Return(&entity.Dimensions{}, nil)
 
 		dep := &gofpdf.Dependencies{
 			Cache: cache,
@@ -1162,16 +1911,222 @@ func TestProvider_GetDimensionsByQrCode(t *testing.T) {
 		sut := gofpdf.New(dep)
 
 		// Act
-		dimensions, err := sut.GetDimensionsByQrCode(codeContent)
+		dimensions, err := sut.GetDimensionsByQrCode(codeContent, &prop)
 
 		// Assert
 		cache.AssertNumberOfCalls(t, "GetImage", 1)
 		assert.NotNil(t, dimensions)
 		assert.Nil(t, err)
 	})
+	t.Run("when qrcode options are sent, should use them in cache key", func(t *testing.T) {
+		// Arrange
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+		qrCode := &props.QrCode{ErrorCorrection: qrlevel.H, QuietZone: 4, Color: &props.RedColor}
+		key := "qr-code-H-4-RGB(255, 0, 0)---code"
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage(key, extension.Png).Return(nil, errors.New("anyError1"))
+		cache.EXPECT().AddImage(key, img)
+
+		code := mocks.NewCode(t)
+		code.EXPECT().GenQr(codeContent, qrCode).Return(img, nil)
+
+		image := mocks.NewImage(t)
+		image.EXPECT().GetDimensions(img).Return(&entity.Dimensions{}, nil)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Image: image,
+			Code:  code,
+		}
+
+		sut := gofpdf.New(dep)
+
+		// Act
+		dimensions, err := sut.GetDimensionsByQrCode(codeContent, qrCode)
+
+		// Assert
+		cache.AssertNumberOfCalls(t, "AddImage", 1)
+		assert.NotNil(t, dimensions)
+		assert.Nil(t, err)
+	})
+}
+
+// nolint: dupl
+func TestProvider_GetDimensionsByBarCode(t *testing.T) {
+	t.Run("when cannot generate barcode, should return error", func(t *testing.T) {
+		// Arrange
+		prop := fixture.BarcodeProp()
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("bar-code-codecode128-16-3.2-0-0-0-", extension.Png).Return(nil, errors.New("anyError1"))
+
+		code := mocks.NewCode(t)
+		code.EXPECT().GenBar(codeContent, (*entity.Cell)(nil), &prop).Return(nil, errors.New("anyError2"))
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Code:  code,
+		}
+
+		// Act
+		dimensions, err := gofpdf.New(dep).GetDimensionsByBarCode(codeContent, &prop)
+
+		// Assert
+		assert.Nil(t, dimensions)
+		assert.NotNil(t, err)
+	})
+	t.Run("when can generate barcode, should return the modules", func(t *testing.T) {
+		// Arrange
+		prop := fixture.BarcodeProp()
+		img := &entity.Image{Bytes: []byte{1, 2, 3}, Dimensions: &entity.Dimensions{Width: 100, Height: 20}}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("bar-code-codecode128-16-3.2-0-0-0-", extension.Png).Return(img, nil)
+		cache.EXPECT().AddImage("bar-code-codecode128-16-3.2-0-0-0-", img)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+		}
+
+		// Act
+		dimensions, err := gofpdf.New(dep).GetDimensionsByBarCode(codeContent, &prop)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 100.0, dimensions.Width)
+	})
+	t.Run("when sizes differ, should cache each barcode apart", func(t *testing.T) {
+		// Arrange
+		prop := fixture.BarcodeProp()
+		moduleProp := prop
+		moduleProp.ModuleWidth = 0.5
+		img := &entity.Image{Bytes: []byte{1, 2, 3}, Dimensions: &entity.Dimensions{Width: 100, Height: 20}}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("bar-code-codecode128-16-3.2-0-0-0-", extension.Png).Return(img, nil)
+		cache.EXPECT().AddImage("bar-code-codecode128-16-3.2-0-0-0-", img)
+		cache.EXPECT().GetImage("bar-code-codecode128-16-3.2-0.5-0-0-", extension.Png).Return(img, nil)
+		cache.EXPECT().AddImage("bar-code-codecode128-16-3.2-0.5-0-0-", img)
+
+		sut := gofpdf.New(&gofpdf.Dependencies{Cache: cache})
+
+		// Act
+		_, err := sut.GetDimensionsByBarCode(codeContent, &prop)
+		_, moduleErr := sut.GetDimensionsByBarCode(codeContent, &moduleProp)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Nil(t, moduleErr)
+	})
+}
+
+// nolint: dupl
+func TestProvider_GetDimensionsByPDF417(t *testing.T) {
+	t.Run("when cannot find image on cache and cannot generate pdf417, should return error", func(t *testing.T) {
+		// Arrange
+		prop := fixture.PDF417Prop()
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("pdf417-4-code", extension.Png).Return(nil, errors.New("anyError1"))
+
+		code := mocks.NewCode(t)
+		code.EXPECT().GenPDF417(codeContent, &prop).Return(nil, errors.New("anyError2"))
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Code:  code,
+		}
+
+		// Act
+		dimensions, err := gofpdf.New(dep).GetDimensionsByPDF417(codeContent, &prop)
+
+		// Assert
+		assert.Nil(t, dimensions)
+		assert.NotNil(t, err)
+	})
+	t.Run("when can find pdf417 on cache, should return dimension", func(t *testing.T) {
+		// Arrange
+		prop := fixture.PDF417Prop()
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("pdf417-4-code", extension.Png).Return(img, nil)
+
+		code := mocks.NewCode(t)
+
+		image := mocks.NewImage(t)
+		image.EXPECT().GetDimensions(img).Return(&entity.Dimensions{}, nil)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Image: image,
+			Code:  code,
+		}
+
+		// Act
+		dimensions, err := gofpdf.New(dep).GetDimensionsByPDF417(codeContent, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "GenPDF417", 0)
+		assert.NotNil(t, dimensions)
+		assert.Nil(t, err)
+	})
 }
 
 // nolint: dupl
+func TestProvider_GetDimensionsByAztec(t *testing.T) {
+	t.Run("when cannot find image on cache and cannot generate aztec, should return error", func(t *testing.T) {
+		// Arrange
+		prop := fixture.AztecProp()
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("aztec-33-code", extension.Png).Return(nil, errors.New("anyError1"))
+
+		code := mocks.NewCode(t)
+		code.EXPECT().GenAztec(codeContent, &prop).Return(nil, errors.New("anyError2"))
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Code:  code,
+		}
+
+		// Act
+		dimensions, err := gofpdf.New(dep).GetDimensionsByAztec(codeContent, &prop)
+
+		// Assert
+		assert.Nil(t, dimensions)
+		assert.NotNil(t, err)
+	})
+	t.Run("when can find aztec on cache, should return dimension", func(t *testing.T) {
+		// Arrange
+		prop := fixture.AztecProp()
+		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+
+		cache := mocks.NewCache(t)
+		cache.EXPECT().GetImage("aztec-33-code", extension.Png).Return(img, nil)
+
+		code := mocks.NewCode(t)
+
+		image := mocks.NewImage(t)
+		image.EXPECT().GetDimensions(img).Return(&entity.Dimensions{}, nil)
+
+		dep := &gofpdf.Dependencies{
+			Cache: cache,
+			Image: image,
+			Code:  code,
+		}
+
+		// Act
+		dimensions, err := gofpdf.New(dep).GetDimensionsByAztec(codeContent, &prop)
+
+		// Assert
+		code.AssertNumberOfCalls(t, "GenAztec", 0)
+		assert.NotNil(t, dimensions)
+		assert.Nil(t, err)
+	})
+}
+
 func TestProvider_GetDimensionsByImage(t *testing.T) {
 	t.Run("when cannot find image on cache and cannot load image, should return error", func(t *testing.T) {
 		// Arrange
@@ -1197,7 +2152,7 @@ func TestProvider_GetDimensionsByImage(t *testing.T) {
 	})
 
 	t.Run("when can find image on cache, should return dimension", func(t *testing.T) {
-		img := &entity.Image{Bytes: []byte{1, 2, 3}}
+		img := &entity.Image{Bytes: []byte{1, 2, 3}, Extension: extension.Jpg}
 
 		// Arrange
 
@@ -1205,7 +2160,7 @@ func TestProvider_GetDimensionsByImage(t *testing.T) {
 		cache.EXPECT().GetImage("docs/assets/images/biplane.jpg", extension.Jpg).Return(img, nil)
 
 		image := mocks.NewImage(t)
-		image.EXPECT().GetImageInfo(img, extension.Jpg).Return(&gpdf.ImageInfoType{}, uuid.UUID{})
+		image.EXPECT().GetDimensions(img).Return(&entity.Dimensions{}, nil)
 
 		dep := &gofpdf.Dependencies{
 			Cache: cache,
@@ -1247,7 +2202,7 @@ func TestProvider_GetDimensionsByImageByte(t *testing.T) {
 		// Arrange
 
 		image := mocks.NewImage(t)
-		image.EXPECT().GetImageInfo(&img, extension.Png).Return(&gpdf.ImageInfoType{}, uuid.UUID{})
+		image.EXPECT().GetDimensions(&img).Return(&entity.Dimensions{}, nil)
 
 		dep := &gofpdf.Dependencies{
 			Image: image,
@@ -1259,10 +2214,34 @@ func TestProvider_GetDimensionsByImageByte(t *testing.T) {
 		dimensions, err := sut.GetDimensionsByImageByte(img.Bytes, extension.Png)
 
 		// Assert
-		image.AssertNumberOfCalls(t, "GetImageInfo", 1)
+		image.AssertNumberOfCalls(t, "GetDimensions", 1)
 		assert.Nil(t, err)
 		assert.NotNil(t, dimensions)
 	})
+	t.Run("when svg bytes are sent, should return svg dimension", func(t *testing.T) {
+		// Arrange
+		svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 200 100"><rect width="200" height="100"/></svg>`)
+
+		sut := gofpdf.New(&gofpdf.Dependencies{})
+
+		// Act
+		dimensions, err := sut.GetDimensionsByImageByte(svg, extension.Svg)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, &entity.Dimensions{Width: 200, Height: 100}, dimensions)
+	})
+	t.Run("when invalid svg bytes are sent, should return an error", func(t *testing.T) {
+		// Arrange
+		sut := gofpdf.New(&gofpdf.Dependencies{})
+
+		// Act
+		dimensions, err := sut.GetDimensionsByImageByte([]byte("<html></html>"), extension.Svg)
+
+		// Assert
+		assert.Nil(t, dimensions)
+		assert.NotNil(t, err)
+	})
 }
 
 /*func TestProvider_AddImageFromFile(t *testing.T) {
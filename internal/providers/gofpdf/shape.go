@@ -0,0 +1,224 @@
+package gofpdf
+
+import (
+	"errors"
+	"math"
+
+	"github.com/jung-kurt/gofpdf"
+
+	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf/gofpdfwrapper"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// allCorners defines that all the corners of a rectangle are rounded.
+const allCorners = "1234"
+
+type shape struct {
+	pdf              gofpdfwrapper.Fpdf
+	defaultDrawColor *props.Color
+	defaultFillColor *props.Color
+	defaultThickness float64
+}
+
+// NewShape create a Shape.
+func NewShape(pdf gofpdfwrapper.Fpdf) *shape {
+	return &shape{
+		pdf:              pdf,
+		defaultDrawColor: &props.BlackColor,
+		defaultFillColor: &props.WhiteColor,
+		defaultThickness: linestyle.DefaultLineThickness,
+	}
+}
+
+// AddRectangle draws a rectangle which fills the cell.
+func (s *shape) AddRectangle(cell *entity.Cell, prop *props.Shape) {
+	style := s.getStyle(prop)
+	if style == "" {
+		return
+	}
+
+	left, top, _, _ := s.pdf.GetMargins()
+	s.setStyle(prop)
+
+	if prop.Radius > 0 {
+		radius := math.Min(prop.Radius, math.Min(cell.Width, cell.Height)/2)
+		s.pdf.RoundedRect(left+cell.X, top+cell.Y, cell.Width, cell.Height, radius, allCorners, style)
+	} else {
+		s.pdf.Rect(left+cell.X, top+cell.Y, cell.Width, cell.Height, style)
+	}
+
+	s.resetStyle(prop)
+}
+
+// AddEllipse draws an ellipse which fills the cell.
+func (s *shape) AddEllipse(cell *entity.Cell, prop *props.Shape) {
+	style := s.getStyle(prop)
+	if style == "" {
+		return
+	}
+
+	left, top, _, _ := s.pdf.GetMargins()
+	s.setStyle(prop)
+	s.pdf.Ellipse(left+cell.X+cell.Width/2, top+cell.Y+cell.Height/2, cell.Width/2, cell.Height/2, 0, style)
+	s.resetStyle(prop)
+}
+
+// AddPolygon draws a closed shape through the points.
+func (s *shape) AddPolygon(points []props.Point, cell *entity.Cell, prop *props.Shape) error {
+	if len(points) < 3 {
+		return errors.New("polygon requires at least 3 points")
+	}
+
+	style := s.getStyle(prop)
+	if style == "" {
+		return nil
+	}
+
+	s.setStyle(prop)
+	s.pdf.Polygon(s.toPdfPoints(points, cell), style)
+	s.resetStyle(prop)
+
+	return nil
+}
+
+// AddPolyline draws straight lines through the points.
+func (s *shape) AddPolyline(points []props.Point, cell *entity.Cell, prop *props.Shape) error {
+	if len(points) < 2 {
+		return errors.New("polyline requires at least 2 points")
+	}
+
+	if prop.NoStroke {
+		return nil
+	}
+
+	pdfPoints := s.toPdfPoints(points, cell)
+
+	s.setStyle(prop)
+	s.pdf.MoveTo(pdfPoints[0].X, pdfPoints[0].Y)
+	for _, point := range pdfPoints[1:] {
+		s.pdf.LineTo(point.X, point.Y)
+	}
+	s.pdf.DrawPath("D")
+	s.resetStyle(prop)
+
+	return nil
+}
+
+// AddBezier draws cubic Bézier curves, the first point is the start of the path and each
+// following group of 3 points are the two control points and the end of a curve.
+func (s *shape) AddBezier(points []props.Point, cell *entity.Cell, prop *props.Shape) error {
+	if len(points) < 4 || (len(points)-1)%3 != 0 {
+		return errors.New("bezier requires a start point and groups of 3 points for each curve")
+	}
+
+	style := s.getStyle(prop)
+	if style == "" {
+		return nil
+	}
+
+	pdfPoints := s.toPdfPoints(points, cell)
+
+	s.setStyle(prop)
+	s.pdf.MoveTo(pdfPoints[0].X, pdfPoints[0].Y)
+	for i := 1; i < len(pdfPoints); i += 3 {
+		s.pdf.CurveBezierCubicTo(pdfPoints[i].X, pdfPoints[i].Y, pdfPoints[i+1].X, pdfPoints[i+1].Y,
+			pdfPoints[i+2].X, pdfPoints[i+2].Y)
+	}
+	s.pdf.DrawPath(style)
+	s.resetStyle(prop)
+
+	return nil
+}
+
+// AddArrow draws a line between the points with an arrowhead in the end point.
+func (s *shape) AddArrow(from, to props.Point, cell *entity.Cell, prop *props.Shape) error {
+	pdfPoints := s.toPdfPoints([]props.Point{from, to}, cell)
+	start, end := pdfPoints[0], pdfPoints[1]
+
+	length := math.Hypot(end.X-start.X, end.Y-start.Y)
+	if length == 0 {
+		return errors.New("arrow requires different start and end points")
+	}
+
+	if prop.NoStroke {
+		return nil
+	}
+
+	// The arrowhead is an isosceles triangle with the tip in the end point
+	dx, dy := (end.X-start.X)/length, (end.Y-start.Y)/length
+	headLength := math.Min(prop.ArrowSize, length)
+	headWidth := headLength / 2
+	base := gofpdf.PointType{X: end.X - dx*headLength, Y: end.Y - dy*headLength}
+
+	s.setStyle(prop)
+	s.pdf.Line(start.X, start.Y, base.X, base.Y)
+
+	if len(prop.DashPattern) > 0 {
+		s.pdf.SetDashPattern([]float64{}, 0)
+	}
+	s.pdf.SetFillColor(prop.StrokeColor.Red, prop.StrokeColor.Green, prop.StrokeColor.Blue)
+	s.pdf.Polygon([]gofpdf.PointType{
+		end,
+		{X: base.X - dy*headWidth, Y: base.Y + dx*headWidth},
+		{X: base.X + dy*headWidth, Y: base.Y - dx*headWidth},
+	}, "F")
+
+	s.resetStyle(prop)
+
+	return nil
+}
+
+// toPdfPoints converts the points in percent of the cell to points in the page.
+func (s *shape) toPdfPoints(points []props.Point, cell *entity.Cell) []gofpdf.PointType {
+	left, top, _, _ := s.pdf.GetMargins()
+
+	pdfPoints := make([]gofpdf.PointType, len(points))
+	for i, point := range points {
+		pdfPoints[i] = gofpdf.PointType{
+			X: left + cell.X + cell.Width*point.X/100,
+			Y: top + cell.Y + cell.Height*point.Y/100,
+		}
+	}
+
+	return pdfPoints
+}
+
+// getStyle returns "D" to draw the outline, "F" to fill or "FD" to do both.
+// An empty style means that there is nothing to draw.
+func (s *shape) getStyle(prop *props.Shape) string {
+	style := ""
+	if prop.FillColor != nil {
+		style += "F"
+	}
+
+	if !prop.NoStroke {
+		style += "D"
+	}
+
+	return style
+}
+
+func (s *shape) setStyle(prop *props.Shape) {
+	s.pdf.SetDrawColor(prop.StrokeColor.Red, prop.StrokeColor.Green, prop.StrokeColor.Blue)
+	s.pdf.SetLineWidth(prop.StrokeWidth)
+
+	if prop.FillColor != nil {
+		s.pdf.SetFillColor(prop.FillColor.Red, prop.FillColor.Green, prop.FillColor.Blue)
+	}
+
+	if len(prop.DashPattern) > 0 {
+		s.pdf.SetDashPattern(prop.DashPattern, 0)
+	}
+}
+
+func (s *shape) resetStyle(prop *props.Shape) {
+	s.pdf.SetDrawColor(s.defaultDrawColor.Red, s.defaultDrawColor.Green, s.defaultDrawColor.Blue)
+	s.pdf.SetFillColor(s.defaultFillColor.Red, s.defaultFillColor.Green, s.defaultFillColor.Blue)
+	s.pdf.SetLineWidth(s.defaultThickness)
+
+	if len(prop.DashPattern) > 0 {
+		s.pdf.SetDashPattern([]float64{}, 0)
+	}
+}
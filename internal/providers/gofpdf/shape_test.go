@@ -0,0 +1,277 @@
+package gofpdf_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	gpdf "github.com/jung-kurt/gofpdf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewShape(t *testing.T) {
+	// Act
+	sut := gofpdf.NewShape(nil)
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*gofpdf.shape", fmt.Sprintf("%T", sut))
+}
+
+func TestShape_AddRectangle(t *testing.T) {
+	t.Run("when there is no stroke and no fill, should not draw", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.Shape{NoStroke: true}
+		prop.MakeValid()
+
+		sut := gofpdf.NewShape(mocks.NewFpdf(t))
+
+		// Act
+		sut.AddRectangle(&cell, &prop)
+	})
+	t.Run("when radius is defined, should draw a rounded rectangle", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		pdf.EXPECT().SetDrawColor(mock.Anything, mock.Anything, mock.Anything)
+		pdf.EXPECT().SetFillColor(mock.Anything, mock.Anything, mock.Anything)
+		pdf.EXPECT().SetLineWidth(mock.Anything)
+		pdf.EXPECT().SetDashPattern(mock.Anything, 0.0)
+		pdf.EXPECT().RoundedRect(10+cell.X, 10+cell.Y, cell.Width, cell.Height, prop.Radius, "1234", "FD")
+
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		sut.AddRectangle(&cell, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "RoundedRect", 1)
+		pdf.AssertCalled(t, "SetDashPattern", prop.DashPattern, 0.0)
+		pdf.AssertCalled(t, "SetDashPattern", []float64{}, 0.0)
+	})
+	t.Run("when radius is not defined, should draw a rectangle", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.Shape{}
+		prop.MakeValid()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().SetLineWidth(0.2)
+		pdf.EXPECT().Rect(10+cell.X, 10+cell.Y, cell.Width, cell.Height, "D")
+
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		sut.AddRectangle(&cell, &prop)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Rect", 1)
+	})
+}
+
+func TestShape_AddEllipse(t *testing.T) {
+	// Arrange
+	cell := fixture.CellEntity()
+	prop := props.Shape{FillColor: &props.RedColor, NoStroke: true}
+	prop.MakeValid()
+
+	pdf := mocks.NewFpdf(t)
+	pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+	pdf.EXPECT().SetDrawColor(0, 0, 0)
+	pdf.EXPECT().SetFillColor(mock.Anything, mock.Anything, mock.Anything)
+	pdf.EXPECT().SetLineWidth(0.2)
+	pdf.EXPECT().Ellipse(10+cell.X+cell.Width/2, 10+cell.Y+cell.Height/2, cell.Width/2, cell.Height/2, 0.0, "F")
+
+	sut := gofpdf.NewShape(pdf)
+
+	// Act
+	sut.AddEllipse(&cell, &prop)
+
+	// Assert
+	pdf.AssertNumberOfCalls(t, "Ellipse", 1)
+}
+
+func TestShape_AddPolygon(t *testing.T) {
+	t.Run("when there are less than 3 points, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+
+		sut := gofpdf.NewShape(mocks.NewFpdf(t))
+
+		// Act
+		err := sut.AddPolygon([]props.Point{{X: 0, Y: 0}, {X: 100, Y: 100}}, &cell, &prop)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when points are valid, should draw polygon relative to the cell", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.Shape{}
+		prop.MakeValid()
+		points := []props.Point{{X: 50, Y: 0}, {X: 100, Y: 100}, {X: 0, Y: 100}}
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().SetLineWidth(0.2)
+		pdf.EXPECT().Polygon([]gpdf.PointType{
+			{X: 10 + cell.X + cell.Width/2, Y: 10 + cell.Y},
+			{X: 10 + cell.X + cell.Width, Y: 10 + cell.Y + cell.Height},
+			{X: 10 + cell.X, Y: 10 + cell.Y + cell.Height},
+		}, "D")
+
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		err := sut.AddPolygon(points, &cell, &prop)
+
+		// Assert
+		assert.Nil(t, err)
+		pdf.AssertNumberOfCalls(t, "Polygon", 1)
+	})
+}
+
+func TestShape_AddPolyline(t *testing.T) {
+	t.Run("when there are less than 2 points, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+
+		sut := gofpdf.NewShape(mocks.NewFpdf(t))
+
+		// Act
+		err := sut.AddPolyline([]props.Point{{X: 0, Y: 0}}, &cell, &prop)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when points are valid, should draw lines", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.Shape{}
+		prop.MakeValid()
+		points := []props.Point{{X: 0, Y: 0}, {X: 50, Y: 100}, {X: 100, Y: 0}}
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().SetLineWidth(0.2)
+		pdf.EXPECT().MoveTo(10+cell.X, 10+cell.Y)
+		pdf.EXPECT().LineTo(mock.Anything, mock.Anything)
+		pdf.EXPECT().DrawPath("D")
+
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		err := sut.AddPolyline(points, &cell, &prop)
+
+		// Assert
+		assert.Nil(t, err)
+		pdf.AssertNumberOfCalls(t, "LineTo", 2)
+	})
+}
+
+func TestShape_AddBezier(t *testing.T) {
+	t.Run("when points are not a start and groups of 3, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+		points := []props.Point{{X: 0, Y: 0}, {X: 0, Y: 100}, {X: 100, Y: 100}}
+
+		sut := gofpdf.NewShape(mocks.NewFpdf(t))
+
+		// Act
+		err := sut.AddBezier(points, &cell, &prop)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when points are valid, should draw curves", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.Shape{}
+		prop.MakeValid()
+		points := []props.Point{{X: 0, Y: 100}, {X: 0, Y: 0}, {X: 100, Y: 0}, {X: 100, Y: 100}}
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		pdf.EXPECT().SetFillColor(255, 255, 255)
+		pdf.EXPECT().SetLineWidth(0.2)
+		pdf.EXPECT().MoveTo(10+cell.X, 10+cell.Y+cell.Height)
+		pdf.EXPECT().CurveBezierCubicTo(10+cell.X, 10+cell.Y, 10+cell.X+cell.Width, 10+cell.Y,
+			10+cell.X+cell.Width, 10+cell.Y+cell.Height)
+		pdf.EXPECT().DrawPath("D")
+
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		err := sut.AddBezier(points, &cell, &prop)
+
+		// Assert
+		assert.Nil(t, err)
+		pdf.AssertNumberOfCalls(t, "CurveBezierCubicTo", 1)
+	})
+}
+
+func TestShape_AddArrow(t *testing.T) {
+	t.Run("when start and end are equal, should return error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		err := sut.AddArrow(props.Point{X: 10, Y: 10}, props.Point{X: 10, Y: 10}, &cell, &prop)
+
+		// Assert
+		assert.NotNil(t, err)
+	})
+	t.Run("when points are valid, should draw line and arrowhead", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.Shape{}
+		prop.MakeValid()
+		startX, endX, y := 10+cell.X, 10+cell.X+cell.Width, 10+cell.Y+cell.Height/2
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		pdf.EXPECT().SetDrawColor(0, 0, 0)
+		pdf.EXPECT().SetFillColor(mock.Anything, mock.Anything, mock.Anything)
+		pdf.EXPECT().SetLineWidth(0.2)
+		pdf.EXPECT().Line(startX, y, endX-prop.ArrowSize, y)
+		pdf.EXPECT().Polygon([]gpdf.PointType{
+			{X: endX, Y: y},
+			{X: endX - prop.ArrowSize, Y: y + prop.ArrowSize/2},
+			{X: endX - prop.ArrowSize, Y: y - prop.ArrowSize/2},
+		}, "F")
+
+		sut := gofpdf.NewShape(pdf)
+
+		// Act
+		err := sut.AddArrow(props.Point{X: 0, Y: 50}, props.Point{X: 100, Y: 50}, &cell, &prop)
+
+		// Assert
+		assert.Nil(t, err)
+		pdf.AssertNumberOfCalls(t, "Polygon", 1)
+	})
+}
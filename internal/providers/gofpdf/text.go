@@ -2,6 +2,7 @@ package gofpdf
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"unicode"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/tabstop"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
@@ -68,6 +70,18 @@ func (s *text) Add(text string, cell *entity.Cell, textProp *props.Text) {
 
 	// Apply Unicode before calc spaces
 	unicodeText := s.textToUnicode(text, textProp)
+
+	// If tab stops are defined, every line is written using them
+	if len(textProp.TabStops) > 0 {
+		for index, line := range strings.Split(unicodeText, "\n") {
+			s.addTabbedLine(textProp, x, width, y+float64(index)*(fontHeight+textProp.VerticalPadding), line)
+		}
+		if textProp.Color != nil {
+			s.font.SetColor(originalColor)
+		}
+		return
+	}
+
 	stringWidth := s.pdf.GetStringWidth(unicodeText)
 
 	// If should add one line
@@ -108,6 +122,10 @@ func (s *text) GetLinesQuantity(text string, textProp *props.Text, colWidth floa
 
 	textTranslated := s.textToUnicode(text, textProp)
 
+	if len(textProp.TabStops) > 0 {
+		return len(strings.Split(textTranslated, "\n"))
+	}
+
 	if textProp.BreakLineStrategy == breakline.DashStrategy {
 		return len(s.getLinesBreakingLineWithDash(text, colWidth))
 	} else {
@@ -115,6 +133,13 @@ func (s *text) GetLinesQuantity(text string, textProp *props.Text, colWidth floa
 	}
 }
 
+// GetStringWidth retrieve the width of a text written in a single line.
+func (s *text) GetStringWidth(text string, textProp *props.Text) float64 {
+	s.font.SetFont(textProp.Family, textProp.Style, textProp.Size)
+
+	return s.pdf.GetStringWidth(s.textToUnicode(text, textProp))
+}
+
 func (s *text) getLinesBreakingLineFromSpace(words []string, colWidth float64) []string {
 	currentlySize := 0.0
 	actualLine := 0
@@ -228,6 +253,87 @@ func (s *text) addLine(textProp *props.Text, xColOffset, colWidth, yColOffset, t
 	s.pdf.Text(dx+xColOffset+left, yColOffset+top, text)
 }
 
+func (s *text) addTabbedLine(textProp *props.Text, xColOffset, colWidth, yColOffset float64, text string) {
+	left, top, _, _ := s.pdf.GetMargins()
+
+	cursor := 0.0
+	for index, segment := range strings.Split(text, "\t") {
+		segmentWidth := s.pdf.GetStringWidth(segment)
+		start := cursor
+
+		if index > 0 {
+			tabStop := s.getNextTabStop(textProp.TabStops, cursor)
+			if tabStop == nil {
+				start = cursor + s.pdf.GetStringWidth(" ")
+			} else {
+				start = s.getTabStopStart(tabStop, min(tabStop.Position, colWidth), segment, segmentWidth)
+				if start < cursor {
+					start = cursor
+				}
+				s.addLeader(s.textToUnicode(tabStop.Leader, textProp), xColOffset+left, yColOffset+top, cursor, start)
+			}
+		}
+
+		s.pdf.Text(xColOffset+left+start, yColOffset+top, segment)
+		cursor = start + segmentWidth
+	}
+
+	if textProp.Hyperlink != nil {
+		fontHeight := s.font.GetHeight(textProp.Family, textProp.Style, textProp.Size)
+		s.pdf.LinkString(xColOffset+left, yColOffset+top-fontHeight, cursor, fontHeight, *textProp.Hyperlink)
+	}
+}
+
+// getNextTabStop returns the first tab stop after the cursor, tab stops are sorted by position.
+func (s *text) getNextTabStop(tabStops []props.TabStop, cursor float64) *props.TabStop {
+	for i := range tabStops {
+		if tabStops[i].Position > cursor {
+			return &tabStops[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *text) getTabStopStart(tabStop *props.TabStop, position float64, segment string, segmentWidth float64) float64 {
+	switch tabStop.Align {
+	case tabstop.Right:
+		return position - segmentWidth
+	case tabstop.Center:
+		return position - segmentWidth/2
+	case tabstop.Decimal:
+		separatorIndex := strings.Index(segment, ".")
+		if separatorIndex < 0 {
+			return position - segmentWidth
+		}
+		return position - s.pdf.GetStringWidth(segment[:separatorIndex])
+	default:
+		return position
+	}
+}
+
+// addLeader fills the space between two positions with the leader, the leader characters are placed
+// on a fixed grid so leaders from different lines stay aligned.
+func (s *text) addLeader(leader string, x, y, from, to float64) {
+	if leader == "" {
+		return
+	}
+
+	leaderWidth := s.pdf.GetStringWidth(leader)
+	if leaderWidth <= 0 {
+		return
+	}
+
+	spaceWidth := s.pdf.GetStringWidth(" ")
+	first := math.Ceil((from + spaceWidth) / leaderWidth)
+	last := math.Floor((to - spaceWidth) / leaderWidth)
+	if last <= first {
+		return
+	}
+
+	s.pdf.Text(x+first*leaderWidth, y, strings.Repeat(leader, int(last-first)))
+}
+
 func (s *text) textToUnicode(txt string, props *props.Text) string {
 	if props.Family == fontfamily.Arial ||
 		props.Family == fontfamily.Helvetica ||
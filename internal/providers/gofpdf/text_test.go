@@ -8,6 +8,8 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/tabstop"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 
 	"github.com/johnfercher/maroto/v2/mocks"
@@ -59,4 +61,73 @@ func TestGetLinesheight(t *testing.T) {
 
 		assert.Equal(t, 2, height)
 	})
+	t.Run("when tab stops are defined, should return the amount of lines", func(t *testing.T) {
+		textProp := &props.Text{TabStops: []props.TabStop{{Position: 50, Align: tabstop.Right}}}
+		textProp.MakeValid(&props.Font{Family: fontfamily.Arial, Size: 10, Style: fontstyle.Normal})
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(textProp.Family, textProp.Style, textProp.Size)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(s string) string { return s })
+
+		text := gofpdf.NewText(pdf, mocks.NewMath(t), font)
+
+		height := text.GetLinesQuantity("item\t1.50\nother item with a long description\t12.50", textProp, 11)
+
+		assert.Equal(t, 2, height)
+	})
+}
+
+func TestText_GetStringWidth(t *testing.T) {
+	// Arrange
+	textProp := &props.Text{}
+	textProp.MakeValid(&props.Font{Family: fontfamily.Arial, Size: 10, Style: fontstyle.Normal})
+
+	font := mocks.NewFont(t)
+	font.EXPECT().SetFont(textProp.Family, textProp.Style, textProp.Size)
+
+	pdf := mocks.NewFpdf(t)
+	pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(s string) string { return s })
+	pdf.EXPECT().GetStringWidth("iv.").Return(4.5)
+
+	text := gofpdf.NewText(pdf, mocks.NewMath(t), font)
+
+	// Act
+	width := text.GetStringWidth("iv.", textProp)
+
+	// Assert
+	assert.Equal(t, 4.5, width)
+}
+
+func TestText_Add(t *testing.T) {
+	t.Run("when tab stops are defined, should place text on the tab stops and fill with leader", func(t *testing.T) {
+		textProp := &props.Text{TabStops: []props.TabStop{{Position: 50, Align: tabstop.Right, Leader: "."}}}
+		textProp.MakeValid(&props.Font{Family: fontfamily.Arial, Size: 10, Style: fontstyle.Normal})
+		cell := &entity.Cell{X: 0, Y: 0, Width: 100, Height: 10}
+
+		font := mocks.NewFont(t)
+		font.EXPECT().SetFont(textProp.Family, textProp.Style, textProp.Size)
+		font.EXPECT().GetHeight(textProp.Family, textProp.Style, textProp.Size).Return(5)
+		font.EXPECT().GetColor().Return(textProp.Color)
+
+		pdf := mocks.NewFpdf(t)
+		pdf.EXPECT().UnicodeTranslatorFromDescriptor("").Return(func(s string) string { return s })
+		pdf.EXPECT().GetMargins().Return(10, 10, 10, 10)
+		pdf.EXPECT().GetStringWidth("item").Return(10)
+		pdf.EXPECT().GetStringWidth("1.50").Return(10)
+		pdf.EXPECT().GetStringWidth(".").Return(2)
+		pdf.EXPECT().GetStringWidth(" ").Return(2)
+		pdf.EXPECT().Text(10.0, 15.0, "item")
+		pdf.EXPECT().Text(22.0, 15.0, ".............")
+		pdf.EXPECT().Text(50.0, 15.0, "1.50")
+
+		text := gofpdf.NewText(pdf, mocks.NewMath(t), font)
+
+		// Act
+		text.Add("item\t1.50", cell, textProp)
+
+		// Assert
+		pdf.AssertNumberOfCalls(t, "Text", 3)
+	})
 }
@@ -0,0 +1,377 @@
+package svg
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// kappa is the distance of the control points used to approximate a quarter of a circle with a cubic bézier.
+const kappa = 0.5522847498
+
+// pathScanner reads the commands, numbers and flags of a path data attribute.
+type pathScanner struct {
+	data string
+	pos  int
+}
+
+func (s *pathScanner) skipSeparators() {
+	for s.pos < len(s.data) {
+		switch s.data[s.pos] {
+		case ' ', '\t', '\n', '\r', ',':
+			s.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (s *pathScanner) done() bool {
+	s.skipSeparators()
+	return s.pos >= len(s.data)
+}
+
+func (s *pathScanner) hasNumber() bool {
+	if s.done() {
+		return false
+	}
+
+	c := s.data[s.pos]
+	return c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9')
+}
+
+func (s *pathScanner) readNumber() (float64, error) {
+	if !s.hasNumber() {
+		return 0, fmt.Errorf("expected number at position %d", s.pos)
+	}
+
+	start := s.pos
+	if s.data[s.pos] == '-' || s.data[s.pos] == '+' {
+		s.pos++
+	}
+
+	hasDot, hasExponent := false, false
+	for s.pos < len(s.data) {
+		c := s.data[s.pos]
+		switch {
+		case c >= '0' && c <= '9':
+			s.pos++
+		case c == '.' && !hasDot && !hasExponent:
+			hasDot = true
+			s.pos++
+		case (c == 'e' || c == 'E') && !hasExponent:
+			hasExponent = true
+			s.pos++
+			if s.pos < len(s.data) && (s.data[s.pos] == '-' || s.data[s.pos] == '+') {
+				s.pos++
+			}
+		default:
+			return strconv.ParseFloat(s.data[start:s.pos], 64)
+		}
+	}
+
+	return strconv.ParseFloat(s.data[start:s.pos], 64)
+}
+
+// readFlag reads an arc flag, flags may be written without separators like "a1 1 0 01 5 5".
+func (s *pathScanner) readFlag() (bool, error) {
+	if s.done() || (s.data[s.pos] != '0' && s.data[s.pos] != '1') {
+		return false, fmt.Errorf("expected flag at position %d", s.pos)
+	}
+
+	s.pos++
+	return s.data[s.pos-1] == '1', nil
+}
+
+func (s *pathScanner) readNumbers(quantity int) ([]float64, error) {
+	numbers := make([]float64, quantity)
+	for i := range numbers {
+		number, err := s.readNumber()
+		if err != nil {
+			return nil, err
+		}
+		numbers[i] = number
+	}
+
+	return numbers, nil
+}
+
+// parseNumbers reads a list of numbers ignoring anything after the first invalid one.
+func parseNumbers(value string) []float64 {
+	scanner := &pathScanner{data: value}
+
+	var numbers []float64
+	for scanner.hasNumber() {
+		number, err := scanner.readNumber()
+		if err != nil {
+			break
+		}
+		numbers = append(numbers, number)
+	}
+
+	return numbers
+}
+
+// pathBuilder converts the relative and shorthand path commands into absolute commands.
+type pathBuilder struct {
+	commands []Command
+	current  Point
+	start    Point
+	control  Point
+	previous byte
+}
+
+// parsePath converts a path data attribute into move, line, curve and close commands.
+func parsePath(data string) ([]Command, error) {
+	scanner := &pathScanner{data: data}
+	builder := &pathBuilder{}
+
+	var command byte
+	for !scanner.done() {
+		c := scanner.data[scanner.pos]
+		if !scanner.hasNumber() {
+			command = c
+			scanner.pos++
+		} else if command == 0 || command == 'Z' || command == 'z' {
+			return nil, fmt.Errorf("unexpected number at position %d", scanner.pos)
+		}
+
+		if len(builder.commands) == 0 && command != 'M' && command != 'm' {
+			return nil, fmt.Errorf("path must start with a moveto, found %q", command)
+		}
+
+		if err := builder.add(command, scanner); err != nil {
+			return nil, err
+		}
+
+		// Subsequent coordinates after a move are implicit line commands.
+		if command == 'M' {
+			command = 'L'
+		} else if command == 'm' {
+			command = 'l'
+		}
+	}
+
+	return builder.commands, nil
+}
+
+func (b *pathBuilder) add(command byte, scanner *pathScanner) error {
+	relative := command >= 'a' && command <= 'z'
+	offset := Point{}
+	if relative {
+		offset = b.current
+	}
+
+	upper := command &^ 0x20
+	switch upper {
+	case 'M', 'L', 'T':
+		numbers, err := scanner.readNumbers(2)
+		if err != nil {
+			return err
+		}
+		point := Point{offset.X + numbers[0], offset.Y + numbers[1]}
+		switch upper {
+		case 'M':
+			b.moveTo(point)
+		case 'L':
+			b.lineTo(point)
+		default:
+			b.quadTo(b.reflectedControl('Q'), point)
+		}
+	case 'H', 'V':
+		numbers, err := scanner.readNumbers(1)
+		if err != nil {
+			return err
+		}
+		point := b.current
+		if upper == 'H' {
+			point.X = offset.X + numbers[0]
+		} else {
+			point.Y = offset.Y + numbers[0]
+		}
+		b.lineTo(point)
+	case 'C':
+		numbers, err := scanner.readNumbers(6)
+		if err != nil {
+			return err
+		}
+		b.cubicTo(Point{offset.X + numbers[0], offset.Y + numbers[1]},
+			Point{offset.X + numbers[2], offset.Y + numbers[3]},
+			Point{offset.X + numbers[4], offset.Y + numbers[5]})
+	case 'S':
+		numbers, err := scanner.readNumbers(4)
+		if err != nil {
+			return err
+		}
+		b.cubicTo(b.reflectedControl('C'),
+			Point{offset.X + numbers[0], offset.Y + numbers[1]},
+			Point{offset.X + numbers[2], offset.Y + numbers[3]})
+	case 'Q':
+		numbers, err := scanner.readNumbers(4)
+		if err != nil {
+			return err
+		}
+		b.quadTo(Point{offset.X + numbers[0], offset.Y + numbers[1]}, Point{offset.X + numbers[2], offset.Y + numbers[3]})
+	case 'A':
+		return b.addArc(scanner, offset)
+	case 'Z':
+		b.closePath()
+	default:
+		return fmt.Errorf("unsupported path command %q", command)
+	}
+
+	return nil
+}
+
+func (b *pathBuilder) addArc(scanner *pathScanner, offset Point) error {
+	radius, err := scanner.readNumbers(3)
+	if err != nil {
+		return err
+	}
+
+	largeArc, err := scanner.readFlag()
+	if err != nil {
+		return err
+	}
+
+	sweep, err := scanner.readFlag()
+	if err != nil {
+		return err
+	}
+
+	end, err := scanner.readNumbers(2)
+	if err != nil {
+		return err
+	}
+
+	b.arcTo(radius[0], radius[1], radius[2], largeArc, sweep, Point{offset.X + end[0], offset.Y + end[1]})
+	return nil
+}
+
+// reflectedControl returns the reflection of the last control point when the previous command
+// is of the same family, otherwise the current point, as the S and T commands require.
+func (b *pathBuilder) reflectedControl(family byte) Point {
+	if b.previous != family {
+		return b.current
+	}
+
+	return Point{2*b.current.X - b.control.X, 2*b.current.Y - b.control.Y}
+}
+
+func (b *pathBuilder) moveTo(point Point) {
+	b.commands = append(b.commands, Command{Type: MoveTo, Points: []Point{point}})
+	b.current, b.start, b.previous = point, point, 'M'
+}
+
+func (b *pathBuilder) lineTo(point Point) {
+	b.commands = append(b.commands, Command{Type: LineTo, Points: []Point{point}})
+	b.current, b.previous = point, 'L'
+}
+
+func (b *pathBuilder) cubicTo(control1, control2, end Point) {
+	b.commands = append(b.commands, Command{Type: CurveTo, Points: []Point{control1, control2, end}})
+	b.current, b.control, b.previous = end, control2, 'C'
+}
+
+// quadTo elevates a quadratic bézier to a cubic one.
+func (b *pathBuilder) quadTo(control, end Point) {
+	start := b.current
+	b.cubicTo(
+		Point{start.X + 2.0/3.0*(control.X-start.X), start.Y + 2.0/3.0*(control.Y-start.Y)},
+		Point{end.X + 2.0/3.0*(control.X-end.X), end.Y + 2.0/3.0*(control.Y-end.Y)},
+		end,
+	)
+	b.control, b.previous = control, 'Q'
+}
+
+func (b *pathBuilder) closePath() {
+	b.commands = append(b.commands, Command{Type: Close})
+	b.current, b.previous = b.start, 'Z'
+}
+
+// arcTo converts an elliptical arc in endpoint parameterization into cubic béziers
+// of at most 90 degrees each, following the SVG implementation notes.
+func (b *pathBuilder) arcTo(rx, ry, rotation float64, largeArc, sweep bool, end Point) {
+	start := b.current
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 || start == end {
+		b.lineTo(end)
+		return
+	}
+
+	phi := rotation * math.Pi / 180
+	cosPhi, sinPhi := math.Cos(phi), math.Sin(phi)
+
+	dx, dy := (start.X-end.X)/2, (start.Y-end.Y)/2
+	x1 := cosPhi*dx + sinPhi*dy
+	y1 := -sinPhi*dx + cosPhi*dy
+
+	lambda := (x1*x1)/(rx*rx) + (y1*y1)/(ry*ry)
+	if lambda > 1 {
+		rx, ry = rx*math.Sqrt(lambda), ry*math.Sqrt(lambda)
+	}
+
+	numerator := rx*rx*ry*ry - rx*rx*y1*y1 - ry*ry*x1*x1
+	denominator := rx*rx*y1*y1 + ry*ry*x1*x1
+	factor := math.Sqrt(math.Max(0, numerator/denominator))
+	if largeArc == sweep {
+		factor = -factor
+	}
+
+	cx1 := factor * rx * y1 / ry
+	cy1 := -factor * ry * x1 / rx
+	cx := cosPhi*cx1 - sinPhi*cy1 + (start.X+end.X)/2
+	cy := sinPhi*cx1 + cosPhi*cy1 + (start.Y+end.Y)/2
+
+	theta := vectorAngle(1, 0, (x1-cx1)/rx, (y1-cy1)/ry)
+	delta := vectorAngle((x1-cx1)/rx, (y1-cy1)/ry, (-x1-cx1)/rx, (-y1-cy1)/ry)
+	if !sweep && delta > 0 {
+		delta -= 2 * math.Pi
+	} else if sweep && delta < 0 {
+		delta += 2 * math.Pi
+	}
+
+	// huge radii overflow to infinity, which leaves no arc to draw, so the end is joined with a line.
+	segments := int(math.Ceil(math.Abs(delta) / (math.Pi / 2)))
+	if segments == 0 || !isFinite(rx, ry, cx, cy, delta) {
+		b.lineTo(end)
+		return
+	}
+
+	step := delta / float64(segments)
+	handle := 4.0 / 3.0 * math.Tan(step/4)
+
+	point := func(angle, radiusX, radiusY float64) Point {
+		x, y := radiusX*math.Cos(angle), radiusY*math.Sin(angle)
+		return Point{cosPhi*x - sinPhi*y + cx, sinPhi*x + cosPhi*y + cy}
+	}
+	derivative := func(angle float64) Point {
+		x, y := -rx*math.Sin(angle), ry*math.Cos(angle)
+		return Point{cosPhi*x - sinPhi*y, sinPhi*x + cosPhi*y}
+	}
+
+	for i := 0; i < segments; i++ {
+		from, to := theta+float64(i)*step, theta+float64(i+1)*step
+		p1, p2 := point(from, rx, ry), point(to, rx, ry)
+		d1, d2 := derivative(from), derivative(to)
+		b.cubicTo(Point{p1.X + handle*d1.X, p1.Y + handle*d1.Y}, Point{p2.X - handle*d2.X, p2.Y - handle*d2.Y}, p2)
+	}
+
+	// avoids floating point drift on the end point.
+	b.commands[len(b.commands)-1].Points[2] = end
+	b.current = end
+}
+
+func vectorAngle(ux, uy, vx, vy float64) float64 {
+	return math.Atan2(ux*vy-uy*vx, ux*vx+uy*vy)
+}
+
+func isFinite(values ...float64) bool {
+	for _, value := range values {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return false
+		}
+	}
+
+	return true
+}
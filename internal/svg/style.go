@@ -0,0 +1,185 @@
+package svg
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// namedColors are the most used CSS color keywords.
+var namedColors = map[string]props.Color{
+	"black":   {Red: 0, Green: 0, Blue: 0},
+	"white":   {Red: 255, Green: 255, Blue: 255},
+	"red":     {Red: 255, Green: 0, Blue: 0},
+	"green":   {Red: 0, Green: 128, Blue: 0},
+	"lime":    {Red: 0, Green: 255, Blue: 0},
+	"blue":    {Red: 0, Green: 0, Blue: 255},
+	"navy":    {Red: 0, Green: 0, Blue: 128},
+	"yellow":  {Red: 255, Green: 255, Blue: 0},
+	"orange":  {Red: 255, Green: 165, Blue: 0},
+	"purple":  {Red: 128, Green: 0, Blue: 128},
+	"fuchsia": {Red: 255, Green: 0, Blue: 255},
+	"magenta": {Red: 255, Green: 0, Blue: 255},
+	"aqua":    {Red: 0, Green: 255, Blue: 255},
+	"cyan":    {Red: 0, Green: 255, Blue: 255},
+	"teal":    {Red: 0, Green: 128, Blue: 128},
+	"olive":   {Red: 128, Green: 128, Blue: 0},
+	"maroon":  {Red: 128, Green: 0, Blue: 0},
+	"silver":  {Red: 192, Green: 192, Blue: 192},
+	"gray":    {Red: 128, Green: 128, Blue: 128},
+	"grey":    {Red: 128, Green: 128, Blue: 128},
+	"brown":   {Red: 165, Green: 42, Blue: 42},
+	"pink":    {Red: 255, Green: 192, Blue: 203},
+	"gold":    {Red: 255, Green: 215, Blue: 0},
+}
+
+// style is the set of inherited presentation attributes.
+type style struct {
+	fill         *props.Color
+	stroke       *props.Color
+	strokeWidth  float64
+	evenOdd      bool
+	currentColor *props.Color
+}
+
+func defaultStyle() style {
+	return style{
+		fill:         &props.BlackColor,
+		strokeWidth:  1,
+		currentColor: &props.BlackColor,
+	}
+}
+
+// inherit returns a copy of the style overridden by the element attributes.
+func (s style) inherit(attrs map[string]string) style {
+	if color, ok := parseColor(attrs["color"], s.currentColor); ok && color != nil {
+		s.currentColor = color
+	}
+
+	if color, ok := parseColor(attrs["fill"], s.currentColor); ok {
+		s.fill = color
+	}
+
+	if color, ok := parseColor(attrs["stroke"], s.currentColor); ok {
+		s.stroke = color
+	}
+
+	if width, ok := parseLength(attrs["stroke-width"]); ok && width >= 0 {
+		s.strokeWidth = width
+	}
+
+	switch attrs["fill-rule"] {
+	case "evenodd":
+		s.evenOdd = true
+	case "nonzero":
+		s.evenOdd = false
+	}
+
+	return s
+}
+
+// toPath applies the style to the commands, the stroke width is scaled by the transformation.
+func (s style) toPath(commands []Command, m matrix) *Path {
+	strokeWidth := s.strokeWidth * m.scale()
+
+	stroke := s.stroke
+	if strokeWidth <= 0 {
+		stroke = nil
+	}
+
+	if len(commands) == 0 || (s.fill == nil && stroke == nil) {
+		return nil
+	}
+
+	return &Path{
+		Commands:    m.apply(commands),
+		Fill:        s.fill,
+		Stroke:      stroke,
+		StrokeWidth: strokeWidth,
+		EvenOdd:     s.evenOdd,
+	}
+}
+
+// parseColor reads a paint value, it returns false when the value is empty or not supported,
+// and a nil color when the paint is none.
+func parseColor(value string, currentColor *props.Color) (*props.Color, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	switch {
+	case value == "" || value == "inherit":
+		return nil, false
+	case value == "none" || value == "transparent":
+		return nil, true
+	case value == "currentcolor":
+		return currentColor, true
+	case strings.HasPrefix(value, "url("):
+		// Paint servers are not supported, so the fallback color is used when there is one.
+		_, fallback, _ := strings.Cut(value, ")")
+		if strings.TrimSpace(fallback) == "" {
+			return nil, true
+		}
+		return parseColor(fallback, currentColor)
+	case strings.HasPrefix(value, "#"):
+		return parseHexColor(value[1:])
+	case strings.HasPrefix(value, "rgb(") && strings.HasSuffix(value, ")"):
+		return parseRgbColor(value[4 : len(value)-1])
+	case strings.HasPrefix(value, "rgba(") && strings.HasSuffix(value, ")"):
+		// The alpha channel is ignored, since paths are drawn opaque.
+		channels := strings.Split(value[5:len(value)-1], ",")
+		if len(channels) != 4 {
+			return nil, false
+		}
+		return parseRgbColor(strings.Join(channels[:3], ","))
+	}
+
+	color, ok := namedColors[value]
+	if !ok {
+		return nil, false
+	}
+
+	return &color, true
+}
+
+func parseHexColor(value string) (*props.Color, bool) {
+	if len(value) == 3 {
+		value = string([]byte{value[0], value[0], value[1], value[1], value[2], value[2]})
+	}
+
+	if len(value) != 6 {
+		return nil, false
+	}
+
+	number, err := strconv.ParseUint(value, 16, 32)
+	if err != nil {
+		return nil, false
+	}
+
+	return &props.Color{Red: int(number >> 16 & 0xff), Green: int(number >> 8 & 0xff), Blue: int(number & 0xff)}, true
+}
+
+func parseRgbColor(value string) (*props.Color, bool) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 3 {
+		return nil, false
+	}
+
+	channels := make([]int, 3)
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		factor := 1.0
+		if strings.HasSuffix(part, "%") {
+			part = strings.TrimSuffix(part, "%")
+			factor = 2.55
+		}
+
+		number, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return nil, false
+		}
+		channels[i] = int(math.Round(math.Max(0, math.Min(255, number*factor))))
+	}
+
+	return &props.Color{Red: channels[0], Green: channels[1], Blue: channels[2]}, true
+}
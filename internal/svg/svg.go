@@ -0,0 +1,325 @@
+// Package svg implements a parser that converts SVG documents into vector paths.
+package svg
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// CommandType is the kind of a path drawing command.
+type CommandType int
+
+const (
+	// MoveTo starts a new sub path at the first point.
+	MoveTo CommandType = iota
+	// LineTo draws a straight line to the first point.
+	LineTo
+	// CurveTo draws a cubic bézier curve using the two control points and the end point.
+	CurveTo
+	// Close closes the current sub path.
+	Close
+)
+
+// Point is a coordinate in the image space.
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Command is a drawing command with its absolute points.
+type Command struct {
+	Type   CommandType
+	Points []Point
+}
+
+// Path is a filled and/or stroked shape of the image.
+type Path struct {
+	Commands    []Command
+	Fill        *props.Color
+	Stroke      *props.Color
+	StrokeWidth float64
+	EvenOdd     bool
+}
+
+// Image is a parsed SVG document, all paths are already transformed to the image space
+// that goes from (0, 0) to (Width, Height).
+type Image struct {
+	Width  float64
+	Height float64
+	Paths  []Path
+}
+
+const defaultSize = 100.0
+
+// skipped are the elements which content is not rendered directly.
+var skipped = map[string]bool{
+	"defs": true, "clipPath": true, "mask": true, "symbol": true, "marker": true, "pattern": true,
+	"title": true, "desc": true, "metadata": true, "style": true, "script": true, "text": true,
+	"linearGradient": true, "radialGradient": true, "filter": true,
+}
+
+type state struct {
+	matrix matrix
+	style  style
+}
+
+// Parse reads a SVG document and converts its shapes into paths.
+func Parse(data []byte) (*Image, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+
+	var img *Image
+	var stack []state
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			attrs := getAttributes(element)
+			name := element.Name.Local
+
+			if img == nil {
+				if name != "svg" {
+					return nil, errors.New("svg root element not found")
+				}
+				var root matrix
+				img, root = parseRoot(attrs)
+				stack = append(stack, state{matrix: root, style: defaultStyle()})
+			}
+
+			if skipped[name] || isHidden(attrs) {
+				if err := decoder.Skip(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			current := stack[len(stack)-1]
+			next := state{
+				matrix: current.matrix.multiply(parseTransform(attrs["transform"])),
+				style:  current.style.inherit(attrs),
+			}
+			stack = append(stack, next)
+
+			commands, err := getCommands(name, attrs)
+			if err != nil {
+				return nil, err
+			}
+
+			if path := next.style.toPath(commands, next.matrix); path != nil {
+				img.Paths = append(img.Paths, *path)
+			}
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+
+	if img == nil {
+		return nil, errors.New("svg root element not found")
+	}
+
+	return img, nil
+}
+
+func getAttributes(element xml.StartElement) map[string]string {
+	attrs := make(map[string]string, len(element.Attr))
+	for _, attr := range element.Attr {
+		attrs[attr.Name.Local] = strings.TrimSpace(attr.Value)
+	}
+
+	for _, declaration := range strings.Split(attrs["style"], ";") {
+		key, value, found := strings.Cut(declaration, ":")
+		if found {
+			attrs[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	return attrs
+}
+
+func isHidden(attrs map[string]string) bool {
+	return attrs["display"] == "none" || attrs["visibility"] == "hidden"
+}
+
+// parseRoot reads the document size and builds the matrix that maps the viewBox into it.
+func parseRoot(attrs map[string]string) (*Image, matrix) {
+	width, hasWidth := parseLength(attrs["width"])
+	hasWidth = hasWidth && width > 0
+	height, hasHeight := parseLength(attrs["height"])
+	hasHeight = hasHeight && height > 0
+
+	viewBox := parseNumbers(attrs["viewBox"])
+	if len(viewBox) != 4 || viewBox[2] <= 0 || viewBox[3] <= 0 {
+		if !hasWidth {
+			width = defaultSize
+		}
+		if !hasHeight {
+			height = defaultSize
+		}
+		return &Image{Width: width, Height: height}, identity()
+	}
+
+	switch {
+	case !hasWidth && !hasHeight:
+		width, height = viewBox[2], viewBox[3]
+	case !hasWidth:
+		width = height * viewBox[2] / viewBox[3]
+	case !hasHeight:
+		height = width * viewBox[3] / viewBox[2]
+	}
+
+	if attrs["preserveAspectRatio"] == "none" {
+		scaleX, scaleY := width/viewBox[2], height/viewBox[3]
+		return &Image{Width: width, Height: height}, matrix{scaleX, 0, 0, scaleY, -viewBox[0] * scaleX, -viewBox[1] * scaleY}
+	}
+
+	// Any other preserveAspectRatio is handled as the default xMidYMid meet.
+	scale := math.Min(width/viewBox[2], height/viewBox[3])
+	dx := (width-viewBox[2]*scale)/2 - viewBox[0]*scale
+	dy := (height-viewBox[3]*scale)/2 - viewBox[1]*scale
+
+	return &Image{Width: width, Height: height}, matrix{scale, 0, 0, scale, dx, dy}
+}
+
+// parseLength converts a length to user units, percentages are not supported.
+func parseLength(value string) (float64, bool) {
+	units := map[string]float64{"px": 1, "pt": 4.0 / 3.0, "pc": 16, "mm": 96 / 25.4, "cm": 96 / 2.54, "in": 96}
+
+	value = strings.TrimSpace(value)
+	if value == "" || strings.HasSuffix(value, "%") {
+		return 0, false
+	}
+
+	factor := 1.0
+	for unit, unitFactor := range units {
+		if strings.HasSuffix(value, unit) {
+			value = strings.TrimSuffix(value, unit)
+			factor = unitFactor
+			break
+		}
+	}
+
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return number * factor, true
+}
+
+func getNumber(attrs map[string]string, key string) float64 {
+	value, _ := parseLength(attrs[key])
+	return value
+}
+
+func getCommands(name string, attrs map[string]string) ([]Command, error) {
+	switch name {
+	case "path":
+		return parsePath(attrs["d"])
+	case "rect":
+		return getRect(attrs), nil
+	case "circle":
+		r := getNumber(attrs, "r")
+		return getEllipse(getNumber(attrs, "cx"), getNumber(attrs, "cy"), r, r), nil
+	case "ellipse":
+		return getEllipse(getNumber(attrs, "cx"), getNumber(attrs, "cy"), getNumber(attrs, "rx"), getNumber(attrs, "ry")), nil
+	case "line":
+		return []Command{
+			{Type: MoveTo, Points: []Point{{getNumber(attrs, "x1"), getNumber(attrs, "y1")}}},
+			{Type: LineTo, Points: []Point{{getNumber(attrs, "x2"), getNumber(attrs, "y2")}}},
+		}, nil
+	case "polyline", "polygon":
+		return getPoly(parseNumbers(attrs["points"]), name == "polygon"), nil
+	default:
+		return nil, nil
+	}
+}
+
+func getRect(attrs map[string]string) []Command {
+	x, y := getNumber(attrs, "x"), getNumber(attrs, "y")
+	w, h := getNumber(attrs, "width"), getNumber(attrs, "height")
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+
+	// when only one radius is given, the other one takes the same value.
+	radiusX, radiusY := getNumber(attrs, "rx"), getNumber(attrs, "ry")
+	if attrs["rx"] == "" {
+		radiusX = radiusY
+	}
+	if attrs["ry"] == "" {
+		radiusY = radiusX
+	}
+	radiusX, radiusY = math.Min(radiusX, w/2), math.Min(radiusY, h/2)
+
+	if radiusX <= 0 || radiusY <= 0 {
+		return getPoly([]float64{x, y, x + w, y, x + w, y + h, x, y + h}, true)
+	}
+
+	kx, ky := radiusX*kappa, radiusY*kappa
+	return []Command{
+		{Type: MoveTo, Points: []Point{{x + radiusX, y}}},
+		{Type: LineTo, Points: []Point{{x + w - radiusX, y}}},
+		{Type: CurveTo, Points: []Point{{x + w - radiusX + kx, y}, {x + w, y + radiusY - ky}, {x + w, y + radiusY}}},
+		{Type: LineTo, Points: []Point{{x + w, y + h - radiusY}}},
+		{Type: CurveTo, Points: []Point{{x + w, y + h - radiusY + ky}, {x + w - radiusX + kx, y + h}, {x + w - radiusX, y + h}}},
+		{Type: LineTo, Points: []Point{{x + radiusX, y + h}}},
+		{Type: CurveTo, Points: []Point{{x + radiusX - kx, y + h}, {x, y + h - radiusY + ky}, {x, y + h - radiusY}}},
+		{Type: LineTo, Points: []Point{{x, y + radiusY}}},
+		{Type: CurveTo, Points: []Point{{x, y + radiusY - ky}, {x + radiusX - kx, y}, {x + radiusX, y}}},
+		{Type: Close},
+	}
+}
+
+func getEllipse(cx, cy, rx, ry float64) []Command {
+	if rx <= 0 || ry <= 0 {
+		return nil
+	}
+
+	kx, ky := rx*kappa, ry*kappa
+	return []Command{
+		{Type: MoveTo, Points: []Point{{cx + rx, cy}}},
+		{Type: CurveTo, Points: []Point{{cx + rx, cy + ky}, {cx + kx, cy + ry}, {cx, cy + ry}}},
+		{Type: CurveTo, Points: []Point{{cx - kx, cy + ry}, {cx - rx, cy + ky}, {cx - rx, cy}}},
+		{Type: CurveTo, Points: []Point{{cx - rx, cy - ky}, {cx - kx, cy - ry}, {cx, cy - ry}}},
+		{Type: CurveTo, Points: []Point{{cx + kx, cy - ry}, {cx + rx, cy - ky}, {cx + rx, cy}}},
+		{Type: Close},
+	}
+}
+
+func getPoly(numbers []float64, closed bool) []Command {
+	if len(numbers) < 4 {
+		return nil
+	}
+
+	var commands []Command
+	for i := 0; i+1 < len(numbers); i += 2 {
+		commandType := LineTo
+		if i == 0 {
+			commandType = MoveTo
+		}
+		commands = append(commands, Command{Type: commandType, Points: []Point{{numbers[i], numbers[i+1]}}})
+	}
+
+	if closed {
+		commands = append(commands, Command{Type: Close})
+	}
+
+	return commands
+}
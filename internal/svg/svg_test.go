@@ -0,0 +1,221 @@
+package svg_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/svg"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("when root is not svg, should return error", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<html><rect width="10" height="10"/></html>`))
+
+		// Assert
+		assert.Nil(t, img)
+		assert.NotNil(t, err)
+	})
+	t.Run("when document is empty, should return error", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(""))
+
+		// Assert
+		assert.Nil(t, img)
+		assert.NotNil(t, err)
+	})
+	t.Run("when path data is invalid, should return error", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg><path d="M 10 10 L 20"/></svg>`))
+
+		// Assert
+		assert.Nil(t, img)
+		assert.NotNil(t, err)
+	})
+	t.Run("when only viewBox is defined, should use viewBox size", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg viewBox="0 0 300 150"></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 300.0, img.Width)
+		assert.Equal(t, 150.0, img.Height)
+	})
+	t.Run("when size has units, should convert to user units", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg width="1in" height="72pt"></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 96.0, img.Width)
+		assert.Equal(t, 96.0, img.Height)
+	})
+	t.Run("when only width is defined, should keep viewBox proportion", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg width="50" viewBox="0 0 200 100"></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 50.0, img.Width)
+		assert.Equal(t, 25.0, img.Height)
+	})
+	t.Run("when size is not defined, should use default size", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 100.0, img.Width)
+		assert.Equal(t, 100.0, img.Height)
+	})
+	t.Run("when viewBox differs from size, should map shapes into size", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg width="20" height="20" viewBox="10 10 10 10"><line x1="10" y1="10" x2="20" y2="20" stroke="black"/></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []svg.Command{
+			{Type: svg.MoveTo, Points: []svg.Point{{X: 0, Y: 0}}},
+			{Type: svg.LineTo, Points: []svg.Point{{X: 20, Y: 20}}},
+		}, img.Paths[0].Commands)
+		assert.Equal(t, 2.0, img.Paths[0].StrokeWidth)
+	})
+	t.Run("when rect has no style, should fill with black and not stroke", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg><rect x="1" y="2" width="3" height="4"/></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, img.Paths, 1)
+		assert.Equal(t, &props.BlackColor, img.Paths[0].Fill)
+		assert.Nil(t, img.Paths[0].Stroke)
+		assert.Equal(t, []svg.Command{
+			{Type: svg.MoveTo, Points: []svg.Point{{X: 1, Y: 2}}},
+			{Type: svg.LineTo, Points: []svg.Point{{X: 4, Y: 2}}},
+			{Type: svg.LineTo, Points: []svg.Point{{X: 4, Y: 6}}},
+			{Type: svg.LineTo, Points: []svg.Point{{X: 1, Y: 6}}},
+			{Type: svg.Close, Points: []svg.Point{}},
+		}, img.Paths[0].Commands)
+	})
+	t.Run("when rect is rounded, should draw corners as curves", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg><rect width="10" height="10" rx="2"/></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, img.Paths[0].Commands, 10)
+		assert.Equal(t, svg.CurveTo, img.Paths[0].Commands[2].Type)
+		assert.Equal(t, svg.Point{X: 10, Y: 2}, img.Paths[0].Commands[2].Points[2])
+	})
+	t.Run("when circle and ellipse are sent, should draw four curves", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg><circle cx="5" cy="5" r="5"/><ellipse cx="5" cy="5" rx="4" ry="2"/></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, img.Paths, 2)
+		assert.Len(t, img.Paths[0].Commands, 6)
+		assert.Equal(t, svg.Point{X: 5, Y: 10}, img.Paths[0].Commands[1].Points[2])
+		assert.Equal(t, svg.Point{X: 9, Y: 5}, img.Paths[1].Commands[0].Points[0])
+	})
+	t.Run("when path uses relative commands, should convert to absolute points", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg><path d="m10 10 h10 v10 l-10 0z M0,0 L1-1"/></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []svg.Command{
+			{Type: svg.MoveTo, Points: []svg.Point{{X: 10, Y: 10}}},
+			{Type: svg.LineTo, Points: []svg.Point{{X: 20, Y: 10}}},
+			{Type: svg.LineTo, Points: []svg.Point{{X: 20, Y: 20}}},
+			{Type: svg.LineTo, Points: []svg.Point{{X: 10, Y: 20}}},
+			{Type: svg.Close, Points: []svg.Point{}},
+			{Type: svg.MoveTo, Points: []svg.Point{{X: 0, Y: 0}}},
+			{Type: svg.LineTo, Points: []svg.Point{{X: 1, Y: -1}}},
+		}, img.Paths[0].Commands)
+	})
+	t.Run("when path uses quadratic and smooth curves, should convert to cubic curves", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg><path d="M0 0 Q 3 3 6 0 T 12 0 C 0 1 2 3 4 5 S 8 9 10 11"/></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		commands := img.Paths[0].Commands
+		assert.Len(t, commands, 5)
+		assert.Equal(t, []svg.Point{{X: 2, Y: 2}, {X: 4, Y: 2}, {X: 6, Y: 0}}, commands[1].Points)
+		assert.Equal(t, []svg.Point{{X: 8, Y: -2}, {X: 10, Y: -2}, {X: 12, Y: 0}}, commands[2].Points)
+		assert.Equal(t, []svg.Point{{X: 6, Y: 7}, {X: 8, Y: 9}, {X: 10, Y: 11}}, commands[4].Points)
+	})
+	t.Run("when path has arcs with compact flags, should convert to curves ending on the arc end", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg><path d="M0 5a5 5 0 105 5"/></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		commands := img.Paths[0].Commands
+		assert.Len(t, commands, 4)
+		for _, command := range commands[1:] {
+			assert.Equal(t, svg.CurveTo, command.Type)
+		}
+		assert.Equal(t, svg.Point{X: 5, Y: 10}, commands[3].Points[2])
+	})
+	t.Run("when arc radius is too large, should join the arc end with a line", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg><path d="M0 0 A1e300 1e300 0 0 1 10 10"/></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		commands := img.Paths[0].Commands
+		assert.Len(t, commands, 2)
+		assert.Equal(t, svg.LineTo, commands[1].Type)
+		assert.Equal(t, svg.Point{X: 10, Y: 10}, commands[1].Points[0])
+	})
+	t.Run("when path does not start with a moveto, should return error", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg><path d="A5 5 0 0 1 10 10"/></svg>`))
+
+		// Assert
+		assert.Nil(t, img)
+		assert.EqualError(t, err, "path must start with a moveto, found 'A'")
+	})
+	t.Run("when group has transforms, should apply them to children", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg><g transform="translate(10, 20) scale(2)">` +
+			`<polyline points="0,0 1,1" transform="rotate(90)" stroke="red" fill="none" stroke-width="3"/></g></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		path := img.Paths[0]
+		assert.Equal(t, svg.Point{X: 10, Y: 20}, path.Commands[0].Points[0])
+		assert.InDelta(t, 8.0, path.Commands[1].Points[0].X, 0.000001)
+		assert.InDelta(t, 22.0, path.Commands[1].Points[0].Y, 0.000001)
+		assert.Nil(t, path.Fill)
+		assert.Equal(t, &props.RedColor, path.Stroke)
+		assert.Equal(t, 6.0, path.StrokeWidth)
+	})
+	t.Run("when style attribute is sent, should override presentation attributes", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg><g color="#00f" fill="red">` +
+			`<polygon points="0,0 1,0 1,1" style="fill: currentColor; fill-rule: evenodd" stroke="rgb(0, 128, 100%)"/>` +
+			`</g></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		path := img.Paths[0]
+		assert.Equal(t, &props.BlueColor, path.Fill)
+		assert.Equal(t, &props.Color{Red: 0, Green: 128, Blue: 255}, path.Stroke)
+		assert.True(t, path.EvenOdd)
+		assert.Equal(t, svg.Close, path.Commands[3].Type)
+	})
+	t.Run("when elements are not rendered, should skip them", func(t *testing.T) {
+		// Act
+		img, err := svg.Parse([]byte(`<svg><defs><rect width="1" height="1"/></defs>` +
+			`<rect width="1" height="1" display="none"/><rect width="1" height="1" fill="none"/>` +
+			`<title>logo</title><circle r="1"/></svg>`))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, img.Paths, 1)
+	})
+}
@@ -0,0 +1,100 @@
+package svg
+
+import (
+	"math"
+	"strings"
+)
+
+// matrix is an affine transformation [a b c d e f], a point is transformed into
+// (a*x + c*y + e, b*x + d*y + f).
+type matrix [6]float64
+
+func identity() matrix {
+	return matrix{1, 0, 0, 1, 0, 0}
+}
+
+// multiply returns the transformation that applies other and then m.
+func (m matrix) multiply(other matrix) matrix {
+	return matrix{
+		m[0]*other[0] + m[2]*other[1],
+		m[1]*other[0] + m[3]*other[1],
+		m[0]*other[2] + m[2]*other[3],
+		m[1]*other[2] + m[3]*other[3],
+		m[0]*other[4] + m[2]*other[5] + m[4],
+		m[1]*other[4] + m[3]*other[5] + m[5],
+	}
+}
+
+func (m matrix) transform(point Point) Point {
+	return Point{m[0]*point.X + m[2]*point.Y + m[4], m[1]*point.X + m[3]*point.Y + m[5]}
+}
+
+// scale is the mean scale factor of the transformation, used to scale stroke widths.
+func (m matrix) scale() float64 {
+	return math.Sqrt(math.Abs(m[0]*m[3] - m[1]*m[2]))
+}
+
+func (m matrix) apply(commands []Command) []Command {
+	transformed := make([]Command, len(commands))
+	for i, command := range commands {
+		points := make([]Point, len(command.Points))
+		for j, point := range command.Points {
+			points[j] = m.transform(point)
+		}
+		transformed[i] = Command{Type: command.Type, Points: points}
+	}
+
+	return transformed
+}
+
+// parseTransform reads a transform list like "translate(10 20) rotate(45)".
+func parseTransform(value string) matrix {
+	result := identity()
+
+	for {
+		name, rest, found := strings.Cut(value, "(")
+		if !found {
+			return result
+		}
+
+		args, remaining, found := strings.Cut(rest, ")")
+		if !found {
+			return result
+		}
+
+		result = result.multiply(getTransform(strings.Trim(strings.TrimSpace(name), ","), parseNumbers(args)))
+		value = remaining
+	}
+}
+
+func getTransform(name string, args []float64) matrix {
+	get := func(i int, fallback float64) float64 {
+		if i < len(args) {
+			return args[i]
+		}
+		return fallback
+	}
+
+	switch name {
+	case "matrix":
+		if len(args) == 6 {
+			return matrix{args[0], args[1], args[2], args[3], args[4], args[5]}
+		}
+	case "translate":
+		return matrix{1, 0, 0, 1, get(0, 0), get(1, 0)}
+	case "scale":
+		sx := get(0, 1)
+		return matrix{sx, 0, 0, get(1, sx), 0, 0}
+	case "rotate":
+		angle := get(0, 0) * math.Pi / 180
+		cx, cy := get(1, 0), get(2, 0)
+		cos, sin := math.Cos(angle), math.Sin(angle)
+		return matrix{1, 0, 0, 1, cx, cy}.multiply(matrix{cos, sin, -sin, cos, 0, 0}).multiply(matrix{1, 0, 0, 1, -cx, -cy})
+	case "skewX":
+		return matrix{1, 0, math.Tan(get(0, 0) * math.Pi / 180), 1, 0, 0}
+	case "skewY":
+		return matrix{1, math.Tan(get(0, 0) * math.Pi / 180), 0, 1, 0, 0}
+	}
+
+	return identity()
+}
@@ -2,6 +2,7 @@ package maroto
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/generation"
 
@@ -9,7 +10,7 @@ import (
 
 	"github.com/johnfercher/maroto/v2/internal/providers/gofpdf"
 
-	"github.com/johnfercher/maroto/v2/pkg/merge"
+	"github.com/johnfercher/maroto/v2/internal/merge"
 
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
 	"github.com/johnfercher/maroto/v2/pkg/config"
 	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/metrics"
 )
 
 type Maroto struct {
@@ -29,6 +31,9 @@ type Maroto struct {
 	provider core.Provider
 	cache    cache.Cache
 
+	// Metrics
+	imageMetrics *gofpdf.ImageMetrics
+
 	// Building
 	cell          entity.Cell
 	pages         []core.Page
@@ -52,9 +57,10 @@ func (m *Maroto) GetCurrentConfig() *entity.Config {
 // It's optional to provide an *entity.Config with customizations
 // those customization are created by using the config.Builder.
 func New(cfgs ...*entity.Config) core.Maroto {
-	cache := cache.New()
 	cfg := getConfig(cfgs...)
-	provider := getProvider(cache, cfg)
+	cache := cache.New(cfg.Loader)
+	imageMetrics := gofpdf.NewImageMetrics()
+	provider := getProvider(cache, cfg, imageMetrics)
 
 	m := &Maroto{
 		provider: provider,
@@ -64,8 +70,9 @@ func New(cfgs ...*entity.Config) core.Maroto {
 			Right:  cfg.Margins.Right,
 			Bottom: cfg.Margins.Bottom,
 		}),
-		cache:  cache,
-		config: cfg,
+		cache:        cache,
+		config:       cfg,
+		imageMetrics: imageMetrics,
 	}
 
 	if cfg.GenerationMode == generation.Concurrent {
@@ -168,6 +175,7 @@ func (m *Maroto) RegisterFooter(rows ...core.Row) error {
 func (m *Maroto) Generate() (core.Document, error) {
 	m.fillPageToAddNew()
 	m.setConfig()
+	m.imageMetrics.Reset()
 
 	if m.config.GenerationMode == generation.Concurrent {
 		return m.generateConcurrently()
@@ -225,9 +233,11 @@ func (m *Maroto) addRow(r core.Row) {
 
 	// As row will extrapolate page, we will add empty space
 	// on the page to force a new page
+	m.removeOrphanRowHeaders(r)
 	m.fillPageToAddNew()
 
 	m.addHeader()
+	m.addRowHeaders(r)
 
 	// AddRows row on the new page
 	m.currentHeight += rowHeight
@@ -241,6 +251,45 @@ func (m *Maroto) addHeader() {
 	}
 }
 
+func (m *Maroto) addRowHeaders(r core.Row) {
+	headedRow, ok := r.(core.HeadedRow)
+	if !ok {
+		return
+	}
+
+	for _, headerRow := range headedRow.GetHeaderRows() {
+		headerRow.SetConfig(m.config)
+		m.currentHeight += headerRow.GetHeight(m.provider, &m.cell)
+		m.rows = append(m.rows, headerRow)
+	}
+}
+
+// removeOrphanRowHeaders removes the header rows of a row from the end of the page when no row was added
+// after them, since they are added again with the row on the new page.
+func (m *Maroto) removeOrphanRowHeaders(r core.Row) {
+	headedRow, ok := r.(core.HeadedRow)
+	if !ok {
+		return
+	}
+
+	headers := headedRow.GetHeaderRows()
+	if len(headers) == 0 || len(headers) > len(m.rows) {
+		return
+	}
+
+	last := m.rows[len(m.rows)-len(headers):]
+	for i, headerRow := range headers {
+		if last[i] != headerRow {
+			return
+		}
+	}
+
+	for _, headerRow := range headers {
+		m.currentHeight -= headerRow.GetHeight(m.provider, &m.cell)
+	}
+	m.rows = m.rows[:len(m.rows)-len(headers)]
+}
+
 func (m *Maroto) fillPageToAddNew() {
 	space := m.cell.Height - m.currentHeight - m.footerHeight
 
@@ -285,7 +334,7 @@ func (m *Maroto) generate() (core.Document, error) {
 		return nil, err
 	}
 
-	return core.NewPDF(documentBytes, nil), nil
+	return core.NewPDF(documentBytes, m.getReport()), nil
 }
 
 func (m *Maroto) generateConcurrently() (core.Document, error) {
@@ -306,7 +355,7 @@ func (m *Maroto) generateConcurrently() (core.Document, error) {
 
 	processed := m.pool.Process(pageGroups)
 	if processed.HasError {
-		return nil, errors.New("an error has occurred while trying to generate PDFs concurrently")
+		return nil, fmt.Errorf("an error has occurred while trying to generate PDFs concurrently: %w", errors.Join(processed.GetErrors()...))
 	}
 
 	pdfs := make([][]byte, len(processed.Results))
@@ -315,12 +364,12 @@ func (m *Maroto) generateConcurrently() (core.Document, error) {
 		pdfs[i] = bytes
 	}
 
-	mergedBytes, err := merge.Bytes(pdfs...)
+	mergedBytes, err := merge.Chunks(pdfs...)
 	if err != nil {
 		return nil, err
 	}
 
-	return core.NewPDF(mergedBytes, nil), nil
+	return core.NewPDF(mergedBytes, m.getReport()), nil
 }
 
 func (m *Maroto) generateLowMemory() (core.Document, error) {
@@ -343,24 +392,24 @@ func (m *Maroto) generateLowMemory() (core.Document, error) {
 	for _, pageGroup := range pageGroups {
 		bytes, err := m.processPage(pageGroup)
 		if err != nil {
-			return nil, errors.New("an error has occurred while trying to generate PDFs in low memory mode")
+			return nil, fmt.Errorf("an error has occurred while trying to generate PDFs in low memory mode: %w", err)
 		}
 
 		pdfResults = append(pdfResults, bytes)
 	}
 
-	mergedBytes, err := merge.Bytes(pdfResults...)
+	mergedBytes, err := merge.Chunks(pdfResults...)
 	if err != nil {
 		return nil, err
 	}
 
-	return core.NewPDF(mergedBytes, nil), nil
+	return core.NewPDF(mergedBytes, m.getReport()), nil
 }
 
 func (m *Maroto) processPage(pages []core.Page) ([]byte, error) {
 	innerCtx := m.cell.Copy()
 
-	innerProvider := getProvider(cache.NewMutexDecorator(cache.New()), m.config)
+	innerProvider := getProvider(cache.NewMutexDecorator(cache.New(m.config.Loader)), m.config, m.imageMetrics)
 	for _, page := range pages {
 		page.Render(innerProvider, innerCtx)
 	}
@@ -368,6 +417,16 @@ func (m *Maroto) processPage(pages []core.Page) ([]byte, error) {
 	return innerProvider.GenerateBytes()
 }
 
+// getReport returns the image optimization metrics, or nil when no image was optimized.
+func (m *Maroto) getReport() *metrics.Report {
+	imageMetrics := m.imageMetrics.GetSizeMetrics()
+	if imageMetrics == nil {
+		return nil
+	}
+
+	return &metrics.Report{ImageMetrics: imageMetrics}
+}
+
 func (m *Maroto) getRowsHeight(rows ...core.Row) float64 {
 	var height float64
 	for _, r := range rows {
@@ -386,8 +445,8 @@ func getConfig(configs ...*entity.Config) *entity.Config {
 	return config.NewBuilder().Build()
 }
 
-func getProvider(cache cache.Cache, cfg *entity.Config) core.Provider {
-	deps := gofpdf.NewBuilder().Build(cfg, cache)
+func getProvider(cache cache.Cache, cfg *entity.Config, imageMetrics *gofpdf.ImageMetrics) core.Provider {
+	deps := gofpdf.NewBuilder().Build(cfg, cache, imageMetrics)
 	provider := gofpdf.New(deps)
 	provider.SetMetadata(cfg.Metadata)
 	provider.SetCompression(cfg.Compression)
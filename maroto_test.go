@@ -1,17 +1,27 @@
 package maroto_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+
 	"github.com/johnfercher/maroto/v2/pkg/components/code"
 	"github.com/johnfercher/maroto/v2/pkg/components/text"
 
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/form"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
 	"github.com/johnfercher/maroto/v2/pkg/components/page"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/table"
 	"github.com/johnfercher/maroto/v2/pkg/config"
 	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/johnfercher/maroto/v2/pkg/test"
 
 	"github.com/johnfercher/maroto/v2"
@@ -180,6 +190,47 @@ func TestMaroto_AddRows(t *testing.T) {
 		// Assert
 		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_rows_5.json")
 	})
+	t.Run("when grouped table is split across pages, should repeat group header", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		var values []int
+		for i := 0; i < 30; i++ {
+			values = append(values, i)
+		}
+		columns := []table.Column[int]{{Title: "Value", Value: func(v int) any { return v }}}
+		group := table.Group[int]{Key: func(int) string { return "group" }}
+
+		// Act
+		rows, err := table.BuildGrouped(values, columns, group, props.Table{RowHeight: 10})
+		sut.AddRows(rows...)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(sut.GetStructure()).Equals("maroto_add_rows_6.json")
+	})
+	t.Run("when group header fits but its first row does not, should move group header to the new page", func(t *testing.T) {
+		// Arrange
+		sut := maroto.New()
+		cfg := sut.GetCurrentConfig()
+		space := cfg.Dimensions.Height - cfg.Margins.Top - cfg.Margins.Bottom
+		columns := []table.Column[int]{{Title: "Value", Value: func(v int) any { return v }}}
+		group := table.Group[int]{Key: func(int) string { return "group" }}
+		rows, err := table.BuildGrouped([]int{1}, columns, group, props.Table{RowHeight: 10})
+
+		// Act
+		sut.AddRow(space-25, col.New(12))
+		sut.AddRows(rows...)
+
+		// Assert
+		assert.Nil(t, err)
+		pages := sut.GetStructure().GetNexts()
+		assert.Len(t, pages, 2)
+		firstPage := pages[0].GetNexts()
+		assert.Equal(t, 10.0, firstPage[1].GetData().Value)
+		assert.Equal(t, 15.0, firstPage[2].GetData().Value)
+		assert.Len(t, firstPage, 3)
+		assert.Len(t, pages[1].GetNexts(), 5)
+	})
 }
 
 func TestMaroto_AddAutoRow(t *testing.T) {
@@ -369,6 +420,76 @@ func TestMaroto_Generate(t *testing.T) {
 		// Assert
 		test.New(t).Assert(sut.GetStructure()).Equals("maroto_concurrent.json")
 	})
+	t.Run("when generated twice with image optimization, should report only the images of each generation", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().
+			WithImageMaxDPI(72).
+			Build()
+
+		sut := maroto.New(cfg)
+		sut.AddRows(image.NewFromFileRow(40, "docs/assets/images/biplane.jpg"))
+
+		// Act
+		first, err := sut.Generate()
+		assert.Nil(t, err)
+		second, err := sut.Generate()
+		assert.Nil(t, err)
+
+		// Assert
+		assert.NotNil(t, first.GetReport())
+		assert.Equal(t, first.GetReport().ImageMetrics, second.GetReport().ImageMetrics)
+	})
+	t.Run("when an image is drawn in many chunks, should embed the image once", func(t *testing.T) {
+		for _, cfg := range []*entity.Config{
+			config.NewBuilder().WithConcurrentMode(4).Build(),
+			config.NewBuilder().WithSequentialLowMemoryMode(1).Build(),
+		} {
+			// Arrange
+			sut := maroto.New(cfg)
+			for i := 0; i < 4; i++ {
+				sut.AddRows(image.NewFromFileRow(250, "docs/assets/images/biplane.jpg"))
+			}
+
+			// Act
+			doc, err := sut.Generate()
+
+			// Assert
+			assert.Nil(t, err)
+			ctx, err := api.ReadContext(bytes.NewReader(doc.GetBytes()), model.NewDefaultConfiguration())
+			assert.Nil(t, err)
+			assert.Nil(t, ctx.EnsurePageCount())
+			images := 0
+			for _, entry := range ctx.Table {
+				streamDict, ok := entry.Object.(types.StreamDict)
+				if ok && streamDict.Subtype() != nil && *streamDict.Subtype() == "Image" {
+					images++
+				}
+			}
+			assert.Equal(t, 4, ctx.PageCount)
+			assert.Equal(t, 1, images)
+		}
+	})
+	t.Run("when a form field name is repeated, should return the duplicated name error in every mode", func(t *testing.T) {
+		for _, cfg := range []*entity.Config{
+			config.NewBuilder().Build(),
+			config.NewBuilder().WithConcurrentMode(1).Build(),
+			config.NewBuilder().WithConcurrentMode(2).Build(),
+			config.NewBuilder().WithSequentialLowMemoryMode(1).Build(),
+			config.NewBuilder().WithSequentialLowMemoryMode(2).Build(),
+		} {
+			// Arrange
+			sut := maroto.New(cfg)
+			sut.AddRows(form.NewTextFieldRow(250, "name", "first"))
+			sut.AddRows(form.NewTextFieldRow(250, "name", "second"))
+
+			// Act
+			doc, err := sut.Generate()
+
+			// Assert
+			assert.ErrorContains(t, err, "form field name is added more than once")
+			assert.Nil(t, doc)
+		}
+	})
 	t.Run("page number", func(t *testing.T) {
 		// Arrange
 		cfg := config.NewBuilder().
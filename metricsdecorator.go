@@ -54,7 +54,11 @@ func (m *MetricsDecorator) Generate() (core.Document, error) {
 
 	bytes := document.GetBytes()
 
-	report := m.buildMetrics(len(bytes)).Normalize()
+	report := m.buildMetrics(len(bytes))
+	if innerReport := document.GetReport(); innerReport != nil {
+		report.ImageMetrics = innerReport.ImageMetrics
+	}
+	report = report.Normalize()
 
 	return core.NewPDF(bytes, report), nil
 }
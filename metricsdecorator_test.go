@@ -15,6 +15,7 @@ import (
 
 	"github.com/johnfercher/maroto/v2/mocks"
 	"github.com/johnfercher/maroto/v2/pkg/components/page"
+	"github.com/johnfercher/maroto/v2/pkg/metrics"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -33,6 +34,7 @@ func TestMetricsDecorator_AddPages(t *testing.T) {
 
 	docToReturn := mocks.NewDocument(t)
 	docToReturn.EXPECT().GetBytes().Return([]byte{1, 2, 3})
+	docToReturn.EXPECT().GetReport().Return(nil)
 	inner := mocks.NewMaroto(t)
 	inner.EXPECT().AddPages(pg)
 	inner.EXPECT().Generate().Return(docToReturn, nil)
@@ -57,12 +59,40 @@ func TestMetricsDecorator_AddPages(t *testing.T) {
 	inner.AssertNumberOfCalls(t, "AddPages", 2)
 }
 
+func TestMetricsDecorator_Generate(t *testing.T) {
+	t.Run("when inner document has image metrics, should keep them in report", func(t *testing.T) {
+		// Arrange
+		imageMetrics := []metrics.SizeMetric{
+			{Key: "images_original_size", Size: metrics.Size{Value: 2048, Scale: metrics.Byte}},
+		}
+
+		docToReturn := mocks.NewDocument(t)
+		docToReturn.EXPECT().GetBytes().Return([]byte{1, 2, 3})
+		docToReturn.EXPECT().GetReport().Return(&metrics.Report{ImageMetrics: imageMetrics})
+		inner := mocks.NewMaroto(t)
+		inner.EXPECT().Generate().Return(docToReturn, nil)
+
+		sut := NewMetricsDecorator(inner)
+
+		// Act
+		doc, err := sut.Generate()
+
+		// Assert
+		assert.Nil(t, err)
+		report := doc.GetReport()
+		assert.Len(t, report.ImageMetrics, 1)
+		assert.Equal(t, "images_original_size", report.ImageMetrics[0].Key)
+		assert.Equal(t, metrics.KiloByte, report.ImageMetrics[0].Size.Scale)
+	})
+}
+
 func TestMetricsDecorator_AddRow(t *testing.T) {
 	// Arrange
 	col := col.New(12)
 
 	docToReturn := mocks.NewDocument(t)
 	docToReturn.EXPECT().GetBytes().Return([]byte{1, 2, 3})
+	docToReturn.EXPECT().GetReport().Return(nil)
 	inner := mocks.NewMaroto(t)
 	inner.EXPECT().AddRow(10.0, col).Return(nil)
 	inner.EXPECT().Generate().Return(docToReturn, nil)
@@ -93,6 +123,7 @@ func TestMetricsDecorator_AddRows(t *testing.T) {
 
 	docToReturn := mocks.NewDocument(t)
 	docToReturn.EXPECT().GetBytes().Return([]byte{1, 2, 3})
+	docToReturn.EXPECT().GetReport().Return(nil)
 	inner := mocks.NewMaroto(t)
 	inner.EXPECT().AddRows(row)
 	inner.EXPECT().Generate().Return(docToReturn, nil)
@@ -123,6 +154,7 @@ func TestMetricsDecorator_GetStructure(t *testing.T) {
 
 	docToReturn := mocks.NewDocument(t)
 	docToReturn.EXPECT().GetBytes().Return([]byte{1, 2, 3})
+	docToReturn.EXPECT().GetReport().Return(nil)
 	inner := mocks.NewMaroto(t)
 	inner.EXPECT().AddRows(row)
 	inner.EXPECT().GetStructure().Return(&node.Node[core.Structure]{})
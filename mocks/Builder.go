@@ -24,17 +24,17 @@ func (_m *Builder) EXPECT() *Builder_Expecter {
 	return &Builder_Expecter{mock: &_m.Mock}
 }
 
-// Build provides a mock function with given fields: cfg, _a1
-func (_m *Builder) Build(cfg *entity.Config, _a1 cache.Cache) *gofpdf.Dependencies {
-	ret := _m.Called(cfg, _a1)
+// Build provides a mock function with given fields: cfg, _a1, imageMetrics
+func (_m *Builder) Build(cfg *entity.Config, _a1 cache.Cache, imageMetrics *gofpdf.ImageMetrics) *gofpdf.Dependencies {
+	ret := _m.Called(cfg, _a1, imageMetrics)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Build")
 	}
 
 	var r0 *gofpdf.Dependencies
-	if rf, ok := ret.Get(0).(func(*entity.Config, cache.Cache) *gofpdf.Dependencies); ok {
-		r0 = rf(cfg, _a1)
+	if rf, ok := ret.Get(0).(func(*entity.Config, cache.Cache, *gofpdf.ImageMetrics) *gofpdf.Dependencies); ok {
+		r0 = rf(cfg, _a1, imageMetrics)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gofpdf.Dependencies)
@@ -52,13 +52,14 @@ type Builder_Build_Call struct {
 // Build is a helper method to define mock.On call
 //   - cfg *entity.Config
 //   - _a1 cache.Cache
-func (_e *Builder_Expecter) Build(cfg interface{}, _a1 interface{}) *Builder_Build_Call {
-	return &Builder_Build_Call{Call: _e.mock.On("Build", cfg, _a1)}
+//   - imageMetrics *gofpdf.ImageMetrics
+func (_e *Builder_Expecter) Build(cfg interface{}, _a1 interface{}, imageMetrics interface{}) *Builder_Build_Call {
+	return &Builder_Build_Call{Call: _e.mock.On("Build", cfg, _a1, imageMetrics)}
 }
 
-func (_c *Builder_Build_Call) Run(run func(cfg *entity.Config, _a1 cache.Cache)) *Builder_Build_Call {
+func (_c *Builder_Build_Call) Run(run func(cfg *entity.Config, _a1 cache.Cache, imageMetrics *gofpdf.ImageMetrics)) *Builder_Build_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entity.Config), args[1].(cache.Cache))
+		run(args[0].(*entity.Config), args[1].(cache.Cache), args[2].(*gofpdf.ImageMetrics))
 	})
 	return _c
 }
@@ -68,7 +69,7 @@ func (_c *Builder_Build_Call) Return(_a0 *gofpdf.Dependencies) *Builder_Build_Ca
 	return _c
 }
 
-func (_c *Builder_Build_Call) RunAndReturn(run func(*entity.Config, cache.Cache) *gofpdf.Dependencies) *Builder_Build_Call {
+func (_c *Builder_Build_Call) RunAndReturn(run func(*entity.Config, cache.Cache, *gofpdf.ImageMetrics) *gofpdf.Dependencies) *Builder_Build_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -22,6 +22,65 @@ func (_m *Code) EXPECT() *Code_Expecter {
 	return &Code_Expecter{mock: &_m.Mock}
 }
 
+// GenAztec provides a mock function with given fields: code, prop
+func (_m *Code) GenAztec(code string, prop *props.Aztec) (*entity.Image, error) {
+	ret := _m.Called(code, prop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenAztec")
+	}
+
+	var r0 *entity.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *props.Aztec) (*entity.Image, error)); ok {
+		return rf(code, prop)
+	}
+	if rf, ok := ret.Get(0).(func(string, *props.Aztec) *entity.Image); ok {
+		r0 = rf(code, prop)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *props.Aztec) error); ok {
+		r1 = rf(code, prop)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Code_GenAztec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenAztec'
+type Code_GenAztec_Call struct {
+	*mock.Call
+}
+
+// GenAztec is a helper method to define mock.On call
+//   - code string
+//   - prop *props.Aztec
+func (_e *Code_Expecter) GenAztec(code interface{}, prop interface{}) *Code_GenAztec_Call {
+	return &Code_GenAztec_Call{Call: _e.mock.On("GenAztec", code, prop)}
+}
+
+func (_c *Code_GenAztec_Call) Run(run func(code string, prop *props.Aztec)) *Code_GenAztec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Aztec))
+	})
+	return _c
+}
+
+func (_c *Code_GenAztec_Call) Return(_a0 *entity.Image, _a1 error) *Code_GenAztec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Code_GenAztec_Call) RunAndReturn(run func(string, *props.Aztec) (*entity.Image, error)) *Code_GenAztec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenBar provides a mock function with given fields: code, cell, prop
 func (_m *Code) GenBar(code string, cell *entity.Cell, prop *props.Barcode) (*entity.Image, error) {
 	ret := _m.Called(code, cell, prop)
@@ -82,9 +141,9 @@ func (_c *Code_GenBar_Call) RunAndReturn(run func(string, *entity.Cell, *props.B
 	return _c
 }
 
-// GenDataMatrix provides a mock function with given fields: code
-func (_m *Code) GenDataMatrix(code string) (*entity.Image, error) {
-	ret := _m.Called(code)
+// GenDataMatrix provides a mock function with given fields: code, prop
+func (_m *Code) GenDataMatrix(code string, prop *props.Rect) (*entity.Image, error) {
+	ret := _m.Called(code, prop)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenDataMatrix")
@@ -92,19 +151,19 @@ func (_m *Code) GenDataMatrix(code string) (*entity.Image, error) {
 
 	var r0 *entity.Image
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*entity.Image, error)); ok {
-		return rf(code)
+	if rf, ok := ret.Get(0).(func(string, *props.Rect) (*entity.Image, error)); ok {
+		return rf(code, prop)
 	}
-	if rf, ok := ret.Get(0).(func(string) *entity.Image); ok {
-		r0 = rf(code)
+	if rf, ok := ret.Get(0).(func(string, *props.Rect) *entity.Image); ok {
+		r0 = rf(code, prop)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Image)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(code)
+	if rf, ok := ret.Get(1).(func(string, *props.Rect) error); ok {
+		r1 = rf(code, prop)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -119,13 +178,14 @@ type Code_GenDataMatrix_Call struct {
 
 // GenDataMatrix is a helper method to define mock.On call
 //   - code string
-func (_e *Code_Expecter) GenDataMatrix(code interface{}) *Code_GenDataMatrix_Call {
-	return &Code_GenDataMatrix_Call{Call: _e.mock.On("GenDataMatrix", code)}
+//   - prop *props.Rect
+func (_e *Code_Expecter) GenDataMatrix(code interface{}, prop interface{}) *Code_GenDataMatrix_Call {
+	return &Code_GenDataMatrix_Call{Call: _e.mock.On("GenDataMatrix", code, prop)}
 }
 
-func (_c *Code_GenDataMatrix_Call) Run(run func(code string)) *Code_GenDataMatrix_Call {
+func (_c *Code_GenDataMatrix_Call) Run(run func(code string, prop *props.Rect)) *Code_GenDataMatrix_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(*props.Rect))
 	})
 	return _c
 }
@@ -135,14 +195,73 @@ func (_c *Code_GenDataMatrix_Call) Return(_a0 *entity.Image, _a1 error) *Code_Ge
 	return _c
 }
 
-func (_c *Code_GenDataMatrix_Call) RunAndReturn(run func(string) (*entity.Image, error)) *Code_GenDataMatrix_Call {
+func (_c *Code_GenDataMatrix_Call) RunAndReturn(run func(string, *props.Rect) (*entity.Image, error)) *Code_GenDataMatrix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenPDF417 provides a mock function with given fields: code, prop
+func (_m *Code) GenPDF417(code string, prop *props.PDF417) (*entity.Image, error) {
+	ret := _m.Called(code, prop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenPDF417")
+	}
+
+	var r0 *entity.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *props.PDF417) (*entity.Image, error)); ok {
+		return rf(code, prop)
+	}
+	if rf, ok := ret.Get(0).(func(string, *props.PDF417) *entity.Image); ok {
+		r0 = rf(code, prop)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *props.PDF417) error); ok {
+		r1 = rf(code, prop)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Code_GenPDF417_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenPDF417'
+type Code_GenPDF417_Call struct {
+	*mock.Call
+}
+
+// GenPDF417 is a helper method to define mock.On call
+//   - code string
+//   - prop *props.PDF417
+func (_e *Code_Expecter) GenPDF417(code interface{}, prop interface{}) *Code_GenPDF417_Call {
+	return &Code_GenPDF417_Call{Call: _e.mock.On("GenPDF417", code, prop)}
+}
+
+func (_c *Code_GenPDF417_Call) Run(run func(code string, prop *props.PDF417)) *Code_GenPDF417_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.PDF417))
+	})
+	return _c
+}
+
+func (_c *Code_GenPDF417_Call) Return(_a0 *entity.Image, _a1 error) *Code_GenPDF417_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Code_GenPDF417_Call) RunAndReturn(run func(string, *props.PDF417) (*entity.Image, error)) *Code_GenPDF417_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GenQr provides a mock function with given fields: code
-func (_m *Code) GenQr(code string) (*entity.Image, error) {
-	ret := _m.Called(code)
+// GenQr provides a mock function with given fields: code, prop
+func (_m *Code) GenQr(code string, prop *props.QrCode) (*entity.Image, error) {
+	ret := _m.Called(code, prop)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenQr")
@@ -150,19 +269,19 @@ func (_m *Code) GenQr(code string) (*entity.Image, error) {
 
 	var r0 *entity.Image
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*entity.Image, error)); ok {
-		return rf(code)
+	if rf, ok := ret.Get(0).(func(string, *props.QrCode) (*entity.Image, error)); ok {
+		return rf(code, prop)
 	}
-	if rf, ok := ret.Get(0).(func(string) *entity.Image); ok {
-		r0 = rf(code)
+	if rf, ok := ret.Get(0).(func(string, *props.QrCode) *entity.Image); ok {
+		r0 = rf(code, prop)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Image)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(code)
+	if rf, ok := ret.Get(1).(func(string, *props.QrCode) error); ok {
+		r1 = rf(code, prop)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -177,13 +296,14 @@ type Code_GenQr_Call struct {
 
 // GenQr is a helper method to define mock.On call
 //   - code string
-func (_e *Code_Expecter) GenQr(code interface{}) *Code_GenQr_Call {
-	return &Code_GenQr_Call{Call: _e.mock.On("GenQr", code)}
+//   - prop *props.QrCode
+func (_e *Code_Expecter) GenQr(code interface{}, prop interface{}) *Code_GenQr_Call {
+	return &Code_GenQr_Call{Call: _e.mock.On("GenQr", code, prop)}
 }
 
-func (_c *Code_GenQr_Call) Run(run func(code string)) *Code_GenQr_Call {
+func (_c *Code_GenQr_Call) Run(run func(code string, prop *props.QrCode)) *Code_GenQr_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(*props.QrCode))
 	})
 	return _c
 }
@@ -193,7 +313,184 @@ func (_c *Code_GenQr_Call) Return(_a0 *entity.Image, _a1 error) *Code_GenQr_Call
 	return _c
 }
 
-func (_c *Code_GenQr_Call) RunAndReturn(run func(string) (*entity.Image, error)) *Code_GenQr_Call {
+func (_c *Code_GenQr_Call) RunAndReturn(run func(string, *props.QrCode) (*entity.Image, error)) *Code_GenQr_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenVectorBar provides a mock function with given fields: code, prop
+func (_m *Code) GenVectorBar(code string, prop *props.Barcode) (*entity.Image, error) {
+	ret := _m.Called(code, prop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenVectorBar")
+	}
+
+	var r0 *entity.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *props.Barcode) (*entity.Image, error)); ok {
+		return rf(code, prop)
+	}
+	if rf, ok := ret.Get(0).(func(string, *props.Barcode) *entity.Image); ok {
+		r0 = rf(code, prop)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *props.Barcode) error); ok {
+		r1 = rf(code, prop)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Code_GenVectorBar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenVectorBar'
+type Code_GenVectorBar_Call struct {
+	*mock.Call
+}
+
+// GenVectorBar is a helper method to define mock.On call
+//   - code string
+//   - prop *props.Barcode
+func (_e *Code_Expecter) GenVectorBar(code interface{}, prop interface{}) *Code_GenVectorBar_Call {
+	return &Code_GenVectorBar_Call{Call: _e.mock.On("GenVectorBar", code, prop)}
+}
+
+func (_c *Code_GenVectorBar_Call) Run(run func(code string, prop *props.Barcode)) *Code_GenVectorBar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Barcode))
+	})
+	return _c
+}
+
+func (_c *Code_GenVectorBar_Call) Return(_a0 *entity.Image, _a1 error) *Code_GenVectorBar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Code_GenVectorBar_Call) RunAndReturn(run func(string, *props.Barcode) (*entity.Image, error)) *Code_GenVectorBar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenVectorDataMatrix provides a mock function with given fields: code, prop
+func (_m *Code) GenVectorDataMatrix(code string, prop *props.Rect) (*entity.Image, error) {
+	ret := _m.Called(code, prop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenVectorDataMatrix")
+	}
+
+	var r0 *entity.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *props.Rect) (*entity.Image, error)); ok {
+		return rf(code, prop)
+	}
+	if rf, ok := ret.Get(0).(func(string, *props.Rect) *entity.Image); ok {
+		r0 = rf(code, prop)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *props.Rect) error); ok {
+		r1 = rf(code, prop)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Code_GenVectorDataMatrix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenVectorDataMatrix'
+type Code_GenVectorDataMatrix_Call struct {
+	*mock.Call
+}
+
+// GenVectorDataMatrix is a helper method to define mock.On call
+//   - code string
+//   - prop *props.Rect
+func (_e *Code_Expecter) GenVectorDataMatrix(code interface{}, prop interface{}) *Code_GenVectorDataMatrix_Call {
+	return &Code_GenVectorDataMatrix_Call{Call: _e.mock.On("GenVectorDataMatrix", code, prop)}
+}
+
+func (_c *Code_GenVectorDataMatrix_Call) Run(run func(code string, prop *props.Rect)) *Code_GenVectorDataMatrix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Rect))
+	})
+	return _c
+}
+
+func (_c *Code_GenVectorDataMatrix_Call) Return(_a0 *entity.Image, _a1 error) *Code_GenVectorDataMatrix_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Code_GenVectorDataMatrix_Call) RunAndReturn(run func(string, *props.Rect) (*entity.Image, error)) *Code_GenVectorDataMatrix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenVectorQr provides a mock function with given fields: code, prop
+func (_m *Code) GenVectorQr(code string, prop *props.QrCode) (*entity.Image, error) {
+	ret := _m.Called(code, prop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenVectorQr")
+	}
+
+	var r0 *entity.Image
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *props.QrCode) (*entity.Image, error)); ok {
+		return rf(code, prop)
+	}
+	if rf, ok := ret.Get(0).(func(string, *props.QrCode) *entity.Image); ok {
+		r0 = rf(code, prop)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Image)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *props.QrCode) error); ok {
+		r1 = rf(code, prop)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Code_GenVectorQr_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenVectorQr'
+type Code_GenVectorQr_Call struct {
+	*mock.Call
+}
+
+// GenVectorQr is a helper method to define mock.On call
+//   - code string
+//   - prop *props.QrCode
+func (_e *Code_Expecter) GenVectorQr(code interface{}, prop interface{}) *Code_GenVectorQr_Call {
+	return &Code_GenVectorQr_Call{Call: _e.mock.On("GenVectorQr", code, prop)}
+}
+
+func (_c *Code_GenVectorQr_Call) Run(run func(code string, prop *props.QrCode)) *Code_GenVectorQr_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.QrCode))
+	})
+	return _c
+}
+
+func (_c *Code_GenVectorQr_Call) Return(_a0 *entity.Image, _a1 error) *Code_GenVectorQr_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Code_GenVectorQr_Call) RunAndReturn(run func(string, *props.QrCode) (*entity.Image, error)) *Code_GenVectorQr_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6,13 +6,9 @@ import (
 	extension "github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
 
-	gofpdf "github.com/jung-kurt/gofpdf"
-
 	mock "github.com/stretchr/testify/mock"
 
 	props "github.com/johnfercher/maroto/v2/pkg/props"
-
-	uuid "github.com/google/uuid"
 )
 
 // Image is an autogenerated mock type for the Image type
@@ -79,63 +75,60 @@ func (_c *Image_Add_Call) RunAndReturn(run func(*entity.Image, *entity.Cell, *en
 	return _c
 }
 
-// GetImageInfo provides a mock function with given fields: img, _a1
-func (_m *Image) GetImageInfo(img *entity.Image, _a1 extension.Type) (*gofpdf.ImageInfoType, uuid.UUID) {
-	ret := _m.Called(img, _a1)
+// GetDimensions provides a mock function with given fields: img
+func (_m *Image) GetDimensions(img *entity.Image) (*entity.Dimensions, error) {
+	ret := _m.Called(img)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetImageInfo")
+		panic("no return value specified for GetDimensions")
 	}
 
-	var r0 *gofpdf.ImageInfoType
-	var r1 uuid.UUID
-	if rf, ok := ret.Get(0).(func(*entity.Image, extension.Type) (*gofpdf.ImageInfoType, uuid.UUID)); ok {
-		return rf(img, _a1)
+	var r0 *entity.Dimensions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entity.Image) (*entity.Dimensions, error)); ok {
+		return rf(img)
 	}
-	if rf, ok := ret.Get(0).(func(*entity.Image, extension.Type) *gofpdf.ImageInfoType); ok {
-		r0 = rf(img, _a1)
+	if rf, ok := ret.Get(0).(func(*entity.Image) *entity.Dimensions); ok {
+		r0 = rf(img)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*gofpdf.ImageInfoType)
+			r0 = ret.Get(0).(*entity.Dimensions)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*entity.Image, extension.Type) uuid.UUID); ok {
-		r1 = rf(img, _a1)
+	if rf, ok := ret.Get(1).(func(*entity.Image) error); ok {
+		r1 = rf(img)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(uuid.UUID)
-		}
+		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
 
-// Image_GetImageInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImageInfo'
-type Image_GetImageInfo_Call struct {
+// Image_GetDimensions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDimensions'
+type Image_GetDimensions_Call struct {
 	*mock.Call
 }
 
-// GetImageInfo is a helper method to define mock.On call
+// GetDimensions is a helper method to define mock.On call
 //   - img *entity.Image
-//   - _a1 extension.Type
-func (_e *Image_Expecter) GetImageInfo(img interface{}, _a1 interface{}) *Image_GetImageInfo_Call {
-	return &Image_GetImageInfo_Call{Call: _e.mock.On("GetImageInfo", img, _a1)}
+func (_e *Image_Expecter) GetDimensions(img interface{}) *Image_GetDimensions_Call {
+	return &Image_GetDimensions_Call{Call: _e.mock.On("GetDimensions", img)}
 }
 
-func (_c *Image_GetImageInfo_Call) Run(run func(img *entity.Image, _a1 extension.Type)) *Image_GetImageInfo_Call {
+func (_c *Image_GetDimensions_Call) Run(run func(img *entity.Image)) *Image_GetDimensions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entity.Image), args[1].(extension.Type))
+		run(args[0].(*entity.Image))
 	})
 	return _c
 }
 
-func (_c *Image_GetImageInfo_Call) Return(_a0 *gofpdf.ImageInfoType, _a1 uuid.UUID) *Image_GetImageInfo_Call {
+func (_c *Image_GetDimensions_Call) Return(_a0 *entity.Dimensions, _a1 error) *Image_GetDimensions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Image_GetImageInfo_Call) RunAndReturn(run func(*entity.Image, extension.Type) (*gofpdf.ImageInfoType, uuid.UUID)) *Image_GetImageInfo_Call {
+func (_c *Image_GetDimensions_Call) RunAndReturn(run func(*entity.Image) (*entity.Dimensions, error)) *Image_GetDimensions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -24,6 +24,77 @@ func (_m *Provider) EXPECT() *Provider_Expecter {
 	return &Provider_Expecter{mock: &_m.Mock}
 }
 
+// AddArrow provides a mock function with given fields: from, to, cell, prop
+func (_m *Provider) AddArrow(from props.Point, to props.Point, cell *entity.Cell, prop *props.Shape) {
+	_m.Called(from, to, cell, prop)
+}
+
+// Provider_AddArrow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddArrow'
+type Provider_AddArrow_Call struct {
+	*mock.Call
+}
+
+// AddArrow is a helper method to define mock.On call
+//   - from props.Point
+//   - to props.Point
+//   - cell *entity.Cell
+//   - prop *props.Shape
+func (_e *Provider_Expecter) AddArrow(from interface{}, to interface{}, cell interface{}, prop interface{}) *Provider_AddArrow_Call {
+	return &Provider_AddArrow_Call{Call: _e.mock.On("AddArrow", from, to, cell, prop)}
+}
+
+func (_c *Provider_AddArrow_Call) Run(run func(from props.Point, to props.Point, cell *entity.Cell, prop *props.Shape)) *Provider_AddArrow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(props.Point), args[1].(props.Point), args[2].(*entity.Cell), args[3].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Provider_AddArrow_Call) Return() *Provider_AddArrow_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddArrow_Call) RunAndReturn(run func(props.Point, props.Point, *entity.Cell, *props.Shape)) *Provider_AddArrow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddAztec provides a mock function with given fields: code, cell, prop
+func (_m *Provider) AddAztec(code string, cell *entity.Cell, prop *props.Aztec) {
+	_m.Called(code, cell, prop)
+}
+
+// Provider_AddAztec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAztec'
+type Provider_AddAztec_Call struct {
+	*mock.Call
+}
+
+// AddAztec is a helper method to define mock.On call
+//   - code string
+//   - cell *entity.Cell
+//   - prop *props.Aztec
+func (_e *Provider_Expecter) AddAztec(code interface{}, cell interface{}, prop interface{}) *Provider_AddAztec_Call {
+	return &Provider_AddAztec_Call{Call: _e.mock.On("AddAztec", code, cell, prop)}
+}
+
+func (_c *Provider_AddAztec_Call) Run(run func(code string, cell *entity.Cell, prop *props.Aztec)) *Provider_AddAztec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell), args[2].(*props.Aztec))
+	})
+	return _c
+}
+
+func (_c *Provider_AddAztec_Call) Return() *Provider_AddAztec_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddAztec_Call) RunAndReturn(run func(string, *entity.Cell, *props.Aztec)) *Provider_AddAztec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddBackgroundImageFromBytes provides a mock function with given fields: bytes, cell, prop, _a3
 func (_m *Provider) AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, _a3 extension.Type) {
 	_m.Called(bytes, cell, prop, _a3)
@@ -95,6 +166,145 @@ func (_c *Provider_AddBarCode_Call) RunAndReturn(run func(string, *entity.Cell,
 	return _c
 }
 
+// AddBezier provides a mock function with given fields: points, cell, prop
+func (_m *Provider) AddBezier(points []props.Point, cell *entity.Cell, prop *props.Shape) {
+	_m.Called(points, cell, prop)
+}
+
+// Provider_AddBezier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBezier'
+type Provider_AddBezier_Call struct {
+	*mock.Call
+}
+
+// AddBezier is a helper method to define mock.On call
+//   - points []props.Point
+//   - cell *entity.Cell
+//   - prop *props.Shape
+func (_e *Provider_Expecter) AddBezier(points interface{}, cell interface{}, prop interface{}) *Provider_AddBezier_Call {
+	return &Provider_AddBezier_Call{Call: _e.mock.On("AddBezier", points, cell, prop)}
+}
+
+func (_c *Provider_AddBezier_Call) Run(run func(points []props.Point, cell *entity.Cell, prop *props.Shape)) *Provider_AddBezier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]props.Point), args[1].(*entity.Cell), args[2].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Provider_AddBezier_Call) Return() *Provider_AddBezier_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddBezier_Call) RunAndReturn(run func([]props.Point, *entity.Cell, *props.Shape)) *Provider_AddBezier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddChart provides a mock function with given fields: chart, cell, prop
+func (_m *Provider) AddChart(chart *entity.Chart, cell *entity.Cell, prop *props.Chart) {
+	_m.Called(chart, cell, prop)
+}
+
+// Provider_AddChart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddChart'
+type Provider_AddChart_Call struct {
+	*mock.Call
+}
+
+// AddChart is a helper method to define mock.On call
+//   - chart *entity.Chart
+//   - cell *entity.Cell
+//   - prop *props.Chart
+func (_e *Provider_Expecter) AddChart(chart interface{}, cell interface{}, prop interface{}) *Provider_AddChart_Call {
+	return &Provider_AddChart_Call{Call: _e.mock.On("AddChart", chart, cell, prop)}
+}
+
+func (_c *Provider_AddChart_Call) Run(run func(chart *entity.Chart, cell *entity.Cell, prop *props.Chart)) *Provider_AddChart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Chart), args[1].(*entity.Cell), args[2].(*props.Chart))
+	})
+	return _c
+}
+
+func (_c *Provider_AddChart_Call) Return() *Provider_AddChart_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddChart_Call) RunAndReturn(run func(*entity.Chart, *entity.Cell, *props.Chart)) *Provider_AddChart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddEllipse provides a mock function with given fields: cell, prop
+func (_m *Provider) AddEllipse(cell *entity.Cell, prop *props.Shape) {
+	_m.Called(cell, prop)
+}
+
+// Provider_AddEllipse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEllipse'
+type Provider_AddEllipse_Call struct {
+	*mock.Call
+}
+
+// AddEllipse is a helper method to define mock.On call
+//   - cell *entity.Cell
+//   - prop *props.Shape
+func (_e *Provider_Expecter) AddEllipse(cell interface{}, prop interface{}) *Provider_AddEllipse_Call {
+	return &Provider_AddEllipse_Call{Call: _e.mock.On("AddEllipse", cell, prop)}
+}
+
+func (_c *Provider_AddEllipse_Call) Run(run func(cell *entity.Cell, prop *props.Shape)) *Provider_AddEllipse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Cell), args[1].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Provider_AddEllipse_Call) Return() *Provider_AddEllipse_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddEllipse_Call) RunAndReturn(run func(*entity.Cell, *props.Shape)) *Provider_AddEllipse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddFormField provides a mock function with given fields: field, cell, prop
+func (_m *Provider) AddFormField(field *entity.FormField, cell *entity.Cell, prop *props.FormField) {
+	_m.Called(field, cell, prop)
+}
+
+// Provider_AddFormField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFormField'
+type Provider_AddFormField_Call struct {
+	*mock.Call
+}
+
+// AddFormField is a helper method to define mock.On call
+//   - field *entity.FormField
+//   - cell *entity.Cell
+//   - prop *props.FormField
+func (_e *Provider_Expecter) AddFormField(field interface{}, cell interface{}, prop interface{}) *Provider_AddFormField_Call {
+	return &Provider_AddFormField_Call{Call: _e.mock.On("AddFormField", field, cell, prop)}
+}
+
+func (_c *Provider_AddFormField_Call) Run(run func(field *entity.FormField, cell *entity.Cell, prop *props.FormField)) *Provider_AddFormField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.FormField), args[1].(*entity.Cell), args[2].(*props.FormField))
+	})
+	return _c
+}
+
+func (_c *Provider_AddFormField_Call) Return() *Provider_AddFormField_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddFormField_Call) RunAndReturn(run func(*entity.FormField, *entity.Cell, *props.FormField)) *Provider_AddFormField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddImageFromBytes provides a mock function with given fields: bytes, cell, prop, _a3
 func (_m *Provider) AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, _a3 extension.Type) {
 	_m.Called(bytes, cell, prop, _a3)
@@ -235,9 +445,114 @@ func (_c *Provider_AddMatrixCode_Call) RunAndReturn(run func(string, *entity.Cel
 	return _c
 }
 
-// AddQrCode provides a mock function with given fields: code, cell, rect
-func (_m *Provider) AddQrCode(code string, cell *entity.Cell, rect *props.Rect) {
-	_m.Called(code, cell, rect)
+// AddPDF417 provides a mock function with given fields: code, cell, prop
+func (_m *Provider) AddPDF417(code string, cell *entity.Cell, prop *props.PDF417) {
+	_m.Called(code, cell, prop)
+}
+
+// Provider_AddPDF417_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPDF417'
+type Provider_AddPDF417_Call struct {
+	*mock.Call
+}
+
+// AddPDF417 is a helper method to define mock.On call
+//   - code string
+//   - cell *entity.Cell
+//   - prop *props.PDF417
+func (_e *Provider_Expecter) AddPDF417(code interface{}, cell interface{}, prop interface{}) *Provider_AddPDF417_Call {
+	return &Provider_AddPDF417_Call{Call: _e.mock.On("AddPDF417", code, cell, prop)}
+}
+
+func (_c *Provider_AddPDF417_Call) Run(run func(code string, cell *entity.Cell, prop *props.PDF417)) *Provider_AddPDF417_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*entity.Cell), args[2].(*props.PDF417))
+	})
+	return _c
+}
+
+func (_c *Provider_AddPDF417_Call) Return() *Provider_AddPDF417_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddPDF417_Call) RunAndReturn(run func(string, *entity.Cell, *props.PDF417)) *Provider_AddPDF417_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddPolygon provides a mock function with given fields: points, cell, prop
+func (_m *Provider) AddPolygon(points []props.Point, cell *entity.Cell, prop *props.Shape) {
+	_m.Called(points, cell, prop)
+}
+
+// Provider_AddPolygon_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPolygon'
+type Provider_AddPolygon_Call struct {
+	*mock.Call
+}
+
+// AddPolygon is a helper method to define mock.On call
+//   - points []props.Point
+//   - cell *entity.Cell
+//   - prop *props.Shape
+func (_e *Provider_Expecter) AddPolygon(points interface{}, cell interface{}, prop interface{}) *Provider_AddPolygon_Call {
+	return &Provider_AddPolygon_Call{Call: _e.mock.On("AddPolygon", points, cell, prop)}
+}
+
+func (_c *Provider_AddPolygon_Call) Run(run func(points []props.Point, cell *entity.Cell, prop *props.Shape)) *Provider_AddPolygon_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]props.Point), args[1].(*entity.Cell), args[2].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Provider_AddPolygon_Call) Return() *Provider_AddPolygon_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddPolygon_Call) RunAndReturn(run func([]props.Point, *entity.Cell, *props.Shape)) *Provider_AddPolygon_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddPolyline provides a mock function with given fields: points, cell, prop
+func (_m *Provider) AddPolyline(points []props.Point, cell *entity.Cell, prop *props.Shape) {
+	_m.Called(points, cell, prop)
+}
+
+// Provider_AddPolyline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPolyline'
+type Provider_AddPolyline_Call struct {
+	*mock.Call
+}
+
+// AddPolyline is a helper method to define mock.On call
+//   - points []props.Point
+//   - cell *entity.Cell
+//   - prop *props.Shape
+func (_e *Provider_Expecter) AddPolyline(points interface{}, cell interface{}, prop interface{}) *Provider_AddPolyline_Call {
+	return &Provider_AddPolyline_Call{Call: _e.mock.On("AddPolyline", points, cell, prop)}
+}
+
+func (_c *Provider_AddPolyline_Call) Run(run func(points []props.Point, cell *entity.Cell, prop *props.Shape)) *Provider_AddPolyline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]props.Point), args[1].(*entity.Cell), args[2].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Provider_AddPolyline_Call) Return() *Provider_AddPolyline_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddPolyline_Call) RunAndReturn(run func([]props.Point, *entity.Cell, *props.Shape)) *Provider_AddPolyline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddQrCode provides a mock function with given fields: code, cell, prop
+func (_m *Provider) AddQrCode(code string, cell *entity.Cell, prop *props.QrCode) {
+	_m.Called(code, cell, prop)
 }
 
 // Provider_AddQrCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddQrCode'
@@ -248,14 +563,14 @@ type Provider_AddQrCode_Call struct {
 // AddQrCode is a helper method to define mock.On call
 //   - code string
 //   - cell *entity.Cell
-//   - rect *props.Rect
-func (_e *Provider_Expecter) AddQrCode(code interface{}, cell interface{}, rect interface{}) *Provider_AddQrCode_Call {
-	return &Provider_AddQrCode_Call{Call: _e.mock.On("AddQrCode", code, cell, rect)}
+//   - prop *props.QrCode
+func (_e *Provider_Expecter) AddQrCode(code interface{}, cell interface{}, prop interface{}) *Provider_AddQrCode_Call {
+	return &Provider_AddQrCode_Call{Call: _e.mock.On("AddQrCode", code, cell, prop)}
 }
 
-func (_c *Provider_AddQrCode_Call) Run(run func(code string, cell *entity.Cell, rect *props.Rect)) *Provider_AddQrCode_Call {
+func (_c *Provider_AddQrCode_Call) Run(run func(code string, cell *entity.Cell, prop *props.QrCode)) *Provider_AddQrCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(*entity.Cell), args[2].(*props.Rect))
+		run(args[0].(string), args[1].(*entity.Cell), args[2].(*props.QrCode))
 	})
 	return _c
 }
@@ -265,7 +580,41 @@ func (_c *Provider_AddQrCode_Call) Return() *Provider_AddQrCode_Call {
 	return _c
 }
 
-func (_c *Provider_AddQrCode_Call) RunAndReturn(run func(string, *entity.Cell, *props.Rect)) *Provider_AddQrCode_Call {
+func (_c *Provider_AddQrCode_Call) RunAndReturn(run func(string, *entity.Cell, *props.QrCode)) *Provider_AddQrCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRectangle provides a mock function with given fields: cell, prop
+func (_m *Provider) AddRectangle(cell *entity.Cell, prop *props.Shape) {
+	_m.Called(cell, prop)
+}
+
+// Provider_AddRectangle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRectangle'
+type Provider_AddRectangle_Call struct {
+	*mock.Call
+}
+
+// AddRectangle is a helper method to define mock.On call
+//   - cell *entity.Cell
+//   - prop *props.Shape
+func (_e *Provider_Expecter) AddRectangle(cell interface{}, prop interface{}) *Provider_AddRectangle_Call {
+	return &Provider_AddRectangle_Call{Call: _e.mock.On("AddRectangle", cell, prop)}
+}
+
+func (_c *Provider_AddRectangle_Call) Run(run func(cell *entity.Cell, prop *props.Shape)) *Provider_AddRectangle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entity.Cell), args[1].(*props.Shape))
+	})
+	return _c
+}
+
+func (_c *Provider_AddRectangle_Call) Return() *Provider_AddRectangle_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Provider_AddRectangle_Call) RunAndReturn(run func(*entity.Cell, *props.Shape)) *Provider_AddRectangle_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -431,6 +780,124 @@ func (_c *Provider_GenerateBytes_Call) RunAndReturn(run func() ([]byte, error))
 	return _c
 }
 
+// GetDimensionsByAztec provides a mock function with given fields: code, prop
+func (_m *Provider) GetDimensionsByAztec(code string, prop *props.Aztec) (*entity.Dimensions, error) {
+	ret := _m.Called(code, prop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDimensionsByAztec")
+	}
+
+	var r0 *entity.Dimensions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *props.Aztec) (*entity.Dimensions, error)); ok {
+		return rf(code, prop)
+	}
+	if rf, ok := ret.Get(0).(func(string, *props.Aztec) *entity.Dimensions); ok {
+		r0 = rf(code, prop)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Dimensions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *props.Aztec) error); ok {
+		r1 = rf(code, prop)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_GetDimensionsByAztec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDimensionsByAztec'
+type Provider_GetDimensionsByAztec_Call struct {
+	*mock.Call
+}
+
+// GetDimensionsByAztec is a helper method to define mock.On call
+//   - code string
+//   - prop *props.Aztec
+func (_e *Provider_Expecter) GetDimensionsByAztec(code interface{}, prop interface{}) *Provider_GetDimensionsByAztec_Call {
+	return &Provider_GetDimensionsByAztec_Call{Call: _e.mock.On("GetDimensionsByAztec", code, prop)}
+}
+
+func (_c *Provider_GetDimensionsByAztec_Call) Run(run func(code string, prop *props.Aztec)) *Provider_GetDimensionsByAztec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Aztec))
+	})
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByAztec_Call) Return(_a0 *entity.Dimensions, _a1 error) *Provider_GetDimensionsByAztec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByAztec_Call) RunAndReturn(run func(string, *props.Aztec) (*entity.Dimensions, error)) *Provider_GetDimensionsByAztec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDimensionsByBarCode provides a mock function with given fields: code, prop
+func (_m *Provider) GetDimensionsByBarCode(code string, prop *props.Barcode) (*entity.Dimensions, error) {
+	ret := _m.Called(code, prop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDimensionsByBarCode")
+	}
+
+	var r0 *entity.Dimensions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *props.Barcode) (*entity.Dimensions, error)); ok {
+		return rf(code, prop)
+	}
+	if rf, ok := ret.Get(0).(func(string, *props.Barcode) *entity.Dimensions); ok {
+		r0 = rf(code, prop)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Dimensions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *props.Barcode) error); ok {
+		r1 = rf(code, prop)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_GetDimensionsByBarCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDimensionsByBarCode'
+type Provider_GetDimensionsByBarCode_Call struct {
+	*mock.Call
+}
+
+// GetDimensionsByBarCode is a helper method to define mock.On call
+//   - code string
+//   - prop *props.Barcode
+func (_e *Provider_Expecter) GetDimensionsByBarCode(code interface{}, prop interface{}) *Provider_GetDimensionsByBarCode_Call {
+	return &Provider_GetDimensionsByBarCode_Call{Call: _e.mock.On("GetDimensionsByBarCode", code, prop)}
+}
+
+func (_c *Provider_GetDimensionsByBarCode_Call) Run(run func(code string, prop *props.Barcode)) *Provider_GetDimensionsByBarCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Barcode))
+	})
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByBarCode_Call) Return(_a0 *entity.Dimensions, _a1 error) *Provider_GetDimensionsByBarCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByBarCode_Call) RunAndReturn(run func(string, *props.Barcode) (*entity.Dimensions, error)) *Provider_GetDimensionsByBarCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDimensionsByImage provides a mock function with given fields: file
 func (_m *Provider) GetDimensionsByImage(file string) (*entity.Dimensions, error) {
 	ret := _m.Called(file)
@@ -548,9 +1015,9 @@ func (_c *Provider_GetDimensionsByImageByte_Call) RunAndReturn(run func([]byte,
 	return _c
 }
 
-// GetDimensionsByMatrixCode provides a mock function with given fields: code
-func (_m *Provider) GetDimensionsByMatrixCode(code string) (*entity.Dimensions, error) {
-	ret := _m.Called(code)
+// GetDimensionsByMatrixCode provides a mock function with given fields: code, prop
+func (_m *Provider) GetDimensionsByMatrixCode(code string, prop *props.Rect) (*entity.Dimensions, error) {
+	ret := _m.Called(code, prop)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDimensionsByMatrixCode")
@@ -558,19 +1025,19 @@ func (_m *Provider) GetDimensionsByMatrixCode(code string) (*entity.Dimensions,
 
 	var r0 *entity.Dimensions
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*entity.Dimensions, error)); ok {
-		return rf(code)
+	if rf, ok := ret.Get(0).(func(string, *props.Rect) (*entity.Dimensions, error)); ok {
+		return rf(code, prop)
 	}
-	if rf, ok := ret.Get(0).(func(string) *entity.Dimensions); ok {
-		r0 = rf(code)
+	if rf, ok := ret.Get(0).(func(string, *props.Rect) *entity.Dimensions); ok {
+		r0 = rf(code, prop)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Dimensions)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(code)
+	if rf, ok := ret.Get(1).(func(string, *props.Rect) error); ok {
+		r1 = rf(code, prop)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -585,13 +1052,14 @@ type Provider_GetDimensionsByMatrixCode_Call struct {
 
 // GetDimensionsByMatrixCode is a helper method to define mock.On call
 //   - code string
-func (_e *Provider_Expecter) GetDimensionsByMatrixCode(code interface{}) *Provider_GetDimensionsByMatrixCode_Call {
-	return &Provider_GetDimensionsByMatrixCode_Call{Call: _e.mock.On("GetDimensionsByMatrixCode", code)}
+//   - prop *props.Rect
+func (_e *Provider_Expecter) GetDimensionsByMatrixCode(code interface{}, prop interface{}) *Provider_GetDimensionsByMatrixCode_Call {
+	return &Provider_GetDimensionsByMatrixCode_Call{Call: _e.mock.On("GetDimensionsByMatrixCode", code, prop)}
 }
 
-func (_c *Provider_GetDimensionsByMatrixCode_Call) Run(run func(code string)) *Provider_GetDimensionsByMatrixCode_Call {
+func (_c *Provider_GetDimensionsByMatrixCode_Call) Run(run func(code string, prop *props.Rect)) *Provider_GetDimensionsByMatrixCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(*props.Rect))
 	})
 	return _c
 }
@@ -601,14 +1069,73 @@ func (_c *Provider_GetDimensionsByMatrixCode_Call) Return(_a0 *entity.Dimensions
 	return _c
 }
 
-func (_c *Provider_GetDimensionsByMatrixCode_Call) RunAndReturn(run func(string) (*entity.Dimensions, error)) *Provider_GetDimensionsByMatrixCode_Call {
+func (_c *Provider_GetDimensionsByMatrixCode_Call) RunAndReturn(run func(string, *props.Rect) (*entity.Dimensions, error)) *Provider_GetDimensionsByMatrixCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDimensionsByPDF417 provides a mock function with given fields: code, prop
+func (_m *Provider) GetDimensionsByPDF417(code string, prop *props.PDF417) (*entity.Dimensions, error) {
+	ret := _m.Called(code, prop)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDimensionsByPDF417")
+	}
+
+	var r0 *entity.Dimensions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *props.PDF417) (*entity.Dimensions, error)); ok {
+		return rf(code, prop)
+	}
+	if rf, ok := ret.Get(0).(func(string, *props.PDF417) *entity.Dimensions); ok {
+		r0 = rf(code, prop)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Dimensions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *props.PDF417) error); ok {
+		r1 = rf(code, prop)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_GetDimensionsByPDF417_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDimensionsByPDF417'
+type Provider_GetDimensionsByPDF417_Call struct {
+	*mock.Call
+}
+
+// GetDimensionsByPDF417 is a helper method to define mock.On call
+//   - code string
+//   - prop *props.PDF417
+func (_e *Provider_Expecter) GetDimensionsByPDF417(code interface{}, prop interface{}) *Provider_GetDimensionsByPDF417_Call {
+	return &Provider_GetDimensionsByPDF417_Call{Call: _e.mock.On("GetDimensionsByPDF417", code, prop)}
+}
+
+func (_c *Provider_GetDimensionsByPDF417_Call) Run(run func(code string, prop *props.PDF417)) *Provider_GetDimensionsByPDF417_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.PDF417))
+	})
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByPDF417_Call) Return(_a0 *entity.Dimensions, _a1 error) *Provider_GetDimensionsByPDF417_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_GetDimensionsByPDF417_Call) RunAndReturn(run func(string, *props.PDF417) (*entity.Dimensions, error)) *Provider_GetDimensionsByPDF417_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDimensionsByQrCode provides a mock function with given fields: code
-func (_m *Provider) GetDimensionsByQrCode(code string) (*entity.Dimensions, error) {
-	ret := _m.Called(code)
+// GetDimensionsByQrCode provides a mock function with given fields: code, prop
+func (_m *Provider) GetDimensionsByQrCode(code string, prop *props.QrCode) (*entity.Dimensions, error) {
+	ret := _m.Called(code, prop)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDimensionsByQrCode")
@@ -616,19 +1143,19 @@ func (_m *Provider) GetDimensionsByQrCode(code string) (*entity.Dimensions, erro
 
 	var r0 *entity.Dimensions
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*entity.Dimensions, error)); ok {
-		return rf(code)
+	if rf, ok := ret.Get(0).(func(string, *props.QrCode) (*entity.Dimensions, error)); ok {
+		return rf(code, prop)
 	}
-	if rf, ok := ret.Get(0).(func(string) *entity.Dimensions); ok {
-		r0 = rf(code)
+	if rf, ok := ret.Get(0).(func(string, *props.QrCode) *entity.Dimensions); ok {
+		r0 = rf(code, prop)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Dimensions)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(code)
+	if rf, ok := ret.Get(1).(func(string, *props.QrCode) error); ok {
+		r1 = rf(code, prop)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -643,13 +1170,14 @@ type Provider_GetDimensionsByQrCode_Call struct {
 
 // GetDimensionsByQrCode is a helper method to define mock.On call
 //   - code string
-func (_e *Provider_Expecter) GetDimensionsByQrCode(code interface{}) *Provider_GetDimensionsByQrCode_Call {
-	return &Provider_GetDimensionsByQrCode_Call{Call: _e.mock.On("GetDimensionsByQrCode", code)}
+//   - prop *props.QrCode
+func (_e *Provider_Expecter) GetDimensionsByQrCode(code interface{}, prop interface{}) *Provider_GetDimensionsByQrCode_Call {
+	return &Provider_GetDimensionsByQrCode_Call{Call: _e.mock.On("GetDimensionsByQrCode", code, prop)}
 }
 
-func (_c *Provider_GetDimensionsByQrCode_Call) Run(run func(code string)) *Provider_GetDimensionsByQrCode_Call {
+func (_c *Provider_GetDimensionsByQrCode_Call) Run(run func(code string, prop *props.QrCode)) *Provider_GetDimensionsByQrCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(*props.QrCode))
 	})
 	return _c
 }
@@ -659,7 +1187,7 @@ func (_c *Provider_GetDimensionsByQrCode_Call) Return(_a0 *entity.Dimensions, _a
 	return _c
 }
 
-func (_c *Provider_GetDimensionsByQrCode_Call) RunAndReturn(run func(string) (*entity.Dimensions, error)) *Provider_GetDimensionsByQrCode_Call {
+func (_c *Provider_GetDimensionsByQrCode_Call) RunAndReturn(run func(string, *props.QrCode) (*entity.Dimensions, error)) *Provider_GetDimensionsByQrCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -758,6 +1286,53 @@ func (_c *Provider_GetLinesQuantity_Call) RunAndReturn(run func(string, *props.T
 	return _c
 }
 
+// GetStringWidth provides a mock function with given fields: text, textProp
+func (_m *Provider) GetStringWidth(text string, textProp *props.Text) float64 {
+	ret := _m.Called(text, textProp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStringWidth")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(string, *props.Text) float64); ok {
+		r0 = rf(text, textProp)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// Provider_GetStringWidth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStringWidth'
+type Provider_GetStringWidth_Call struct {
+	*mock.Call
+}
+
+// GetStringWidth is a helper method to define mock.On call
+//   - text string
+//   - textProp *props.Text
+func (_e *Provider_Expecter) GetStringWidth(text interface{}, textProp interface{}) *Provider_GetStringWidth_Call {
+	return &Provider_GetStringWidth_Call{Call: _e.mock.On("GetStringWidth", text, textProp)}
+}
+
+func (_c *Provider_GetStringWidth_Call) Run(run func(text string, textProp *props.Text)) *Provider_GetStringWidth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*props.Text))
+	})
+	return _c
+}
+
+func (_c *Provider_GetStringWidth_Call) Return(_a0 float64) *Provider_GetStringWidth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_GetStringWidth_Call) RunAndReturn(run func(string, *props.Text) float64) *Provider_GetStringWidth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetCompression provides a mock function with given fields: compression
 func (_m *Provider) SetCompression(compression bool) {
 	_m.Called(compression)
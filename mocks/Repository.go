@@ -5,6 +5,7 @@ package mocks
 import (
 	fontstyle "github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	entity "github.com/johnfercher/maroto/v2/pkg/core/entity"
+	loader "github.com/johnfercher/maroto/v2/pkg/loader"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -181,6 +182,54 @@ func (_c *Repository_Load_Call) RunAndReturn(run func() ([]*entity.CustomFont, e
 	return _c
 }
 
+// WithLoader provides a mock function with given fields: _a0
+func (_m *Repository) WithLoader(_a0 loader.Loader) repository.Repository {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithLoader")
+	}
+
+	var r0 repository.Repository
+	if rf, ok := ret.Get(0).(func(loader.Loader) repository.Repository); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.Repository)
+		}
+	}
+
+	return r0
+}
+
+// Repository_WithLoader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithLoader'
+type Repository_WithLoader_Call struct {
+	*mock.Call
+}
+
+// WithLoader is a helper method to define mock.On call
+//   - _a0 loader.Loader
+func (_e *Repository_Expecter) WithLoader(_a0 interface{}) *Repository_WithLoader_Call {
+	return &Repository_WithLoader_Call{Call: _e.mock.On("WithLoader", _a0)}
+}
+
+func (_c *Repository_WithLoader_Call) Run(run func(_a0 loader.Loader)) *Repository_WithLoader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(loader.Loader))
+	})
+	return _c
+}
+
+func (_c *Repository_WithLoader_Call) Return(_a0 repository.Repository) *Repository_WithLoader_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_WithLoader_Call) RunAndReturn(run func(loader.Loader) repository.Repository) *Repository_WithLoader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRepository(t interface {
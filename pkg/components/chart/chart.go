@@ -0,0 +1,87 @@
+// Package chart implements creation of vector charts.
+package chart
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type Chart struct {
+	data   entity.Chart
+	prop   props.Chart
+	config *entity.Config
+}
+
+// New is responsible to create an instance of a Chart.
+//   - labels: The labels of the values, ex: the months of a year
+//   - series: The values drawn for each label, pie and donut charts draw only the first series
+//   - ps: A set of settings that must be applied to the chart
+func New(labels []string, series []entity.Series, ps ...props.Chart) core.Component {
+	prop := props.Chart{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return &Chart{
+		data: entity.Chart{
+			Labels: labels,
+			Series: series,
+		},
+		prop: prop,
+	}
+}
+
+// NewCol is responsible to create an instance of a Chart wrapped in a Col.
+func NewCol(size int, labels []string, series []entity.Series, ps ...props.Chart) core.Col {
+	chart := New(labels, series, ps...)
+	return col.New(size).Add(chart)
+}
+
+// NewRow is responsible to create an instance of a Chart wrapped in a Row.
+func NewRow(height float64, labels []string, series []entity.Series, ps ...props.Chart) core.Row {
+	chart := New(labels, series, ps...)
+	c := col.New().Add(chart)
+	return row.New(height).Add(c)
+}
+
+// NewAutoRow is responsible to create an instance of a Chart wrapped in a Row with automatic height.
+func NewAutoRow(labels []string, series []entity.Series, ps ...props.Chart) core.Row {
+	chart := New(labels, series, ps...)
+	c := col.New().Add(chart)
+	return row.New().Add(c)
+}
+
+// Render renders a Chart into a PDF context.
+func (c *Chart) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddChart(&c.data, cell, &c.prop)
+}
+
+// GetStructure returns the Structure of a Chart.
+func (c *Chart) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "chart",
+		Value:   c.prop.Type,
+		Details: c.data.AppendMap(c.prop.ToMap()),
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height that the chart will have in the PDF
+func (c *Chart) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	proportion := c.prop.Proportion.Height / c.prop.Proportion.Width
+	width := (c.prop.Percent / 100) * cell.Width
+	return proportion*width + c.prop.Top
+}
+
+// SetConfig sets the config.
+func (c *Chart) SetConfig(config *entity.Config) {
+	c.config = config
+	c.prop.Text.MakeValid(c.config.DefaultFont)
+}
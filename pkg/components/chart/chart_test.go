@@ -0,0 +1,121 @@
+package chart_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/chart"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Arrange
+		data := fixture.ChartEntity()
+
+		// Act
+		sut := chart.New(data.Labels, data.Series)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_chart_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Arrange
+		data := fixture.ChartEntity()
+
+		// Act
+		sut := chart.New(data.Labels, data.Series, fixture.ChartProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_chart_custom_prop.json")
+	})
+}
+
+func TestNewCol(t *testing.T) {
+	// Arrange
+	data := fixture.ChartEntity()
+
+	// Act
+	sut := chart.NewCol(12, data.Labels, data.Series, fixture.ChartProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_chart_col.json")
+}
+
+func TestNewRow(t *testing.T) {
+	// Arrange
+	data := fixture.ChartEntity()
+
+	// Act
+	sut := chart.NewRow(40, data.Labels, data.Series, fixture.ChartProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_chart_row.json")
+}
+
+func TestNewAutoRow(t *testing.T) {
+	// Arrange
+	data := fixture.ChartEntity()
+
+	// Act
+	sut := chart.NewAutoRow(data.Labels, data.Series, fixture.ChartProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/charts/new_chart_auto_row.json")
+}
+
+func TestChart_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		data := fixture.ChartEntity()
+		prop := fixture.ChartProp()
+		sut := chart.New(data.Labels, data.Series, prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddChart(&data, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddChart", 1)
+	})
+}
+
+func TestChart_SetConfig(t *testing.T) {
+	t.Run("should apply default font to labels", func(t *testing.T) {
+		// Arrange
+		fontProp := fixture.FontProp()
+		cfg := &entity.Config{
+			DefaultFont: &fontProp,
+		}
+		sut := chart.New(nil, nil)
+
+		// Act
+		sut.SetConfig(cfg)
+
+		// Assert
+		details := sut.GetStructure().GetData().Details
+		assert.Equal(t, fontProp.Family, details["prop_text_font_family"])
+		assert.Equal(t, 8.0, details["prop_text_font_size"])
+	})
+}
+
+func TestChart_GetHeight(t *testing.T) {
+	t.Run("when proportion is 4x3, should return 75% of the used width plus top", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := chart.New(nil, nil, props.Chart{Percent: 50, Top: 2, Proportion: props.Proportion{Width: 4, Height: 3}})
+
+		// Act
+		height := sut.GetHeight(mocks.NewProvider(t), &cell)
+
+		// Assert
+		assert.Equal(t, cell.Width*0.5*0.75+2, height)
+	})
+}
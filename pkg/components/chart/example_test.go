@@ -0,0 +1,51 @@
+package chart_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/chart"
+	"github.com/johnfercher/maroto/v2/pkg/consts/charttype"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNew demonstrates how to create a bar chart component.
+func ExampleNew() {
+	m := maroto.New()
+
+	labels := []string{"Jan", "Feb", "Mar"}
+	series := []entity.Series{
+		{Name: "Sales", Values: []float64{10, 25, 17}},
+		{Name: "Costs", Values: []float64{8, 12, 9}},
+	}
+
+	m.AddRows(chart.NewAutoRow(labels, series))
+
+	// generate document
+}
+
+// ExampleNewCol demonstrates how to create a donut chart wrapped into a column.
+func ExampleNewCol() {
+	m := maroto.New()
+
+	labels := []string{"Rent", "Food", "Others"}
+	series := []entity.Series{{Values: []float64{50, 30, 20}}}
+
+	m.AddRow(60,
+		chart.NewCol(6, labels, series, props.Chart{Type: charttype.Donut, Center: true}),
+		chart.NewCol(6, labels, series, props.Chart{Type: charttype.Pie, Center: true}),
+	)
+
+	// generate document
+}
+
+// ExampleNewRow demonstrates how to create a line chart wrapped into a row.
+func ExampleNewRow() {
+	m := maroto.New()
+
+	labels := []string{"Q1", "Q2", "Q3", "Q4"}
+	series := []entity.Series{{Name: "Revenue", Values: []float64{3.5, 4.2, 3.9, 5.1}, Color: &props.BlueColor}}
+
+	m.AddRows(chart.NewRow(80, labels, series, props.Chart{Type: charttype.Line, Center: true}))
+
+	// generate document
+}
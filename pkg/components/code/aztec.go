@@ -0,0 +1,87 @@
+// Package code implements creation of Barcode, MatrixCode, QrCode, PDF417 and Aztec.
+// nolint:dupl
+package code
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type Aztec struct {
+	code   string
+	prop   props.Aztec
+	config *entity.Config
+}
+
+// NewAztec is responsible to create an instance of an Aztec.
+func NewAztec(code string, aztecProps ...props.Aztec) core.Component {
+	prop := props.Aztec{}
+	if len(aztecProps) > 0 {
+		prop = aztecProps[0]
+	}
+	prop.MakeValid()
+
+	return &Aztec{
+		code: code,
+		prop: prop,
+	}
+}
+
+// NewAztecCol is responsible to create an instance of an Aztec wrapped in a Col.
+func NewAztecCol(size int, code string, ps ...props.Aztec) core.Col {
+	aztec := NewAztec(code, ps...)
+	return col.New(size).Add(aztec)
+}
+
+// NewAutoAztecRow is responsible to create an instance of an Aztec wrapped in a Row with automatic height.
+//   - code: The value that must be placed in the aztec
+//   - ps: A set of settings that must be applied to the aztec
+func NewAutoAztecRow(code string, ps ...props.Aztec) core.Row {
+	aztec := NewAztec(code, ps...)
+	c := col.New().Add(aztec)
+	return row.New().Add(c)
+}
+
+// NewAztecRow is responsible to create an instance of an Aztec wrapped in a Row.
+func NewAztecRow(height float64, code string, ps ...props.Aztec) core.Row {
+	aztec := NewAztec(code, ps...)
+	c := col.New().Add(aztec)
+	return row.New(height).Add(c)
+}
+
+// Render renders an Aztec into a PDF context.
+func (a *Aztec) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddAztec(a.code, cell, &a.prop)
+}
+
+// GetStructure returns the Structure of an Aztec.
+func (a *Aztec) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "aztec",
+		Value:   a.code,
+		Details: a.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height that the code will have in the PDF
+func (a *Aztec) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	dimensions, err := provider.GetDimensionsByAztec(a.code, &a.prop)
+	if err != nil {
+		return 0
+	}
+	proportion := dimensions.Height / dimensions.Width
+	width := (a.prop.Percent / 100) * cell.Width
+	return proportion * width
+}
+
+// SetConfig sets the configuration of an Aztec.
+func (a *Aztec) SetConfig(config *entity.Config) {
+	a.config = config
+}
@@ -0,0 +1,144 @@
+// nolint: dupl
+package code_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAztec(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAztec("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_aztec_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewAztec("code", fixture.AztecProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_aztec_custom_prop.json")
+	})
+}
+
+func TestNewAztecCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAztecCol(12, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_aztec_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewAztecCol(12, "code", fixture.AztecProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_aztec_col_custom_prop.json")
+	})
+}
+
+func TestNewAztecRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAztecRow(10, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_aztec_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewAztecRow(10, "code", fixture.AztecProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_aztec_row_custom_prop.json")
+	})
+}
+
+func TestNewAutoAztecRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoAztecRow("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_auto_aztec_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoAztecRow("code", fixture.AztecProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_auto_aztec_row_custom_prop.json")
+	})
+}
+
+func TestAztec_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		codeValue := "code"
+		cell := fixture.CellEntity()
+		prop := fixture.AztecProp()
+		sut := code.NewAztec(codeValue, prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddAztec(codeValue, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddAztec", 1)
+	})
+}
+
+func TestAztec_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := code.NewAztec("code")
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
+
+func TestAztec_GetHeight(t *testing.T) {
+	t.Run("When it is not possible to know the dimensions of the aztec, should return height 0", func(t *testing.T) {
+		cell := fixture.CellEntity()
+		defaultProp := props.Aztec{}
+		defaultProp.MakeValid()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByAztec("code", &defaultProp).Return(nil, errors.New("anyError2"))
+
+		sut := code.NewAztec("code")
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+		assert.Equal(t, height, 0.0)
+	})
+
+	t.Run("When the height of the aztec is half the width, should return half the width of the cell", func(t *testing.T) {
+		cell := fixture.CellEntity()
+		defaultProp := props.Aztec{}
+		defaultProp.MakeValid()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByAztec("code", &defaultProp).Return(&entity.Dimensions{Width: 10, Height: 5}, nil)
+
+		sut := code.NewAztec("code")
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+		assert.Equal(t, height, cell.Width/2)
+	})
+}
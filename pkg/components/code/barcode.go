@@ -1,10 +1,11 @@
-// Package code implements creation of Barcode, MatrixCode and QrCode.
+// Package code implements creation of Barcode, MatrixCode, QrCode, PDF417 and Aztec.
 // nolint:dupl // It's similar to Barcode.go and it's hard to extract common code.
 package code
 
 import (
 	"github.com/johnfercher/go-tree/node"
 
+	codegen "github.com/johnfercher/maroto/v2/internal/code"
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
 	"github.com/johnfercher/maroto/v2/pkg/core"
@@ -13,7 +14,9 @@ import (
 )
 
 type Barcode struct {
-	code   string
+	code string
+	// value is the code shown in the structure and below the bars, it is empty when it is the code itself.
+	value  string
 	prop   props.Barcode
 	config *entity.Config
 }
@@ -69,6 +72,11 @@ func NewAutoBarRow(code string, ps ...props.Barcode) core.Row {
 //   - provider: Is the creator provider used to generate the pdf
 //   - cell: cell represents the space available to draw the component
 func (b *Barcode) Render(provider core.Provider, cell *entity.Cell) {
+	if b.prop.HumanReadable != nil {
+		b.renderWithText(provider, cell)
+		return
+	}
+
 	provider.AddBarCode(b.code, cell, &b.prop)
 }
 
@@ -76,7 +84,7 @@ func (b *Barcode) Render(provider core.Provider, cell *entity.Cell) {
 func (b *Barcode) GetStructure() *node.Node[core.Structure] {
 	str := core.Structure{
 		Type:    "barcode",
-		Value:   b.code,
+		Value:   b.getValue(),
 		Details: b.prop.ToMap(),
 	}
 
@@ -85,12 +93,52 @@ func (b *Barcode) GetStructure() *node.Node[core.Structure] {
 
 // GetHeight returns the height that the barcode will have in the PDF
 func (b *Barcode) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	barsHeight := b.getBarsHeight(provider, cell)
+	if b.prop.HumanReadable == nil {
+		return barsHeight
+	}
+
+	return barsHeight + getBarcodeTextHeight(provider, b.prop.HumanReadable)
+}
+
+// getBarsHeight returns the height of the bars without the human readable text.
+func (b *Barcode) getBarsHeight(provider core.Provider, cell *entity.Cell) float64 {
+	if b.prop.ModuleWidth > 0 {
+		if b.prop.Height > 0 {
+			return b.prop.Top + b.prop.Height
+		}
+
+		// When the modules can't be read the proportional height is used, so the row has room for the error.
+		dimensions, err := provider.GetDimensionsByBarCode(b.code, &b.prop)
+		if err == nil {
+			return b.prop.Top + b.prop.GetModuleHeight(dimensions.Width)
+		}
+	}
+
+	var layout *eanLayout
+	if b.prop.HumanReadable != nil && b.prop.HumanReadable.EANLayout {
+		layout = getEANLayout(b.prop.Type, codegen.GetHumanReadable(b.getValue(), b.prop.Type))
+	}
+
 	proportion := b.prop.Proportion.Height / b.prop.Proportion.Width
-	width := (b.prop.Percent / 100) * cell.Width
-	return proportion * width
+	return proportion * getBarsWidth(&b.prop, layout, cell.Width, 0)
 }
 
 // SetConfig sets the configuration of a Barcode.
 func (b *Barcode) SetConfig(config *entity.Config) {
 	b.config = config
+
+	if b.prop.HumanReadable != nil && config != nil && config.DefaultFont != nil {
+		text := *b.prop.HumanReadable
+		text.MakeValid(config.DefaultFont)
+		b.prop.HumanReadable = &text
+	}
+}
+
+func (b *Barcode) getValue() string {
+	if b.value != "" {
+		return b.value
+	}
+
+	return b.code
 }
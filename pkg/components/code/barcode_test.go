@@ -2,14 +2,19 @@
 package code_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/johnfercher/maroto/v2/internal/fixture"
 	"github.com/johnfercher/maroto/v2/mocks"
 	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/johnfercher/maroto/v2/pkg/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNewBar(t *testing.T) {
@@ -27,6 +32,18 @@ func TestNewBar(t *testing.T) {
 		// Assert
 		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_bar_custom_prop.json")
 	})
+	t.Run("when human readable is sent, should use the provided", func(t *testing.T) {
+		// Arrange
+		prop := fixture.BarcodeProp()
+		prop.Type = barcode.EAN
+		prop.HumanReadable = &props.BarcodeText{Size: 8, Top: 1, EANLayout: true}
+
+		// Act
+		sut := code.NewBar("789123456789", prop)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_bar_human_readable_prop.json")
+	})
 }
 
 func TestNewBarCol(t *testing.T) {
@@ -97,6 +114,97 @@ func TestBarcode_Render(t *testing.T) {
 		// Assert
 		provider.AssertNumberOfCalls(t, "AddBarCode", 1)
 	})
+	t.Run("when human readable is sent, should render the text below the bars", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.Barcode{
+			Percent:       100,
+			Proportion:    props.Proportion{Width: 10, Height: 2},
+			HumanReadable: &props.BarcodeText{Top: 1},
+		}
+		sut := code.NewBar("code", prop)
+
+		barsProp := prop
+		barsProp.MakeValid()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(&props.Font{}).Return(4)
+		provider.EXPECT().AddBarCode("code", &entity.Cell{X: 10, Y: 15, Width: 100, Height: 20}, &barsProp)
+		provider.EXPECT().AddText("code", &entity.Cell{X: 10, Y: 35, Width: 100, Height: 5}, &props.Text{Top: 1, Align: align.Center})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddBarCode", 1)
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when ean layout is sent, should split the digits with the check digit", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.Barcode{
+			Type:          barcode.EAN,
+			Proportion:    props.Proportion{Width: 10, Height: 2},
+			HumanReadable: &props.BarcodeText{EANLayout: true},
+		}
+		sut := code.NewBar("789123456789", prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4)
+		provider.EXPECT().AddBarCode("789123456789", mock.Anything, mock.Anything)
+		provider.EXPECT().AddText("7", mock.Anything, mock.Anything)
+		provider.EXPECT().AddText("891234", mock.Anything, mock.Anything)
+		provider.EXPECT().AddText("567895", mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 3)
+	})
+	t.Run("when module width is sent, should render the text below the bars with the quiet zone", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.Barcode{ModuleWidth: 0.5, Height: 10, QuietZone: 10, HumanReadable: &props.BarcodeText{}}
+		sut := code.NewBar("code", prop)
+
+		validProp := prop
+		validProp.MakeValid()
+		barsProp := validProp
+		barsProp.QuietZone = 0
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4)
+		provider.EXPECT().GetDimensionsByBarCode("code", &validProp).Return(&entity.Dimensions{Width: 100, Height: 20}, nil)
+		provider.EXPECT().AddBarCode("code", &entity.Cell{X: 15, Y: 15, Width: 50, Height: 10}, &barsProp)
+		provider.EXPECT().AddText("code", &entity.Cell{X: 15, Y: 25, Width: 50, Height: 4}, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when module width is sent and column is too narrow, should let provider report it", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.Barcode{ModuleWidth: 1, QuietZone: 10, HumanReadable: &props.BarcodeText{}}
+		sut := code.NewBar("code", prop)
+
+		validProp := prop
+		validProp.MakeValid()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4)
+		provider.EXPECT().GetDimensionsByBarCode("code", &validProp).Return(&entity.Dimensions{Width: 100, Height: 20}, nil)
+		provider.EXPECT().AddBarCode("code", &cell, &validProp)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 0)
+	})
 }
 
 func TestBarcode_SetConfig(t *testing.T) {
@@ -121,4 +229,72 @@ func TestBarcode_GetHeight(t *testing.T) {
 		height := sut.GetHeight(provider, &cell)
 		assert.Equal(t, height, cell.Width*0.2)
 	})
+	t.Run("When human readable is sent, should add the text height", func(t *testing.T) {
+		cell := fixture.CellEntity()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4)
+
+		sut := code.NewBar("code", props.Barcode{
+			Proportion:    props.Proportion{Width: 10.0, Height: 2.0},
+			Percent:       100.0,
+			HumanReadable: &props.BarcodeText{Top: 1},
+		})
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+		assert.Equal(t, cell.Width*0.2+5, height)
+	})
+	t.Run("When module width and height are sent, should return the height", func(t *testing.T) {
+		cell := fixture.CellEntity()
+
+		provider := mocks.NewProvider(t)
+
+		sut := code.NewBar("code", props.Barcode{ModuleWidth: 0.33, Height: 22.85})
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+		assert.Equal(t, 22.85, height)
+	})
+	t.Run("When only module width is sent, should use the proportion of the bars width", func(t *testing.T) {
+		cell := fixture.CellEntity()
+		prop := props.Barcode{ModuleWidth: 0.5, Proportion: props.Proportion{Width: 10, Height: 2}}
+		validProp := prop
+		validProp.MakeValid()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByBarCode("code", &validProp).Return(&entity.Dimensions{Width: 100, Height: 20}, nil)
+
+		sut := code.NewBar("code", prop)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+		assert.Equal(t, 10.0, height)
+	})
+	t.Run("When module width and top are sent, should add the top to the height", func(t *testing.T) {
+		cell := fixture.CellEntity()
+
+		provider := mocks.NewProvider(t)
+
+		sut := code.NewBar("code", props.Barcode{ModuleWidth: 0.33, Height: 20, Top: 5})
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+		assert.Equal(t, 25.0, height)
+	})
+	t.Run("When module width is sent and modules can't be read, should use the proportional height", func(t *testing.T) {
+		cell := fixture.CellEntity()
+		prop := props.Barcode{ModuleWidth: 0.5, Proportion: props.Proportion{Width: 10, Height: 2}, Percent: 100}
+		validProp := prop
+		validProp.MakeValid()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByBarCode("code", &validProp).Return(nil, errors.New("invalid code"))
+
+		sut := code.NewBar("code", prop)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+		assert.Equal(t, cell.Width*0.2, height)
+	})
 }
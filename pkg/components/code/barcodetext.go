@@ -0,0 +1,183 @@
+package code
+
+import (
+	codegen "github.com/johnfercher/maroto/v2/internal/code"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// eanGroup is a group of digits printed between two modules, modules before the bars are negative.
+type eanGroup struct {
+	from, to   int
+	start, end float64
+}
+
+// eanLayout describes how the digits of an EAN or UPC code are printed, left and right are the
+// modules reserved outside the bars for the number system and the check digit.
+type eanLayout struct {
+	modules, left, right float64
+	groups               []eanGroup
+}
+
+var (
+	ean13Layout = &eanLayout{modules: 95, left: 7, groups: []eanGroup{
+		{from: 0, to: 1, start: -7, end: 0}, {from: 1, to: 7, start: 3, end: 45}, {from: 7, to: 13, start: 50, end: 92},
+	}}
+	ean8Layout = &eanLayout{modules: 67, groups: []eanGroup{
+		{from: 0, to: 4, start: 3, end: 31}, {from: 4, to: 8, start: 36, end: 64},
+	}}
+	upcaLayout = &eanLayout{modules: 95, left: 7, right: 7, groups: []eanGroup{
+		{from: 0, to: 1, start: -7, end: 0}, {from: 1, to: 6, start: 10, end: 45},
+		{from: 6, to: 11, start: 50, end: 85}, {from: 11, to: 12, start: 95, end: 102},
+	}}
+	upceLayout = &eanLayout{modules: 51, left: 7, right: 7, groups: []eanGroup{
+		{from: 0, to: 1, start: -7, end: 0}, {from: 1, to: 7, start: 3, end: 45}, {from: 7, to: 8, start: 51, end: 58},
+	}}
+)
+
+// getEANLayout returns the layout of the digits, or nil when the code is not an EAN or UPC with all its digits.
+func getEANLayout(barcodeType barcode.Type, digits string) *eanLayout {
+	switch {
+	case barcodeType == barcode.EAN && len(digits) == 13:
+		return ean13Layout
+	case (barcodeType == barcode.EAN || barcodeType == barcode.EAN8) && len(digits) == 8:
+		return ean8Layout
+	case barcodeType == barcode.UPCA && len(digits) == 12:
+		return upcaLayout
+	case barcodeType == barcode.UPCE && len(digits) == 8:
+		return upceLayout
+	default:
+		return nil
+	}
+}
+
+// getBarcodeTextHeight returns the height of the human readable text with the space above it.
+func getBarcodeTextHeight(provider core.Provider, text *props.BarcodeText) float64 {
+	return text.Top + provider.GetFontHeight(text.ToFontProp())
+}
+
+// getBarsWidth returns the width of the bars, limited by the cell width and, when the height is known,
+// by the cell height without the text. The width reserved for the digits outside the bars is discounted.
+func getBarsWidth(prop *props.Barcode, layout *eanLayout, width, height float64) float64 {
+	percent := prop.Percent / 100
+	proportion := prop.Proportion.Height / prop.Proportion.Width
+
+	barsWidth := width * percent
+	if layout != nil {
+		barsWidth *= layout.modules / (layout.left + layout.modules + layout.right)
+	}
+
+	if height > 0 && barsWidth*proportion > height*percent {
+		barsWidth = height * percent / proportion
+	}
+
+	return barsWidth
+}
+
+// renderWithText renders the bars and the human readable code as one block, the text is aligned with the bars
+// instead of the cell, so the digits stay below the bars whatever the size or the alignment of the barcode.
+func (b *Barcode) renderWithText(provider core.Provider, cell *entity.Cell) {
+	text := b.prop.HumanReadable
+	digits := codegen.GetHumanReadable(b.getValue(), b.prop.Type)
+	textHeight := getBarcodeTextHeight(provider, text)
+
+	var layout *eanLayout
+	if text.EANLayout {
+		layout = getEANLayout(b.prop.Type, digits)
+	}
+
+	getBarsCell := b.getScaledBarsCell
+	if b.prop.ModuleWidth > 0 {
+		getBarsCell = b.getModuleBarsCell
+	}
+
+	barsCell, barsProp, ok := getBarsCell(provider, cell, layout, textHeight)
+	if !ok {
+		// The provider reports why the bars do not fit in the cell.
+		provider.AddBarCode(b.code, cell, &b.prop)
+		return
+	}
+	provider.AddBarCode(b.code, barsCell, barsProp)
+
+	if layout == nil {
+		textCell := &entity.Cell{X: barsCell.X, Y: barsCell.Y + barsCell.Height, Width: barsCell.Width, Height: textHeight}
+		provider.AddText(digits, textCell, text.ToTextProp())
+		return
+	}
+
+	moduleWidth := barsCell.Width / layout.modules
+	textProp := text.ToTextProp()
+	textProp.Align = align.Center
+	for _, group := range layout.groups {
+		textCell := &entity.Cell{
+			X:      barsCell.X + group.start*moduleWidth,
+			Y:      barsCell.Y + barsCell.Height,
+			Width:  (group.end - group.start) * moduleWidth,
+			Height: textHeight,
+		}
+		provider.AddText(digits[group.from:group.to], textCell, textProp)
+	}
+}
+
+// getScaledBarsCell returns the area of the bars scaled to the cell, with the prop to draw them exactly in that area.
+func (b *Barcode) getScaledBarsCell(_ core.Provider, cell *entity.Cell, layout *eanLayout,
+	textHeight float64,
+) (*entity.Cell, *props.Barcode, bool) {
+	barsWidth := getBarsWidth(&b.prop, layout, cell.Width, cell.Height-textHeight)
+	barsHeight := barsWidth * b.prop.Proportion.Height / b.prop.Proportion.Width
+
+	left, right := 0.0, 0.0
+	if layout != nil {
+		moduleWidth := barsWidth / layout.modules
+		left, right = layout.left*moduleWidth, layout.right*moduleWidth
+	}
+
+	x, y := b.prop.Left, b.prop.Top
+	if b.prop.Center {
+		x = (cell.Width - left - barsWidth - right) / 2
+		y = (cell.Height - barsHeight - textHeight) / 2
+	}
+
+	barsProp := b.prop
+	barsProp.Left, barsProp.Top, barsProp.Percent, barsProp.Center = 0, 0, 100, false
+
+	return &entity.Cell{X: cell.X + x + left, Y: cell.Y + y, Width: barsWidth, Height: barsHeight}, &barsProp, true
+}
+
+// getModuleBarsCell returns the area of the bars sized by module width, the digits of the EAN layout are printed
+// in the quiet zones. It returns false when the bars with the quiet zones do not fit in the cell.
+func (b *Barcode) getModuleBarsCell(provider core.Provider, cell *entity.Cell, _ *eanLayout,
+	textHeight float64,
+) (*entity.Cell, *props.Barcode, bool) {
+	dimensions, err := provider.GetDimensionsByBarCode(b.code, &b.prop)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	barsWidth := dimensions.Width * b.prop.ModuleWidth
+	barsHeight := b.prop.GetModuleHeight(dimensions.Width)
+	quietZone := b.prop.QuietZone * b.prop.ModuleWidth
+	needed := barsWidth + 2*quietZone
+	if !b.prop.Center {
+		needed += b.prop.Left
+	}
+
+	if needed > cell.Width {
+		return nil, nil, false
+	}
+
+	x, y := b.prop.Left+quietZone, b.prop.Top
+	if b.prop.Center {
+		x = (cell.Width - barsWidth) / 2
+		y = (cell.Height - barsHeight - textHeight) / 2
+	}
+
+	barsProp := b.prop
+	barsProp.Left, barsProp.Top, barsProp.QuietZone, barsProp.Center = 0, 0, 0, false
+	barsProp.Height = barsHeight
+
+	return &entity.Cell{X: cell.X + x, Y: cell.Y + y, Width: barsWidth, Height: barsHeight}, &barsProp, true
+}
@@ -0,0 +1,61 @@
+package code
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// The FEBRABAN layout prints the bars of a boleto with 103 mm of width and 13 mm of height.
+const (
+	boletoModuleWidth = 0.254
+	boletoHeight      = 13.0
+	boletoQuietZone   = 10.0
+)
+
+// NewBoleto is responsible to create an instance of a boleto Barcode, the digitable line is printed below
+// the bars when the human readable text is not sent. When neither Percent nor ModuleWidth are sent,
+// the bars have the FEBRABAN size.
+//   - code: The 44 digits of the boleto, which can be built with payload.Boleto
+//   - ps: A set of settings that must be applied to the barcode, the type is always barcode.Boleto
+func NewBoleto(code string, ps ...props.Barcode) core.Component {
+	prop := props.Barcode{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+
+	prop.Type = barcode.Boleto
+	if prop.HumanReadable == nil {
+		prop.HumanReadable = &props.BarcodeText{}
+	}
+
+	if prop.Percent == 0 && prop.ModuleWidth == 0 {
+		prop.ModuleWidth = boletoModuleWidth
+		prop.Height = boletoHeight
+		prop.QuietZone = boletoQuietZone
+	}
+
+	return NewBar(code, prop)
+}
+
+// NewBoletoCol is responsible to create an instance of a boleto Barcode wrapped in a Col.
+func NewBoletoCol(size int, code string, ps ...props.Barcode) core.Col {
+	bar := NewBoleto(code, ps...)
+	return col.New(size).Add(bar)
+}
+
+// NewBoletoRow is responsible to create an instance of a boleto Barcode wrapped in a Row.
+func NewBoletoRow(height float64, code string, ps ...props.Barcode) core.Row {
+	bar := NewBoleto(code, ps...)
+	c := col.New().Add(bar)
+	return row.New(height).Add(c)
+}
+
+// NewAutoBoletoRow is responsible to create an instance of a boleto Barcode wrapped in a Row with automatic height.
+func NewAutoBoletoRow(code string, ps ...props.Barcode) core.Row {
+	bar := NewBoleto(code, ps...)
+	c := col.New().Add(bar)
+	return row.New().Add(c)
+}
@@ -0,0 +1,83 @@
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+const boletoCode = "00193373700000001000500940144816060680935031"
+
+func TestNewBoleto(t *testing.T) {
+	t.Run("when prop is not sent, should use the febraban size with the digitable line", func(t *testing.T) {
+		// Act
+		sut := code.NewBoleto(boletoCode)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_boleto_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewBoleto(boletoCode, fixture.BarcodeProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_boleto_custom_prop.json")
+	})
+}
+
+func TestNewBoletoCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewBoletoCol(12, boletoCode)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_boleto_col_default_prop.json")
+	})
+}
+
+func TestNewBoletoRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewBoletoRow(20, boletoCode)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_boleto_row_default_prop.json")
+	})
+}
+
+func TestNewAutoBoletoRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoBoletoRow(boletoCode)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_auto_boleto_row_default_prop.json")
+	})
+}
+
+func TestBoleto_Render(t *testing.T) {
+	t.Run("should render the digitable line below the bars", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{Width: 170, Height: 30}
+		sut := code.NewBoleto(boletoCode)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4)
+		provider.EXPECT().GetDimensionsByBarCode(boletoCode, mock.Anything).Return(&entity.Dimensions{Width: 404, Height: 1}, nil)
+		provider.EXPECT().AddBarCode(boletoCode, mock.Anything, mock.Anything)
+		provider.EXPECT().AddText("00190.50095 40144.816069 06809.350314 3 37370000000100", mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddBarCode", 1)
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+}
@@ -2,6 +2,8 @@ package code_test
 
 import (
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/consts/qrlevel"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 
 	"github.com/johnfercher/maroto/v2"
@@ -43,7 +45,24 @@ func ExampleNewBarRow() {
 func ExampleNewQr() {
 	m := maroto.New()
 
-	qrCode := code.NewQr("123456789", props.Rect{Percent: 70.5})
+	qrCode := code.NewQr("123456789", props.QrCode{Percent: 70.5})
+	col := col.New(6).Add(qrCode)
+	m.AddRow(10, col)
+
+	// generate document
+}
+
+// ExampleNewQr_options demonstrates how to generate a qrcode with error correction, quiet zone and colors.
+func ExampleNewQr_options() {
+	m := maroto.New()
+
+	qrCode := code.NewQr("123456789", props.QrCode{
+		Percent:         70.5,
+		ErrorCorrection: qrlevel.Q,
+		QuietZone:       4,
+		Color:           &props.BlueColor,
+		BackgroundColor: &props.WhiteColor,
+	})
 	col := col.New(6).Add(qrCode)
 	m.AddRow(10, col)
 
@@ -54,7 +73,7 @@ func ExampleNewQr() {
 func ExampleNewQrCol() {
 	m := maroto.New()
 
-	qrCodeCol := code.NewQrCol(12, "123456789", props.Rect{Percent: 70.5})
+	qrCodeCol := code.NewQrCol(12, "123456789", props.QrCode{Percent: 70.5})
 	m.AddRow(10, qrCodeCol)
 
 	// generate document
@@ -64,7 +83,7 @@ func ExampleNewQrCol() {
 func ExampleNewQrRow() {
 	m := maroto.New()
 
-	qrCodeRow := code.NewQrRow(10, "123456789", props.Rect{Percent: 70.5})
+	qrCodeRow := code.NewQrRow(10, "123456789", props.QrCode{Percent: 70.5})
 	m.AddRows(qrCodeRow)
 
 	// generate document
@@ -81,6 +100,17 @@ func ExampleNewMatrix() {
 	// generate document
 }
 
+// ExampleNewMatrix_vector demonstrates how to draw a matrixcode with vector rectangles, which is sharp at any size.
+func ExampleNewMatrix_vector() {
+	m := maroto.New()
+
+	matrixCode := code.NewMatrix("123456789", props.Rect{Percent: 70.5, Vector: true})
+	col := col.New(6).Add(matrixCode)
+	m.AddRow(10, col)
+
+	// generate document
+}
+
 // ExampleNewMatrixCol demonstrates how to generate a column with a matrixcode and add it to maroto.
 func ExampleNewMatrixCol() {
 	m := maroto.New()
@@ -100,3 +130,95 @@ func ExampleNewMatrixRow() {
 
 	// generate document
 }
+
+// ExampleNewPDF417 demonstrates how to generate a pdf417 and add it to maroto.
+func ExampleNewPDF417() {
+	m := maroto.New()
+
+	pdf417 := code.NewPDF417("123456789", props.PDF417{Percent: 70.5, ErrorCorrectionLevel: 4})
+	col := col.New(6).Add(pdf417)
+	m.AddRow(10, col)
+
+	// generate document
+}
+
+// ExampleNewPDF417Col demonstrates how to generate a column with a pdf417 and add it to maroto.
+func ExampleNewPDF417Col() {
+	m := maroto.New()
+
+	pdf417Col := code.NewPDF417Col(12, "123456789", props.PDF417{Percent: 70.5})
+	m.AddRow(10, pdf417Col)
+
+	// generate document
+}
+
+// ExampleNewPDF417Row demonstrates how to generate a row with a pdf417 and add it to maroto.
+func ExampleNewPDF417Row() {
+	m := maroto.New()
+
+	pdf417Row := code.NewPDF417Row(10, "123456789", props.PDF417{Percent: 70.5})
+	m.AddRows(pdf417Row)
+
+	// generate document
+}
+
+// ExampleNewAztec demonstrates how to generate an aztec and add it to maroto.
+func ExampleNewAztec() {
+	m := maroto.New()
+
+	aztec := code.NewAztec("123456789", props.Aztec{Percent: 70.5, ErrorCorrectionPercent: 33})
+	col := col.New(6).Add(aztec)
+	m.AddRow(10, col)
+
+	// generate document
+}
+
+// ExampleNewAztecCol demonstrates how to generate a column with an aztec and add it to maroto.
+func ExampleNewAztecCol() {
+	m := maroto.New()
+
+	aztecCol := code.NewAztecCol(12, "123456789", props.Aztec{Percent: 70.5})
+	m.AddRow(10, aztecCol)
+
+	// generate document
+}
+
+// ExampleNewAztecRow demonstrates how to generate a row with an aztec and add it to maroto.
+func ExampleNewAztecRow() {
+	m := maroto.New()
+
+	aztecRow := code.NewAztecRow(10, "123456789", props.Aztec{Percent: 70.5})
+	m.AddRows(aztecRow)
+
+	// generate document
+}
+
+// ExampleNewGS1Bar demonstrates how to generate a GS1-128 barcode with application identifiers and add it to maroto.
+func ExampleNewGS1Bar() {
+	m := maroto.New()
+
+	gs1 := code.NewGS1Bar([]entity.GS1Element{
+		{AI: "01", Value: "09501101530003"},
+		{AI: "17", Value: "260131"},
+		{AI: "10", Value: "AB12"},
+	}, props.Barcode{Percent: 90})
+	col := col.New(8).Add(gs1)
+	m.AddRow(20, col)
+
+	// generate document
+}
+
+// ExampleNewGS1Matrix demonstrates how to generate a GS1 DataMatrix with application identifiers and add it to maroto.
+func ExampleNewGS1Matrix() {
+	m := maroto.New()
+
+	gs1 := code.NewGS1Matrix([]entity.GS1Element{
+		{AI: "01", Value: "09501101530003"},
+		{AI: "17", Value: "260131"},
+		{AI: "10", Value: "AB12"},
+	}, props.Rect{Percent: 70})
+	col := col.New(4).Add(gs1)
+	m.AddAutoRow(col)
+
+	// generate document
+}
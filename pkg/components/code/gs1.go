@@ -0,0 +1,132 @@
+package code
+
+import (
+	"strings"
+
+	codegen "github.com/johnfercher/maroto/v2/internal/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// gs1CaptionSize is the font size of the human readable text printed below a GS1 DataMatrix.
+const gs1CaptionSize = 8.0
+
+// NewGS1Bar is responsible to create an instance of a GS1-128 Barcode, the application identifiers are printed
+// in parentheses below the bars when the human readable text is not sent.
+//   - elements: The application identifiers and values that must be placed in the barcode
+//   - ps: A set of settings that must be applied to the barcode, the type is always barcode.GS1128
+func NewGS1Bar(elements []entity.GS1Element, ps ...props.Barcode) core.Component {
+	prop := props.Barcode{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+
+	prop.Type = barcode.GS1128
+	if prop.HumanReadable == nil {
+		prop.HumanReadable = &props.BarcodeText{}
+	}
+	prop.MakeValid()
+
+	value := getGS1Code(elements)
+	data, err := codegen.GetGS1Data(elements)
+	if err != nil {
+		return newGS1Error("barcode", value, err)
+	}
+
+	return &Barcode{
+		code:  data,
+		value: value,
+		prop:  prop,
+	}
+}
+
+// NewGS1BarCol is responsible to create an instance of a GS1-128 Barcode wrapped in a Col.
+func NewGS1BarCol(size int, elements []entity.GS1Element, ps ...props.Barcode) core.Col {
+	bar := NewGS1Bar(elements, ps...)
+	return col.New(size).Add(bar)
+}
+
+// NewGS1BarRow is responsible to create an instance of a GS1-128 Barcode wrapped in a Row.
+func NewGS1BarRow(height float64, elements []entity.GS1Element, ps ...props.Barcode) core.Row {
+	bar := NewGS1Bar(elements, ps...)
+	c := col.New().Add(bar)
+	return row.New(height).Add(c)
+}
+
+// NewAutoGS1BarRow is responsible to create an instance of a GS1-128 Barcode wrapped in a Row with automatic height.
+func NewAutoGS1BarRow(elements []entity.GS1Element, ps ...props.Barcode) core.Row {
+	bar := NewGS1Bar(elements, ps...)
+	c := col.New().Add(bar)
+	return row.New().Add(c)
+}
+
+// NewGS1Matrix is responsible to create an instance of a GS1 DataMatrix, the application identifiers are printed
+// in parentheses below the code when the caption is not sent.
+//   - elements: The application identifiers and values that must be placed in the matrixcode
+//   - ps: A set of settings that must be applied to the matrixcode
+func NewGS1Matrix(elements []entity.GS1Element, ps ...props.Rect) core.Component {
+	prop := props.Rect{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+
+	value := getGS1Code(elements)
+	data, err := codegen.GetGS1Data(elements)
+	if err != nil {
+		return newGS1Error("matrixcode", value, err)
+	}
+
+	prop.GS1 = true
+	if prop.Caption == nil {
+		prop.Caption = &props.Caption{Value: value, Prop: props.Text{Size: gs1CaptionSize, Align: align.Center}}
+	}
+	prop.MakeValid()
+
+	return &MatrixCode{
+		code:  data,
+		value: value,
+		prop:  prop,
+	}
+}
+
+// NewGS1MatrixCol is responsible to create an instance of a GS1 DataMatrix wrapped in a Col.
+func NewGS1MatrixCol(size int, elements []entity.GS1Element, ps ...props.Rect) core.Col {
+	matrixCode := NewGS1Matrix(elements, ps...)
+	return col.New(size).Add(matrixCode)
+}
+
+// NewGS1MatrixRow is responsible to create an instance of a GS1 DataMatrix wrapped in a Row.
+func NewGS1MatrixRow(height float64, elements []entity.GS1Element, ps ...props.Rect) core.Row {
+	matrixCode := NewGS1Matrix(elements, ps...)
+	c := col.New().Add(matrixCode)
+	return row.New(height).Add(c)
+}
+
+// NewAutoGS1MatrixRow is responsible to create an instance of a GS1 DataMatrix wrapped in a Row with automatic height.
+func NewAutoGS1MatrixRow(elements []entity.GS1Element, ps ...props.Rect) core.Row {
+	matrixCode := NewGS1Matrix(elements, ps...)
+	c := col.New().Add(matrixCode)
+	return row.New().Add(c)
+}
+
+// ValidateGS1 checks the application identifiers and the values of a GS1 code, the GS1 components
+// render this error in place of the code when the elements are invalid.
+func ValidateGS1(elements []entity.GS1Element) error {
+	_, err := codegen.GetGS1Data(elements)
+	return err
+}
+
+// getGS1Code joins the elements with the application identifiers in parentheses, which is the human readable text.
+func getGS1Code(elements []entity.GS1Element) string {
+	var code strings.Builder
+	for _, element := range elements {
+		code.WriteString(element.ToString())
+	}
+
+	return code.String()
+}
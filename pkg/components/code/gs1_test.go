@@ -0,0 +1,284 @@
+package code_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewGS1Bar(t *testing.T) {
+	t.Run("when prop is not sent, should use gs1128 with human readable text", func(t *testing.T) {
+		// Act
+		sut := code.NewGS1Bar(fixtureGS1Elements())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_bar_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided with gs1128 type", func(t *testing.T) {
+		// Act
+		sut := code.NewGS1Bar(fixtureGS1Elements(), fixture.BarcodeProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_bar_custom_prop.json")
+	})
+}
+
+func TestNewGS1BarCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewGS1BarCol(12, fixtureGS1Elements())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_bar_col_default_prop.json")
+	})
+}
+
+func TestNewGS1BarRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewGS1BarRow(10, fixtureGS1Elements())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_bar_row_default_prop.json")
+	})
+}
+
+func TestNewAutoGS1BarRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoGS1BarRow(fixtureGS1Elements())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_auto_gs1_bar_row_default_prop.json")
+	})
+}
+
+func TestNewGS1Matrix(t *testing.T) {
+	t.Run("when prop is not sent, should use gs1 with the human readable caption", func(t *testing.T) {
+		// Act
+		sut := code.NewGS1Matrix(fixtureGS1Elements())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_matrix_default_prop.json")
+	})
+	t.Run("when caption is sent, should use the provided", func(t *testing.T) {
+		// Arrange
+		prop := fixture.RectProp()
+		prop.Caption = &props.Caption{Value: "Batch AB12"}
+
+		// Act
+		sut := code.NewGS1Matrix(fixtureGS1Elements(), prop)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_matrix_custom_prop.json")
+	})
+}
+
+func TestNewGS1MatrixCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewGS1MatrixCol(12, fixtureGS1Elements())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_matrix_col_default_prop.json")
+	})
+}
+
+func TestNewGS1MatrixRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewGS1MatrixRow(10, fixtureGS1Elements())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_gs1_matrix_row_default_prop.json")
+	})
+}
+
+func TestNewAutoGS1MatrixRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoGS1MatrixRow(fixtureGS1Elements())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_auto_gs1_matrix_row_default_prop.json")
+	})
+}
+
+func TestGS1Bar_Render(t *testing.T) {
+	t.Run("should render the application identifiers in parentheses below the bars", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := props.Barcode{Percent: 100, Proportion: props.Proportion{Width: 10, Height: 2}}
+		sut := code.NewGS1Bar(fixtureGS1Elements(), prop)
+
+		barsProp := prop
+		barsProp.Type = barcode.GS1128
+		barsProp.HumanReadable = &props.BarcodeText{}
+		barsProp.MakeValid()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(&props.Font{}).Return(4)
+		provider.EXPECT().AddBarCode(gs1Data, &entity.Cell{X: 10, Y: 15, Width: 100, Height: 20}, &barsProp)
+		provider.EXPECT().AddText(gs1Code, &entity.Cell{X: 10, Y: 35, Width: 100, Height: 4}, &props.Text{Align: align.Center})
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddBarCode", 1)
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when value has parentheses, should send the value unchanged to the encoder", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		elements := []entity.GS1Element{{AI: "10", Value: "AB(21)12"}}
+		sut := code.NewGS1Bar(elements, props.Barcode{Percent: 100, Proportion: props.Proportion{Width: 10, Height: 2}})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(&props.Font{}).Return(4)
+		provider.EXPECT().AddBarCode("\u00f110AB(21)12", mock.Anything, mock.Anything)
+		provider.EXPECT().AddText("(10)AB(21)12", mock.Anything, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddBarCode", 1)
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+	t.Run("when elements are invalid, should render the validation error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		elements := []entity.GS1Element{{AI: "01", Value: "09501101530004"}}
+		sut := code.NewGS1Bar(elements)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("gs1 (01) check digit does not match", &cell, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+}
+
+func TestGS1Matrix_Render(t *testing.T) {
+	t.Run("should render the caption below the matrix code", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := code.NewGS1Matrix(fixtureGS1Elements())
+
+		captionProp := props.Text{Size: 8, Align: align.Center}
+		codeProp := props.Rect{GS1: true, Caption: &props.Caption{Value: gs1Code, Prop: captionProp}}
+		codeProp.MakeValid()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetLinesQuantity(gs1Code, &captionProp, cell.Width).Return(1)
+		provider.EXPECT().GetFontHeight(&props.Font{Size: 8}).Return(3)
+		provider.EXPECT().AddMatrixCode(gs1Data, &entity.Cell{X: 10, Y: 15, Width: 100, Height: 147}, &codeProp)
+		provider.EXPECT().AddText(gs1Code, &entity.Cell{X: 10, Y: 162, Width: 100, Height: 3}, &captionProp)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddMatrixCode", 1)
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+}
+
+func TestGS1Matrix_Render_Invalid(t *testing.T) {
+	t.Run("when elements are invalid, should render the validation error", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		elements := []entity.GS1Element{{AI: "17", Value: "261331"}}
+		sut := code.NewGS1Matrix(elements)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddText("gs1 (17) must be a date in the format YYMMDD", &cell, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+		assert.Equal(t, "gs1 (17) must be a date in the format YYMMDD",
+			sut.GetStructure().GetData().Details["error"])
+	})
+}
+
+func TestValidateGS1(t *testing.T) {
+	invalid := map[string][]entity.GS1Element{
+		"gs1 code must have at least one application identifier": nil,
+		"gs1 application identifier (88) is not supported":       {{AI: "88", Value: "123"}},
+		"gs1 (01) must have 14 characters":                       {{AI: "01", Value: "0950110153000"}},
+		"gs1 (01) check digit does not match":                    {{AI: "01", Value: "09501101530004"}},
+		"gs1 (17) must be a date in the format YYMMDD":           {{AI: "17", Value: "261331"}},
+		"gs1 (10) must have from 1 to 20 characters":             {{AI: "10", Value: "ABCDEFGHIJKLMNOPQRSTU"}},
+		"gs1 (10) has characters outside the GS1 character set":  {{AI: "10", Value: "AB 12"}},
+		"gs1 (3103) only accepts digits":                         {{AI: "3103", Value: "00A500"}},
+	}
+	for message, elements := range invalid {
+		t.Run("when elements are invalid, should return "+message, func(t *testing.T) {
+			// Act
+			err := code.ValidateGS1(elements)
+
+			// Assert
+			assert.EqualError(t, err, message)
+		})
+	}
+	t.Run("when elements are valid, should not return error", func(t *testing.T) {
+		// Act
+		err := code.ValidateGS1(fixtureGS1Elements())
+
+		// Assert
+		assert.Nil(t, err)
+	})
+}
+
+func TestGS1Matrix_GetHeight(t *testing.T) {
+	t.Run("should add the caption height to the matrix code height", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := code.NewGS1Matrix(fixtureGS1Elements())
+
+		captionProp := props.Text{Size: 8, Align: align.Center}
+		codeProp := props.Rect{GS1: true, Caption: &props.Caption{Value: gs1Code, Prop: captionProp}}
+		codeProp.MakeValid()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByMatrixCode(gs1Data, &codeProp).Return(&entity.Dimensions{Width: 18, Height: 18}, nil)
+		provider.EXPECT().GetLinesQuantity(gs1Code, &captionProp, cell.Width).Return(1)
+		provider.EXPECT().GetFontHeight(&props.Font{Size: 8}).Return(3)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, cell.Width+3, height)
+	})
+}
+
+const (
+	gs1Code = "(01)09501101530003(17)260131(10)AB12"
+	// gs1Data is the data sent to the encoders, it starts with FNC1 and has FNC1 after the variable length values.
+	gs1Data = "\u00f101095011015300031726013110AB12"
+)
+
+func fixtureGS1Elements() []entity.GS1Element {
+	return []entity.GS1Element{
+		{AI: "01", Value: "09501101530003"},
+		{AI: "17", Value: "260131"},
+		{AI: "10", Value: "AB12"},
+	}
+}
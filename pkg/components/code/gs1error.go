@@ -0,0 +1,54 @@
+package code
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/internal/merror"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// gs1Error is rendered in place of a GS1 code with invalid elements, it prints the validation error,
+// so the reason is shown instead of a generic message.
+type gs1Error struct {
+	codeType string
+	value    string
+	err      error
+	config   *entity.Config
+}
+
+func newGS1Error(codeType, value string, err error) core.Component {
+	return &gs1Error{
+		codeType: codeType,
+		value:    value,
+		err:      err,
+	}
+}
+
+// Render prints the validation error in the cell.
+func (g *gs1Error) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddText(g.err.Error(), cell, merror.DefaultErrorText)
+}
+
+// GetStructure returns the structure of the code with the validation error.
+func (g *gs1Error) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    g.codeType,
+		Value:   g.value,
+		Details: map[string]interface{}{"error": g.err.Error()},
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height of the error text.
+func (g *gs1Error) GetHeight(provider core.Provider, _ *entity.Cell) float64 {
+	errorText := merror.DefaultErrorText
+	return provider.GetFontHeight(&props.Font{Family: errorText.Family, Style: errorText.Style, Size: errorText.Size})
+}
+
+// SetConfig sets the configuration of the code.
+func (g *gs1Error) SetConfig(config *entity.Config) {
+	g.config = config
+}
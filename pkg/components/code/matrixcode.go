@@ -1,10 +1,11 @@
-// Package code implements creation of Barcode, MatrixCode and QrCode.
+// Package code implements creation of Barcode, MatrixCode, QrCode, PDF417 and Aztec.
 // nolint:dupl
 package code
 
 import (
 	"github.com/johnfercher/go-tree/node"
 
+	"github.com/johnfercher/maroto/v2/internal/caption"
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
 	"github.com/johnfercher/maroto/v2/pkg/core"
@@ -13,7 +14,9 @@ import (
 )
 
 type MatrixCode struct {
-	code   string
+	code string
+	// value is the code shown in the structure, it is empty when it is the code itself.
+	value  string
 	prop   props.Rect
 	config *entity.Config
 }
@@ -56,14 +59,16 @@ func NewMatrixRow(height float64, code string, ps ...props.Rect) core.Row {
 
 // Render renders a MatrixCode into a PDF context.
 func (m *MatrixCode) Render(provider core.Provider, cell *entity.Cell) {
-	provider.AddMatrixCode(m.code, cell, &m.prop)
+	caption.Render(provider, cell, m.prop.Caption, func(codeCell *entity.Cell) {
+		provider.AddMatrixCode(m.code, codeCell, &m.prop)
+	})
 }
 
 // GetStructure returns the Structure of a MatrixCode.
 func (m *MatrixCode) GetStructure() *node.Node[core.Structure] {
 	str := core.Structure{
 		Type:    "matrixcode",
-		Value:   m.code,
+		Value:   m.getValue(),
 		Details: m.prop.ToMap(),
 	}
 
@@ -72,16 +77,25 @@ func (m *MatrixCode) GetStructure() *node.Node[core.Structure] {
 
 // GetHeight returns the height that the code will have in the PDF
 func (m *MatrixCode) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
-	dimensions, err := provider.GetDimensionsByMatrixCode(m.code)
+	dimensions, err := provider.GetDimensionsByMatrixCode(m.code, &m.prop)
 	if err != nil {
 		return 0
 	}
 	proportion := dimensions.Height / dimensions.Width
 	width := (m.prop.Percent / 100) * cell.Width
-	return proportion * width
+	return proportion*width + caption.GetHeight(provider, m.prop.Caption, cell.Width)
 }
 
 // SetConfig sets the configuration of a MatrixCode.
 func (m *MatrixCode) SetConfig(config *entity.Config) {
 	m.config = config
+	caption.MakeValid(&m.prop, config)
+}
+
+func (m *MatrixCode) getValue() string {
+	if m.value != "" {
+		return m.value
+	}
+
+	return m.code
 }
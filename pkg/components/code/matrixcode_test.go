@@ -9,6 +9,7 @@ import (
 	"github.com/johnfercher/maroto/v2/mocks"
 	"github.com/johnfercher/maroto/v2/pkg/components/code"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/johnfercher/maroto/v2/pkg/test"
 	"github.com/stretchr/testify/assert"
 )
@@ -113,9 +114,11 @@ func TestMatrixCode_SetConfig(t *testing.T) {
 func TestMatrixCode_GetHeight(t *testing.T) {
 	t.Run("When it is not possible to know the dimensions of the matrix code, should return height 0", func(t *testing.T) {
 		cell := fixture.CellEntity()
+		defaultProp := props.Rect{}
+		defaultProp.MakeValid()
 
 		provider := mocks.NewProvider(t)
-		provider.EXPECT().GetDimensionsByMatrixCode("code").Return(nil, errors.New("anyError2"))
+		provider.EXPECT().GetDimensionsByMatrixCode("code", &defaultProp).Return(nil, errors.New("anyError2"))
 
 		sut := code.NewMatrix("code")
 
@@ -126,9 +129,11 @@ func TestMatrixCode_GetHeight(t *testing.T) {
 
 	t.Run("When the height of the matrix code is half the width, should return half the width of the cell", func(t *testing.T) {
 		cell := fixture.CellEntity()
+		defaultProp := props.Rect{}
+		defaultProp.MakeValid()
 
 		provider := mocks.NewProvider(t)
-		provider.EXPECT().GetDimensionsByMatrixCode("code").Return(&entity.Dimensions{Width: 10, Height: 5}, nil)
+		provider.EXPECT().GetDimensionsByMatrixCode("code", &defaultProp).Return(&entity.Dimensions{Width: 10, Height: 5}, nil)
 
 		sut := code.NewMatrix("code")
 
@@ -0,0 +1,87 @@
+// Package code implements creation of Barcode, MatrixCode, QrCode, PDF417 and Aztec.
+// nolint:dupl
+package code
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type PDF417 struct {
+	code   string
+	prop   props.PDF417
+	config *entity.Config
+}
+
+// NewPDF417 is responsible to create an instance of a PDF417.
+func NewPDF417(code string, pdf417Props ...props.PDF417) core.Component {
+	prop := props.PDF417{}
+	if len(pdf417Props) > 0 {
+		prop = pdf417Props[0]
+	}
+	prop.MakeValid()
+
+	return &PDF417{
+		code: code,
+		prop: prop,
+	}
+}
+
+// NewPDF417Col is responsible to create an instance of a PDF417 wrapped in a Col.
+func NewPDF417Col(size int, code string, ps ...props.PDF417) core.Col {
+	pdf417 := NewPDF417(code, ps...)
+	return col.New(size).Add(pdf417)
+}
+
+// NewAutoPDF417Row is responsible to create an instance of a PDF417 wrapped in a Row with automatic height.
+//   - code: The value that must be placed in the pdf417
+//   - ps: A set of settings that must be applied to the pdf417
+func NewAutoPDF417Row(code string, ps ...props.PDF417) core.Row {
+	pdf417 := NewPDF417(code, ps...)
+	c := col.New().Add(pdf417)
+	return row.New().Add(c)
+}
+
+// NewPDF417Row is responsible to create an instance of a PDF417 wrapped in a Row.
+func NewPDF417Row(height float64, code string, ps ...props.PDF417) core.Row {
+	pdf417 := NewPDF417(code, ps...)
+	c := col.New().Add(pdf417)
+	return row.New(height).Add(c)
+}
+
+// Render renders a PDF417 into a PDF context.
+func (p *PDF417) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddPDF417(p.code, cell, &p.prop)
+}
+
+// GetStructure returns the Structure of a PDF417.
+func (p *PDF417) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "pdf417",
+		Value:   p.code,
+		Details: p.prop.ToMap(),
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height that the code will have in the PDF
+func (p *PDF417) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	dimensions, err := provider.GetDimensionsByPDF417(p.code, &p.prop)
+	if err != nil {
+		return 0
+	}
+	proportion := dimensions.Height / dimensions.Width
+	width := (p.prop.Percent / 100) * cell.Width
+	return proportion * width
+}
+
+// SetConfig sets the configuration of a PDF417.
+func (p *PDF417) SetConfig(config *entity.Config) {
+	p.config = config
+}
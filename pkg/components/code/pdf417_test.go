@@ -0,0 +1,144 @@
+// nolint: dupl
+package code_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPDF417(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417("code", fixture.PDF417Prop())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_custom_prop.json")
+	})
+}
+
+func TestNewPDF417Col(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417Col(12, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_col_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417Col(12, "code", fixture.PDF417Prop())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_col_custom_prop.json")
+	})
+}
+
+func TestNewPDF417Row(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417Row(10, "code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewPDF417Row(10, "code", fixture.PDF417Prop())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_pdf417_row_custom_prop.json")
+	})
+}
+
+func TestNewAutoPDF417Row(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoPDF417Row("code")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_auto_pdf417_row_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := code.NewAutoPDF417Row("code", fixture.PDF417Prop())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_auto_pdf417_row_custom_prop.json")
+	})
+}
+
+func TestPDF417_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		codeValue := "code"
+		cell := fixture.CellEntity()
+		prop := fixture.PDF417Prop()
+		sut := code.NewPDF417(codeValue, prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddPDF417(codeValue, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddPDF417", 1)
+	})
+}
+
+func TestPDF417_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := code.NewPDF417("code")
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
+
+func TestPDF417_GetHeight(t *testing.T) {
+	t.Run("When it is not possible to know the dimensions of the pdf417, should return height 0", func(t *testing.T) {
+		cell := fixture.CellEntity()
+		defaultProp := props.PDF417{}
+		defaultProp.MakeValid()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByPDF417("code", &defaultProp).Return(nil, errors.New("anyError2"))
+
+		sut := code.NewPDF417("code")
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+		assert.Equal(t, height, 0.0)
+	})
+
+	t.Run("When the height of the pdf417 is half the width, should return half the width of the cell", func(t *testing.T) {
+		cell := fixture.CellEntity()
+		defaultProp := props.PDF417{}
+		defaultProp.MakeValid()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByPDF417("code", &defaultProp).Return(&entity.Dimensions{Width: 10, Height: 5}, nil)
+
+		sut := code.NewPDF417("code")
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+		assert.Equal(t, height, cell.Width/2)
+	})
+}
@@ -1,4 +1,4 @@
-// Package code implements creation of Barcode, MatrixCode and QrCode.
+// Package code implements creation of Barcode, MatrixCode, QrCode, PDF417 and Aztec.
 // nolint:dupl
 package code
 
@@ -14,13 +14,13 @@ import (
 
 type QrCode struct {
 	code   string
-	prop   props.Rect
+	prop   props.QrCode
 	config *entity.Config
 }
 
 // NewQr is responsible to create an instance of a QrCode.
-func NewQr(code string, barcodeProps ...props.Rect) core.Component {
-	prop := props.Rect{}
+func NewQr(code string, barcodeProps ...props.QrCode) core.Component {
+	prop := props.QrCode{}
 	if len(barcodeProps) > 0 {
 		prop = barcodeProps[0]
 	}
@@ -33,7 +33,7 @@ func NewQr(code string, barcodeProps ...props.Rect) core.Component {
 }
 
 // NewQrCol is responsible to create an instance of a QrCode wrapped in a Col.
-func NewQrCol(size int, code string, ps ...props.Rect) core.Col {
+func NewQrCol(size int, code string, ps ...props.QrCode) core.Col {
 	qrCode := NewQr(code, ps...)
 	return col.New(size).Add(qrCode)
 }
@@ -41,14 +41,14 @@ func NewQrCol(size int, code string, ps ...props.Rect) core.Col {
 // NewAutoMatrixRow is responsible to create an instance of a qrcode wrapped in a Row with automatic height.
 //   - code: The value that must be placed in the qrcode
 //   - ps: A set of settings that must be applied to the qrcode
-func NewAutoQrRow(code string, ps ...props.Rect) core.Row {
+func NewAutoQrRow(code string, ps ...props.QrCode) core.Row {
 	qrCode := NewQr(code, ps...)
 	c := col.New().Add(qrCode)
 	return row.New().Add(c)
 }
 
 // NewQrRow is responsible to create an instance of a QrCode wrapped in a Row.
-func NewQrRow(height float64, code string, ps ...props.Rect) core.Row {
+func NewQrRow(height float64, code string, ps ...props.QrCode) core.Row {
 	qrCode := NewQr(code, ps...)
 	c := col.New().Add(qrCode)
 	return row.New(height).Add(c)
@@ -72,7 +72,7 @@ func (q *QrCode) GetStructure() *node.Node[core.Structure] {
 
 // GetHeight returns the height that the QrCode will have in the PDF
 func (q *QrCode) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
-	dimensions, err := provider.GetDimensionsByQrCode(q.code)
+	dimensions, err := provider.GetDimensionsByQrCode(q.code, &q.prop)
 	if err != nil {
 		return 0
 	}
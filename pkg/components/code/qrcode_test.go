@@ -9,6 +9,7 @@ import (
 	"github.com/johnfercher/maroto/v2/mocks"
 	"github.com/johnfercher/maroto/v2/pkg/components/code"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/johnfercher/maroto/v2/pkg/test"
 	"github.com/stretchr/testify/assert"
 )
@@ -23,7 +24,7 @@ func TestNewQr(t *testing.T) {
 	})
 	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
 		// Act
-		sut := code.NewQr("code", fixture.RectProp())
+		sut := code.NewQr("code", fixture.QrCodeProp())
 
 		// Assert
 		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_qr_custom_prop.json")
@@ -40,7 +41,7 @@ func TestNewQrCol(t *testing.T) {
 	})
 	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
 		// Act
-		sut := code.NewQrCol(12, "code", fixture.RectProp())
+		sut := code.NewQrCol(12, "code", fixture.QrCodeProp())
 
 		// Assert
 		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_qr_col_custom_prop.json")
@@ -57,7 +58,7 @@ func TestNewQrRow(t *testing.T) {
 	})
 	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
 		// Act
-		sut := code.NewQrRow(10, "code", fixture.RectProp())
+		sut := code.NewQrRow(10, "code", fixture.QrCodeProp())
 
 		// Assert
 		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_qr_row_custom_prop.json")
@@ -74,7 +75,7 @@ func TestNewAutoQrRow(t *testing.T) {
 	})
 	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
 		// Act
-		sut := code.NewAutoQrRow("code", fixture.RectProp())
+		sut := code.NewAutoQrRow("code", fixture.QrCodeProp())
 
 		// Assert
 		test.New(t).Assert(sut.GetStructure()).Equals("components/codes/new_auto_qr_row_custom_prop.json")
@@ -86,7 +87,7 @@ func TestQrCode_Render(t *testing.T) {
 		// Arrange
 		codeValue := "code"
 		cell := fixture.CellEntity()
-		prop := fixture.RectProp()
+		prop := fixture.QrCodeProp()
 		sut := code.NewQr(codeValue, prop)
 
 		provider := mocks.NewProvider(t)
@@ -113,9 +114,11 @@ func TestQrCode_SetConfig(t *testing.T) {
 func TestQrCode_GetHeight(t *testing.T) {
 	t.Run("When it is not possible to know the dimensions of the qrcode, should return height 0", func(t *testing.T) {
 		cell := fixture.CellEntity()
+		defaultProp := props.QrCode{}
+		defaultProp.MakeValid()
 
 		provider := mocks.NewProvider(t)
-		provider.EXPECT().GetDimensionsByQrCode("code").Return(nil, errors.New("anyError2"))
+		provider.EXPECT().GetDimensionsByQrCode("code", &defaultProp).Return(nil, errors.New("anyError2"))
 
 		sut := code.NewQr("code")
 
@@ -126,9 +129,11 @@ func TestQrCode_GetHeight(t *testing.T) {
 
 	t.Run("When the height of the qr code is half the width, should return half the width of the cell", func(t *testing.T) {
 		cell := fixture.CellEntity()
+		defaultProp := props.QrCode{}
+		defaultProp.MakeValid()
 
 		provider := mocks.NewProvider(t)
-		provider.EXPECT().GetDimensionsByQrCode("code").Return(&entity.Dimensions{Width: 10, Height: 5}, nil)
+		provider.EXPECT().GetDimensionsByQrCode("code", &defaultProp).Return(&entity.Dimensions{Width: 10, Height: 5}, nil)
 
 		sut := code.NewQr("code")
 
@@ -0,0 +1,69 @@
+package form_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/form"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNewTextField demonstrates how to create a text field component.
+func ExampleNewTextField() {
+	m := maroto.New()
+
+	field := form.NewTextField("name", "Maria Silva", props.FormField{Required: true})
+	col := col.New(6).Add(field)
+	m.AddRow(10, col)
+
+	// generate document
+}
+
+// ExampleNewAutoTextFieldRow demonstrates how to create a multi-line text field wrapped into a row with automatic height.
+func ExampleNewAutoTextFieldRow() {
+	m := maroto.New()
+
+	row := form.NewAutoTextFieldRow("notes", "", props.FormField{MultiLine: true, Lines: 5})
+	m.AddRows(row)
+
+	// generate document
+}
+
+// ExampleNewCheckBoxCol demonstrates how to create a checkbox component wrapped into a column.
+func ExampleNewCheckBoxCol() {
+	m := maroto.New()
+
+	checkBoxCol := form.NewCheckBoxCol(1, "accept", false, props.FormField{Required: true})
+	m.AddRow(6, checkBoxCol)
+
+	// generate document
+}
+
+// ExampleNewRadioGroupCol demonstrates how to create a radio group component wrapped into a column.
+func ExampleNewRadioGroupCol() {
+	m := maroto.New()
+
+	radioGroupCol := form.NewRadioGroupCol(6, "plan", []string{"basic", "premium"}, "basic")
+	m.AddRow(14, radioGroupCol)
+
+	// generate document
+}
+
+// ExampleNewDropdownRow demonstrates how to create a dropdown component wrapped into a row.
+func ExampleNewDropdownRow() {
+	m := maroto.New()
+
+	dropdownRow := form.NewDropdownRow(8, "country", []string{"Brazil", "Chile"}, "Brazil")
+	m.AddRows(dropdownRow)
+
+	// generate document
+}
+
+// ExampleNewSignatureFieldCol demonstrates how to create a signature field wrapped into a column.
+func ExampleNewSignatureFieldCol() {
+	m := maroto.New()
+
+	signatureCol := form.NewSignatureFieldCol(6, "signature", props.FormField{ReadOnly: true})
+	m.AddRow(20, signatureCol)
+
+	// generate document
+}
@@ -0,0 +1,214 @@
+// Package form implements creation of interactive form fields, which are filled in with a PDF reader.
+package form
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/formfield"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	// padding is the space above and below the text of a field in a row with automatic height.
+	padding = 1.5
+	// lineSpacing is the space between the lines of multi-line text fields, relative to the font height.
+	lineSpacing                 = 1.15
+	defaultSignatureFieldHeight = 15.0
+)
+
+// Field is an interactive field of the form of the document.
+type Field struct {
+	data   entity.FormField
+	prop   props.FormField
+	config *entity.Config
+}
+
+func newField(data entity.FormField, ps ...props.FormField) core.Component {
+	prop := props.FormField{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return &Field{
+		data: data,
+		prop: prop,
+	}
+}
+
+func newRow(height float64, field core.Component) core.Row {
+	c := col.New().Add(field)
+	return row.New(height).Add(c)
+}
+
+func newAutoRow(field core.Component) core.Row {
+	c := col.New().Add(field)
+	return row.New().Add(c)
+}
+
+// NewTextField is responsible to create an instance of a text Field.
+//   - name: The name that identifies the field when the form is read, it must be unique in the document
+//   - value: The default text of the field
+//   - ps: A set of settings that must be applied to the field, MultiLine allows more than one line
+func NewTextField(name, value string, ps ...props.FormField) core.Component {
+	return newField(entity.FormField{Type: formfield.Text, Name: name, Value: value}, ps...)
+}
+
+// NewTextFieldCol is responsible to create an instance of a text Field wrapped in a Col.
+func NewTextFieldCol(size int, name, value string, ps ...props.FormField) core.Col {
+	return col.New(size).Add(NewTextField(name, value, ps...))
+}
+
+// NewTextFieldRow is responsible to create an instance of a text Field wrapped in a Row.
+func NewTextFieldRow(height float64, name, value string, ps ...props.FormField) core.Row {
+	return newRow(height, NewTextField(name, value, ps...))
+}
+
+// NewAutoTextFieldRow is responsible to create an instance of a text Field wrapped in a Row with automatic height.
+func NewAutoTextFieldRow(name, value string, ps ...props.FormField) core.Row {
+	return newAutoRow(NewTextField(name, value, ps...))
+}
+
+// NewCheckBox is responsible to create an instance of a checkbox Field, which is a square on the left of the cell.
+//   - name: The name that identifies the field when the form is read, it must be unique in the document
+//   - checked: The default state of the checkbox
+//   - ps: A set of settings that must be applied to the field
+func NewCheckBox(name string, checked bool, ps ...props.FormField) core.Component {
+	return newField(entity.FormField{Type: formfield.CheckBox, Name: name, Checked: checked}, ps...)
+}
+
+// NewCheckBoxCol is responsible to create an instance of a checkbox Field wrapped in a Col.
+func NewCheckBoxCol(size int, name string, checked bool, ps ...props.FormField) core.Col {
+	return col.New(size).Add(NewCheckBox(name, checked, ps...))
+}
+
+// NewCheckBoxRow is responsible to create an instance of a checkbox Field wrapped in a Row.
+func NewCheckBoxRow(height float64, name string, checked bool, ps ...props.FormField) core.Row {
+	return newRow(height, NewCheckBox(name, checked, ps...))
+}
+
+// NewAutoCheckBoxRow is responsible to create an instance of a checkbox Field wrapped in a Row with automatic height.
+func NewAutoCheckBoxRow(name string, checked bool, ps ...props.FormField) core.Row {
+	return newAutoRow(NewCheckBox(name, checked, ps...))
+}
+
+// NewRadioGroup is responsible to create an instance of a radio group Field, the options are stacked in the cell
+// with a button on the left of each option.
+//   - name: The name that identifies the field when the form is read, it must be unique in the document
+//   - options: The options of the group, which must be unique
+//   - selected: The option selected by default, empty means that no option is selected
+//   - ps: A set of settings that must be applied to the field
+func NewRadioGroup(name string, options []string, selected string, ps ...props.FormField) core.Component {
+	return newField(entity.FormField{Type: formfield.RadioGroup, Name: name, Options: options, Value: selected}, ps...)
+}
+
+// NewRadioGroupCol is responsible to create an instance of a radio group Field wrapped in a Col.
+func NewRadioGroupCol(size int, name string, options []string, selected string, ps ...props.FormField) core.Col {
+	return col.New(size).Add(NewRadioGroup(name, options, selected, ps...))
+}
+
+// NewRadioGroupRow is responsible to create an instance of a radio group Field wrapped in a Row.
+func NewRadioGroupRow(height float64, name string, options []string, selected string, ps ...props.FormField) core.Row {
+	return newRow(height, NewRadioGroup(name, options, selected, ps...))
+}
+
+// NewAutoRadioGroupRow is responsible to create an instance of a radio group Field wrapped in a Row with automatic height.
+func NewAutoRadioGroupRow(name string, options []string, selected string, ps ...props.FormField) core.Row {
+	return newAutoRow(NewRadioGroup(name, options, selected, ps...))
+}
+
+// NewDropdown is responsible to create an instance of a dropdown Field.
+//   - name: The name that identifies the field when the form is read, it must be unique in the document
+//   - options: The options of the list, which must be unique
+//   - selected: The option selected by default, empty means that no option is selected
+//   - ps: A set of settings that must be applied to the field
+func NewDropdown(name string, options []string, selected string, ps ...props.FormField) core.Component {
+	return newField(entity.FormField{Type: formfield.Dropdown, Name: name, Options: options, Value: selected}, ps...)
+}
+
+// NewDropdownCol is responsible to create an instance of a dropdown Field wrapped in a Col.
+func NewDropdownCol(size int, name string, options []string, selected string, ps ...props.FormField) core.Col {
+	return col.New(size).Add(NewDropdown(name, options, selected, ps...))
+}
+
+// NewDropdownRow is responsible to create an instance of a dropdown Field wrapped in a Row.
+func NewDropdownRow(height float64, name string, options []string, selected string, ps ...props.FormField) core.Row {
+	return newRow(height, NewDropdown(name, options, selected, ps...))
+}
+
+// NewAutoDropdownRow is responsible to create an instance of a dropdown Field wrapped in a Row with automatic height.
+func NewAutoDropdownRow(name string, options []string, selected string, ps ...props.FormField) core.Row {
+	return newAutoRow(NewDropdown(name, options, selected, ps...))
+}
+
+// NewSignatureField is responsible to create an instance of an empty signature Field, where the document is
+// digitally signed with a PDF reader.
+//   - name: The name that identifies the field when the form is read, it must be unique in the document
+//   - ps: A set of settings that must be applied to the field
+func NewSignatureField(name string, ps ...props.FormField) core.Component {
+	return newField(entity.FormField{Type: formfield.Signature, Name: name}, ps...)
+}
+
+// NewSignatureFieldCol is responsible to create an instance of a signature Field wrapped in a Col.
+func NewSignatureFieldCol(size int, name string, ps ...props.FormField) core.Col {
+	return col.New(size).Add(NewSignatureField(name, ps...))
+}
+
+// NewSignatureFieldRow is responsible to create an instance of a signature Field wrapped in a Row.
+func NewSignatureFieldRow(height float64, name string, ps ...props.FormField) core.Row {
+	return newRow(height, NewSignatureField(name, ps...))
+}
+
+// NewAutoSignatureFieldRow is responsible to create an instance of a signature Field wrapped in a Row with
+// automatic height.
+func NewAutoSignatureFieldRow(name string, ps ...props.FormField) core.Row {
+	return newAutoRow(NewSignatureField(name, ps...))
+}
+
+// Render renders a Field into a PDF context.
+func (f *Field) Render(provider core.Provider, cell *entity.Cell) {
+	provider.AddFormField(&f.data, cell, &f.prop)
+}
+
+// GetStructure returns the Structure of a Field.
+func (f *Field) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "formfield",
+		Value:   f.data.Name,
+		Details: f.data.AppendMap(f.prop.ToMap()),
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height that the field will have in the PDF, which fits a line of text for each line
+// of multi-line text fields and for each option of radio groups.
+func (f *Field) GetHeight(provider core.Provider, _ *entity.Cell) float64 {
+	if f.data.Type == formfield.Signature {
+		return defaultSignatureFieldHeight
+	}
+
+	fontHeight := provider.GetFontHeight(&props.Font{Family: fontfamily.Helvetica, Style: fontstyle.Normal, Size: f.prop.Size})
+	lineHeight := fontHeight + 2*padding
+
+	if f.data.Type == formfield.RadioGroup {
+		return float64(len(f.data.Options)) * lineHeight
+	}
+
+	if f.prop.MultiLine {
+		return float64(f.prop.Lines)*fontHeight*lineSpacing + 2*padding
+	}
+
+	return lineHeight
+}
+
+// SetConfig sets the config.
+func (f *Field) SetConfig(config *entity.Config) {
+	f.config = config
+}
@@ -0,0 +1,271 @@
+package form_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/form"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewTextField(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := form.NewTextField("name", "Maria Silva")
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_text_field_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := form.NewTextField("name", "Maria Silva", fixture.FormFieldProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_text_field_custom_prop.json")
+	})
+}
+
+func TestNewTextFieldCol(t *testing.T) {
+	// Act
+	sut := form.NewTextFieldCol(6, "name", "Maria Silva")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_text_field_col.json")
+}
+
+func TestNewTextFieldRow(t *testing.T) {
+	// Act
+	sut := form.NewTextFieldRow(10, "name", "Maria Silva")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_text_field_row.json")
+}
+
+func TestNewAutoTextFieldRow(t *testing.T) {
+	// Act
+	sut := form.NewAutoTextFieldRow("name", "Maria Silva")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_text_field_auto_row.json")
+}
+
+func TestNewCheckBox(t *testing.T) {
+	// Act
+	sut := form.NewCheckBox("accept", true)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_checkbox.json")
+}
+
+func TestNewCheckBoxCol(t *testing.T) {
+	// Act
+	sut := form.NewCheckBoxCol(6, "accept", true)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_checkbox_col.json")
+}
+
+func TestNewCheckBoxRow(t *testing.T) {
+	// Act
+	sut := form.NewCheckBoxRow(10, "accept", true)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_checkbox_row.json")
+}
+
+func TestNewAutoCheckBoxRow(t *testing.T) {
+	// Act
+	sut := form.NewAutoCheckBoxRow("accept", true)
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_checkbox_auto_row.json")
+}
+
+func TestNewRadioGroup(t *testing.T) {
+	// Act
+	sut := form.NewRadioGroup("plan", []string{"basic", "premium"}, "premium")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_radio_group.json")
+}
+
+func TestNewRadioGroupCol(t *testing.T) {
+	// Act
+	sut := form.NewRadioGroupCol(6, "plan", []string{"basic", "premium"}, "premium")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_radio_group_col.json")
+}
+
+func TestNewRadioGroupRow(t *testing.T) {
+	// Act
+	sut := form.NewRadioGroupRow(10, "plan", []string{"basic", "premium"}, "premium")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_radio_group_row.json")
+}
+
+func TestNewAutoRadioGroupRow(t *testing.T) {
+	// Act
+	sut := form.NewAutoRadioGroupRow("plan", []string{"basic", "premium"}, "premium")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_radio_group_auto_row.json")
+}
+
+func TestNewDropdown(t *testing.T) {
+	// Act
+	sut := form.NewDropdown("country", []string{"Brazil", "Chile"}, "Chile")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_dropdown.json")
+}
+
+func TestNewDropdownCol(t *testing.T) {
+	// Act
+	sut := form.NewDropdownCol(6, "country", []string{"Brazil", "Chile"}, "Chile")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_dropdown_col.json")
+}
+
+func TestNewDropdownRow(t *testing.T) {
+	// Act
+	sut := form.NewDropdownRow(10, "country", []string{"Brazil", "Chile"}, "Chile")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_dropdown_row.json")
+}
+
+func TestNewAutoDropdownRow(t *testing.T) {
+	// Act
+	sut := form.NewAutoDropdownRow("country", []string{"Brazil", "Chile"}, "Chile")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_dropdown_auto_row.json")
+}
+
+func TestNewSignatureField(t *testing.T) {
+	// Act
+	sut := form.NewSignatureField("signature")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_signature_field.json")
+}
+
+func TestNewSignatureFieldCol(t *testing.T) {
+	// Act
+	sut := form.NewSignatureFieldCol(6, "signature")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_signature_field_col.json")
+}
+
+func TestNewSignatureFieldRow(t *testing.T) {
+	// Act
+	sut := form.NewSignatureFieldRow(10, "signature")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_signature_field_row.json")
+}
+
+func TestNewAutoSignatureFieldRow(t *testing.T) {
+	// Act
+	sut := form.NewAutoSignatureFieldRow("signature")
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_signature_field_auto_row.json")
+}
+
+func TestField_Render(t *testing.T) {
+	t.Run("should call provider correctly", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		data := fixture.FormFieldEntity()
+		prop := fixture.FormFieldProp()
+		sut := form.NewRadioGroup(data.Name, data.Options, data.Value, prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddFormField(&data, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddFormField", 1)
+	})
+}
+
+func TestField_GetHeight(t *testing.T) {
+	t.Run("when field is a signature, should return the signature height", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := form.NewSignatureField("signature")
+
+		// Act
+		height := sut.GetHeight(mocks.NewProvider(t), &cell)
+
+		// Assert
+		assert.Equal(t, 15.0, height)
+	})
+	t.Run("when field is a single line text, should return the height of a line", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := form.NewTextField("name", "")
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(&props.Font{Family: fontfamily.Helvetica, Style: fontstyle.Normal, Size: 10}).Return(4)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 7.0, height)
+	})
+	t.Run("when field is a multi-line text, should return the height of the lines", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := form.NewTextField("notes", "", props.FormField{MultiLine: true, Lines: 4})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(&props.Font{Family: fontfamily.Helvetica, Style: fontstyle.Normal, Size: 10}).Return(4)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.InDelta(t, 21.4, height, 0.001)
+	})
+	t.Run("when field is a radio group, should return the height of a line for each option", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		sut := form.NewRadioGroup("plan", []string{"basic", "premium", "gold"}, "")
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(&props.Font{Family: fontfamily.Helvetica, Style: fontstyle.Normal, Size: 10}).Return(4)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 21.0, height)
+	})
+}
+
+func TestField_SetConfig(t *testing.T) {
+	// Arrange
+	sut := form.NewCheckBox("accept", true)
+
+	// Act
+	sut.SetConfig(&entity.Config{})
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/forms/new_checkbox.json")
+}
@@ -4,6 +4,7 @@ package image
 import (
 	"github.com/johnfercher/go-tree/node"
 
+	"github.com/johnfercher/maroto/v2/internal/caption"
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
@@ -56,7 +57,9 @@ func NewAutoFromBytesRow(bytes []byte, extension extension.Type, ps ...props.Rec
 
 // Render renders an Image into a PDF context.
 func (b *BytesImage) Render(provider core.Provider, cell *entity.Cell) {
-	provider.AddImageFromBytes(b.bytes, cell, &b.prop, b.extension)
+	caption.Render(provider, cell, b.prop.Caption, func(imageCell *entity.Cell) {
+		provider.AddImageFromBytes(b.bytes, imageCell, &b.prop, b.extension)
+	})
 }
 
 // GetStructure returns the Structure of an Image.
@@ -85,11 +88,15 @@ func (b *BytesImage) GetHeight(provider core.Provider, cell *entity.Cell) float6
 		return 0
 	}
 	proportion := dimensions.Height / dimensions.Width
+	if b.prop.Crop != nil {
+		proportion *= b.prop.Crop.Height / b.prop.Crop.Width
+	}
 	width := (b.prop.Percent / 100) * cell.Width
-	return proportion * width
+	return proportion*width + caption.GetHeight(provider, b.prop.Caption, cell.Width)
 }
 
 // SetConfig sets the pdf config.
 func (b *BytesImage) SetConfig(config *entity.Config) {
 	b.config = config
+	caption.MakeValid(&b.prop, config)
 }
@@ -9,8 +9,10 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/components/image"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/johnfercher/maroto/v2/pkg/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNewFromBytes(t *testing.T) {
@@ -28,6 +30,19 @@ func TestNewFromBytes(t *testing.T) {
 		// Assert
 		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_bytes_custom_prop.json")
 	})
+	t.Run("when caption and alt text are sent, should show them in structure", func(t *testing.T) {
+		// Arrange
+		prop := props.Rect{
+			Caption: &props.Caption{Value: "Figure 1: company logo", Prop: props.Text{Size: 8}},
+			AltText: "company logo",
+		}
+
+		// Act
+		sut := image.NewFromBytes([]byte{1, 2, 3}, extension.Jpg, prop)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/images/new_image_from_bytes_caption_prop.json")
+	})
 }
 
 func TestNewFromBytesCol(t *testing.T) {
@@ -99,6 +114,32 @@ func TestBytesImage_Render(t *testing.T) {
 		// Assert
 		provider.AssertNumberOfCalls(t, "AddImageFromBytes", 1)
 	})
+	t.Run("when caption is sent, should render image above the caption", func(t *testing.T) {
+		// Arrange
+		bytes := []byte{1, 2, 3}
+		ext := extension.Jpg
+		cell := fixture.CellEntity()
+		prop := props.Rect{Caption: &props.Caption{Value: "caption"}}
+		sut := image.NewFromBytes(bytes, ext, prop)
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{Size: 10}})
+
+		imageCell := cell.Copy()
+		imageCell.Height -= 5
+		captionCell := &entity.Cell{X: cell.X, Y: cell.Y + imageCell.Height, Width: cell.Width, Height: 5}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetLinesQuantity("caption", mock.Anything, cell.Width).Return(1)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(5.0)
+		provider.EXPECT().AddImageFromBytes(bytes, &imageCell, mock.Anything, ext)
+		provider.EXPECT().AddText("caption", captionCell, mock.Anything)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddImageFromBytes", 1)
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
 }
 
 func TestBytesImage_SetConfig(t *testing.T) {
@@ -142,4 +183,35 @@ func TestBytesImage_GetHeight(t *testing.T) {
 		height := sut.GetHeight(provider, &cell)
 		assert.Equal(t, height, cell.Width/2)
 	})
+	t.Run("When the image is cropped, should return the height of the cropped region", func(t *testing.T) {
+		cell := fixture.CellEntity()
+		img := fixture.ImageEntity()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByImageByte(img.Bytes, img.Extension).Return(&entity.Dimensions{Width: 10, Height: 5}, nil)
+
+		sut := image.NewFromBytes(img.Bytes, img.Extension, props.Rect{Crop: &props.Crop{Width: 50}})
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+		assert.Equal(t, height, cell.Width)
+	})
+	t.Run("When the image has caption, should add the caption lines height", func(t *testing.T) {
+		cell := fixture.CellEntity()
+		img := fixture.ImageEntity()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByImageByte(img.Bytes, img.Extension).Return(&entity.Dimensions{Width: 10, Height: 5}, nil)
+		provider.EXPECT().GetLinesQuantity("caption", mock.Anything, cell.Width-2).Return(2)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4.0)
+
+		sut := image.NewFromBytes(img.Bytes, img.Extension, props.Rect{
+			Caption: &props.Caption{Value: "caption", Prop: props.Text{Top: 1, Left: 2, VerticalPadding: 0.5}},
+		})
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{Size: 10}})
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+		assert.Equal(t, cell.Width/2+9.5, height)
+	})
 }
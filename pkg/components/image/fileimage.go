@@ -4,6 +4,7 @@ package image
 import (
 	"github.com/johnfercher/go-tree/node"
 
+	"github.com/johnfercher/maroto/v2/internal/caption"
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
 	"github.com/johnfercher/maroto/v2/pkg/core"
@@ -53,7 +54,9 @@ func NewAutoFromFileRow(path string, ps ...props.Rect) core.Row {
 
 // Render renders an Image into a PDF context.
 func (f *FileImage) Render(provider core.Provider, cell *entity.Cell) {
-	provider.AddImageFromFile(f.path, cell, &f.prop)
+	caption.Render(provider, cell, f.prop.Caption, func(imageCell *entity.Cell) {
+		provider.AddImageFromFile(f.path, imageCell, &f.prop)
+	})
 }
 
 // GetStructure returns the Structure of an Image.
@@ -74,11 +77,15 @@ func (f *FileImage) GetHeight(provider core.Provider, cell *entity.Cell) float64
 		return 0.0
 	}
 	proportion := dimensions.Height / dimensions.Width
+	if f.prop.Crop != nil {
+		proportion *= f.prop.Crop.Height / f.prop.Crop.Width
+	}
 	width := (f.prop.Percent / 100) * cell.Width
-	return (proportion * width) + f.prop.Top
+	return (proportion * width) + f.prop.Top + caption.GetHeight(provider, f.prop.Caption, cell.Width)
 }
 
 // SetConfig sets the pdf config.
 func (f *FileImage) SetConfig(config *entity.Config) {
 	f.config = config
+	caption.MakeValid(&f.prop, config)
 }
@@ -7,8 +7,10 @@ import (
 	"github.com/johnfercher/maroto/v2/internal/fixture"
 	"github.com/johnfercher/maroto/v2/mocks"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
 	"github.com/johnfercher/maroto/v2/pkg/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/johnfercher/maroto/v2/pkg/components/image"
 )
@@ -138,4 +140,19 @@ func TestFileImage_GetHeight(t *testing.T) {
 		height := sut.GetHeight(provider, &cell)
 		assert.Equal(t, height, cell.Width/2)
 	})
+	t.Run("When the file image has caption, should add the caption height", func(t *testing.T) {
+		cell := fixture.CellEntity()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetDimensionsByImage("path").Return(&entity.Dimensions{Width: 10, Height: 5}, nil)
+		provider.EXPECT().GetLinesQuantity("caption", mock.Anything, cell.Width).Return(1)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4.0)
+
+		sut := image.NewFromFile("path", props.Rect{Caption: &props.Caption{Value: "caption"}})
+		sut.SetConfig(&entity.Config{DefaultFont: &props.Font{Size: 10}})
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+		assert.Equal(t, cell.Width/2+4, height)
+	})
 }
@@ -0,0 +1,50 @@
+package itemlist_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/itemlist"
+	"github.com/johnfercher/maroto/v2/pkg/consts/liststyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNew demonstrates how to create a bulleted list.
+func ExampleNew() {
+	items := []itemlist.Item{
+		itemlist.NewItem("first item"),
+		itemlist.NewItem("second item",
+			itemlist.NewItem("nested item"),
+		),
+	}
+
+	rows := itemlist.New(items)
+
+	m := maroto.New()
+	m.AddRows(rows...)
+
+	// generate document
+}
+
+// ExampleNew_numbered demonstrates how to create a numbered list with custom numbering styles.
+func ExampleNew_numbered() {
+	items := []itemlist.Item{
+		itemlist.NewItem("first clause",
+			itemlist.NewItem("first sub clause"),
+			itemlist.NewItem("second sub clause"),
+		),
+		itemlist.NewItem("second clause"),
+	}
+
+	rows := itemlist.New(items, props.List{
+		Levels: []props.ListLevel{
+			{Style: liststyle.Decimal, Suffix: "."},
+			{Style: liststyle.LowerAlpha, Suffix: ")"},
+			{Style: liststyle.LowerRoman, Suffix: "."},
+		},
+		MarkerWidth: 7,
+	})
+
+	m := maroto.New()
+	m.AddRows(rows...)
+
+	// generate document
+}
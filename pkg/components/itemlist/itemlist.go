@@ -0,0 +1,133 @@
+// Package itemlist implements creation of bulleted and numbered lists.
+package itemlist
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/liststyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Item is an entry of a list, it may contain nested items.
+type Item struct {
+	Text  string
+	Items []Item
+}
+
+// NewItem is responsible to create an Item with optional nested items.
+func NewItem(value string, items ...Item) Item {
+	return Item{
+		Text:  value,
+		Items: items,
+	}
+}
+
+// New is responsible to build the rows of a list. Every item is an automatic row,
+// so the list is split between pages like any other automatic row.
+// The marker is placed in the indentation of the item and the item text wraps after the marker width,
+// or after the widest marker of the level when it does not fit the marker width.
+func New(items []Item, ps ...props.List) []core.Row {
+	prop := props.List{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return buildRows(nil, items, 0, &prop)
+}
+
+func buildRows(rows []core.Row, items []Item, depth int, prop *props.List) []core.Row {
+	level := prop.GetLevel(depth)
+	indent := prop.Text.Left + float64(depth)*prop.Indent
+
+	markers := getWidestMarkers(level, len(items))
+
+	for i, item := range items {
+		markerProp := prop.Text
+		markerProp.Left = indent
+		markerProp.Align = align.Left
+
+		marker := text.New(GetMarker(level, i+1), markerProp)
+		value := newItemText(item.Text, markers, indent, prop)
+
+		rows = append(rows, row.New().Add(col.New().Add(marker, value)))
+		rows = buildRows(rows, item.Items, depth+1, prop)
+	}
+
+	return rows
+}
+
+// getWidestMarkers returns the markers with the most characters of a level with the amount of items,
+// which are the candidates to be the widest marker.
+func getWidestMarkers(level props.ListLevel, amount int) []string {
+	var markers []string
+	length := 0
+	for position := 1; position <= amount; position++ {
+		marker := GetMarker(level, position)
+		markerLength := utf8.RuneCountInString(marker)
+		if markerLength > length {
+			markers = nil
+			length = markerLength
+		}
+
+		if markerLength == length && !slices.Contains(markers, marker) {
+			markers = append(markers, marker)
+		}
+	}
+
+	return markers
+}
+
+// GetMarker returns the marker of the item in the position (starting by 1) inside a level.
+func GetMarker(level props.ListLevel, position int) string {
+	switch level.Style {
+	case liststyle.Decimal:
+		return strconv.Itoa(position) + level.Suffix
+	case liststyle.LowerAlpha:
+		return strings.ToLower(toAlpha(position)) + level.Suffix
+	case liststyle.UpperAlpha:
+		return toAlpha(position) + level.Suffix
+	case liststyle.LowerRoman:
+		return strings.ToLower(toRoman(position)) + level.Suffix
+	case liststyle.UpperRoman:
+		return toRoman(position) + level.Suffix
+	default:
+		return level.Bullet
+	}
+}
+
+// toAlpha converts a position to letters, ex: 1 -> A, 26 -> Z, 27 -> AA.
+func toAlpha(position int) string {
+	var letters string
+	for position > 0 {
+		position--
+		letters = string(rune('A'+position%26)) + letters
+		position /= 26
+	}
+
+	return letters
+}
+
+// toRoman converts a position to roman numbers, ex: 4 -> IV, 14 -> XIV.
+func toRoman(position int) string {
+	values := []int{1000, 900, 500, 400, 100, 90, 50, 40, 10, 9, 5, 4, 1}
+	symbols := []string{"M", "CM", "D", "CD", "C", "XC", "L", "XL", "X", "IX", "V", "IV", "I"}
+
+	var roman string
+	for i, value := range values {
+		for position >= value {
+			roman += symbols[i]
+			position -= value
+		}
+	}
+
+	return roman
+}
@@ -0,0 +1,138 @@
+package itemlist_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/itemlist"
+	"github.com/johnfercher/maroto/v2/pkg/components/page"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/liststyle"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("when prop is not sent, should use bullets", func(t *testing.T) {
+		// Arrange
+		items := buildItems()
+
+		// Act
+		rows := itemlist.New(items)
+		p := page.New().Add(rows...)
+
+		// Assert
+		test.New(t).Assert(p.GetStructure()).Equals("components/itemlists/new_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided levels", func(t *testing.T) {
+		// Arrange
+		items := buildItems()
+		prop := props.List{
+			Levels: []props.ListLevel{
+				{Style: liststyle.Decimal},
+				{Style: liststyle.LowerAlpha, Suffix: ")"},
+			},
+			Indent:      8,
+			MarkerWidth: 6,
+			Text:        props.Text{Left: 2, Size: 12},
+		}
+
+		// Act
+		rows := itemlist.New(items, prop)
+		p := page.New().Add(rows...)
+
+		// Assert
+		test.New(t).Assert(p.GetStructure()).Equals("components/itemlists/new_custom_prop.json")
+	})
+	t.Run("when marker is wider than the marker width, should place the text after the widest marker", func(t *testing.T) {
+		// Arrange
+		var items []itemlist.Item
+		for i := 0; i < 10; i++ {
+			items = append(items, itemlist.NewItem("item"))
+		}
+		prop := props.List{Levels: []props.ListLevel{{Style: liststyle.Decimal}}, MarkerWidth: 3}
+		cell := &entity.Cell{Width: 100}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetStringWidth("10. ", mock.Anything).Return(8)
+		provider.EXPECT().GetLinesQuantity("1.", mock.Anything, 100.0).Return(1)
+		provider.EXPECT().GetLinesQuantity("item", mock.Anything, 92.0).Return(1)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4)
+
+		rows := itemlist.New(items, prop)
+		rows[0].SetConfig(&entity.Config{MaxGridSize: 12, DefaultFont: &props.Font{Family: fontfamily.Arial, Size: 10}})
+
+		// Act
+		height := rows[0].GetHeight(provider, cell)
+
+		// Assert
+		assert.Equal(t, 4.0, height)
+		provider.AssertNumberOfCalls(t, "GetStringWidth", 1)
+	})
+	t.Run("when markers fit the marker width, should place the text after the marker width", func(t *testing.T) {
+		// Arrange
+		items := []itemlist.Item{itemlist.NewItem("item")}
+		prop := props.List{MarkerWidth: 6}
+		cell := &entity.Cell{Width: 100}
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetStringWidth("• ", mock.Anything).Return(2)
+		provider.EXPECT().GetLinesQuantity("•", mock.Anything, 100.0).Return(1)
+		provider.EXPECT().GetLinesQuantity("item", mock.Anything, 94.0).Return(1)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(4)
+
+		rows := itemlist.New(items, prop)
+		rows[0].SetConfig(&entity.Config{MaxGridSize: 12, DefaultFont: &props.Font{Family: fontfamily.Arial, Size: 10}})
+
+		// Act
+		height := rows[0].GetHeight(provider, cell)
+
+		// Assert
+		assert.Equal(t, 4.0, height)
+	})
+	t.Run("when items are empty, should return no rows", func(t *testing.T) {
+		// Act
+		rows := itemlist.New(nil)
+
+		// Assert
+		assert.Empty(t, rows)
+	})
+}
+
+func TestGetMarker(t *testing.T) {
+	cases := []struct {
+		name     string
+		level    props.ListLevel
+		position int
+		expected string
+	}{
+		{"when style is bullet, should return bullet", props.ListLevel{Style: liststyle.Bullet, Bullet: "-"}, 3, "-"},
+		{"when style is decimal, should return number", props.ListLevel{Style: liststyle.Decimal, Suffix: "."}, 12, "12."},
+		{"when style is lower alpha, should return letter", props.ListLevel{Style: liststyle.LowerAlpha, Suffix: ")"}, 2, "b)"},
+		{"when style is upper alpha after z, should return two letters", props.ListLevel{Style: liststyle.UpperAlpha, Suffix: "."}, 28, "AB."},
+		{"when style is lower roman, should return roman number", props.ListLevel{Style: liststyle.LowerRoman, Suffix: "."}, 4, "iv."},
+		{"when style is upper roman, should return roman number", props.ListLevel{Style: liststyle.UpperRoman, Suffix: "."}, 1994, "MCMXCIV."},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, itemlist.GetMarker(c.level, c.position))
+		})
+	}
+}
+
+func buildItems() []itemlist.Item {
+	return []itemlist.Item{
+		itemlist.NewItem("first"),
+		itemlist.NewItem("second",
+			itemlist.NewItem("nested first"),
+			itemlist.NewItem("nested second",
+				itemlist.NewItem("deep nested"),
+			),
+		),
+	}
+}
@@ -0,0 +1,83 @@
+package itemlist
+
+import (
+	"math"
+
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// itemText is the text of an item, which is placed after the widest marker of its level,
+// or after the marker width when the markers are narrower.
+type itemText struct {
+	value       string
+	markers     []string
+	indent      float64
+	markerWidth float64
+	prop        props.Text
+	config      *entity.Config
+}
+
+func newItemText(value string, markers []string, indent float64, listProp *props.List) *itemText {
+	return &itemText{
+		value:       value,
+		markers:     markers,
+		indent:      indent,
+		markerWidth: listProp.MarkerWidth,
+		prop:        listProp.Text,
+	}
+}
+
+// GetStructure returns the Structure of an itemText.
+func (i *itemText) GetStructure() *node.Node[core.Structure] {
+	details := i.prop.ToMap()
+	details["prop_left"] = i.indent
+	details["prop_marker_width"] = i.markerWidth
+
+	str := core.Structure{
+		Type:    "item_text",
+		Value:   i.value,
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height that the text will have in the PDF.
+func (i *itemText) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	return i.getText(provider).GetHeight(provider, cell)
+}
+
+// SetConfig sets the config.
+func (i *itemText) SetConfig(config *entity.Config) {
+	i.config = config
+	i.prop.MakeValid(config.DefaultFont)
+}
+
+// Render renders an itemText into a PDF context.
+func (i *itemText) Render(provider core.Provider, cell *entity.Cell) {
+	i.getText(provider).Render(provider, cell)
+}
+
+func (i *itemText) getText(provider core.Provider) core.Component {
+	prop := i.prop
+	prop.Left = i.indent + i.getMarkerWidth(provider)
+
+	t := text.New(i.value, prop)
+	t.SetConfig(i.config)
+	return t
+}
+
+// getMarkerWidth returns the largest of the marker width and the width of the widest marker followed by a space.
+func (i *itemText) getMarkerWidth(provider core.Provider) float64 {
+	width := i.markerWidth
+	for _, marker := range i.markers {
+		width = math.Max(width, provider.GetStringWidth(marker+" ", &i.prop))
+	}
+
+	return width
+}
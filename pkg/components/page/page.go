@@ -34,6 +34,10 @@ func (p *Page) Render(provider core.Provider, cell entity.Cell) {
 	innerCell := cell.Copy()
 
 	prop := &props.Rect{}
+	if p.config.BackgroundImageProp != nil {
+		backgroundProp := *p.config.BackgroundImageProp
+		prop = &backgroundProp
+	}
 	prop.MakeValid()
 
 	if p.config.BackgroundImage != nil {
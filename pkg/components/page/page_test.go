@@ -7,6 +7,7 @@ import (
 	"github.com/johnfercher/maroto/v2/mocks"
 	"github.com/johnfercher/maroto/v2/pkg/components/image"
 	"github.com/johnfercher/maroto/v2/pkg/components/page"
+	"github.com/johnfercher/maroto/v2/pkg/consts/clip"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/core"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
@@ -102,6 +103,35 @@ func TestPage_Render(t *testing.T) {
 		row.AssertNumberOfCalls(t, "Render", 1)
 		row.AssertNumberOfCalls(t, "GetHeight", 1)
 	})
+	t.Run("when there is background image prop, should send it to provider", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.PageProp()
+		prop.Pattern = ""
+		cfg := &entity.Config{
+			BackgroundImage: &entity.Image{
+				Bytes:     []byte{1, 2, 3},
+				Extension: extension.Jpg,
+			},
+			BackgroundImageProp: &props.Rect{Opacity: 0.3, Clip: clip.Circle},
+		}
+
+		rectProp := &props.Rect{Opacity: 0.3, Clip: clip.Circle}
+		rectProp.MakeValid()
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddBackgroundImageFromBytes(cfg.BackgroundImage.Bytes, &cell, rectProp, cfg.BackgroundImage.Extension)
+
+		sut := page.New(prop)
+		sut.SetConfig(cfg)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddBackgroundImageFromBytes", 1)
+		assert.Equal(t, 0.0, cfg.BackgroundImageProp.Percent)
+	})
 	t.Run("when there is background image and there is page pattern, should call row render and provider correctly", func(t *testing.T) {
 		// Arrange
 		cell := fixture.CellEntity()
@@ -0,0 +1,39 @@
+package shape_test
+
+import (
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/shape"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleNewRectangle demonstrates how to create a frame with rounded corners behind a text.
+func ExampleNewRectangle() {
+	m := maroto.New()
+
+	frame := shape.NewRectangle(props.Shape{Radius: 2, FillColor: &props.Color{Red: 240, Green: 240, Blue: 240}})
+	m.AddRow(20, col.New(12).Add(frame, text.New("callout", props.Text{Top: 8, Left: 4})))
+
+	// generate document
+}
+
+// ExampleNewPolylineCol demonstrates how to create a check mark.
+func ExampleNewPolylineCol() {
+	m := maroto.New()
+
+	check := []props.Point{{X: 10, Y: 55}, {X: 40, Y: 85}, {X: 90, Y: 15}}
+	m.AddRow(10, shape.NewPolylineCol(1, check, props.Shape{StrokeColor: &props.GreenColor, StrokeWidth: 0.8}))
+
+	// generate document
+}
+
+// ExampleNewArrowRow demonstrates how to create a dashed arrow wrapped into a row.
+func ExampleNewArrowRow() {
+	m := maroto.New()
+
+	m.AddRows(shape.NewArrowRow(10, props.Point{X: 0, Y: 50}, props.Point{X: 100, Y: 50},
+		props.Shape{DashPattern: []float64{2, 1}}))
+
+	// generate document
+}
@@ -0,0 +1,216 @@
+// Package shape implements creation of shapes: rectangles, ellipses, circles, polygons, polylines,
+// Bézier paths and arrows. Shapes fill the cell and their points are defined in percent of the cell,
+// so they should be added to rows with a defined height.
+package shape
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/shapetype"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+type Shape struct {
+	shapeType shapetype.Type
+	points    []props.Point
+	prop      props.Shape
+	config    *entity.Config
+}
+
+func newShape(shapeType shapetype.Type, points []props.Point, ps ...props.Shape) *Shape {
+	prop := props.Shape{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid()
+
+	return &Shape{
+		shapeType: shapeType,
+		points:    points,
+		prop:      prop,
+	}
+}
+
+// NewRectangle is responsible to create an instance of a rectangle which fills the cell,
+// the corners are rounded when a radius is defined.
+func NewRectangle(ps ...props.Shape) core.Component {
+	return newShape(shapetype.Rectangle, nil, ps...)
+}
+
+// NewRectangleCol is responsible to create an instance of a rectangle wrapped in a Col.
+func NewRectangleCol(size int, ps ...props.Shape) core.Col {
+	return col.New(size).Add(NewRectangle(ps...))
+}
+
+// NewRectangleRow is responsible to create an instance of a rectangle wrapped in a Row.
+func NewRectangleRow(height float64, ps ...props.Shape) core.Row {
+	return row.New(height).Add(col.New().Add(NewRectangle(ps...)))
+}
+
+// NewEllipse is responsible to create an instance of an ellipse which fills the cell.
+func NewEllipse(ps ...props.Shape) core.Component {
+	return newShape(shapetype.Ellipse, nil, ps...)
+}
+
+// NewEllipseCol is responsible to create an instance of an ellipse wrapped in a Col.
+func NewEllipseCol(size int, ps ...props.Shape) core.Col {
+	return col.New(size).Add(NewEllipse(ps...))
+}
+
+// NewEllipseRow is responsible to create an instance of an ellipse wrapped in a Row.
+func NewEllipseRow(height float64, ps ...props.Shape) core.Row {
+	return row.New(height).Add(col.New().Add(NewEllipse(ps...)))
+}
+
+// NewCircle is responsible to create an instance of a circle centralized in the cell,
+// the diameter is the smallest side of the cell.
+func NewCircle(ps ...props.Shape) core.Component {
+	return newShape(shapetype.Circle, nil, ps...)
+}
+
+// NewCircleCol is responsible to create an instance of a circle wrapped in a Col.
+func NewCircleCol(size int, ps ...props.Shape) core.Col {
+	return col.New(size).Add(NewCircle(ps...))
+}
+
+// NewCircleRow is responsible to create an instance of a circle wrapped in a Row.
+func NewCircleRow(height float64, ps ...props.Shape) core.Row {
+	return row.New(height).Add(col.New().Add(NewCircle(ps...)))
+}
+
+// NewPolygon is responsible to create an instance of a closed shape through the points.
+//   - points: At least 3 points, in percent of the cell
+//   - ps: A set of settings that must be applied to the polygon
+func NewPolygon(points []props.Point, ps ...props.Shape) core.Component {
+	return newShape(shapetype.Polygon, points, ps...)
+}
+
+// NewPolygonCol is responsible to create an instance of a polygon wrapped in a Col.
+func NewPolygonCol(size int, points []props.Point, ps ...props.Shape) core.Col {
+	return col.New(size).Add(NewPolygon(points, ps...))
+}
+
+// NewPolygonRow is responsible to create an instance of a polygon wrapped in a Row.
+func NewPolygonRow(height float64, points []props.Point, ps ...props.Shape) core.Row {
+	return row.New(height).Add(col.New().Add(NewPolygon(points, ps...)))
+}
+
+// NewPolyline is responsible to create an instance of straight lines through the points.
+//   - points: At least 2 points, in percent of the cell
+//   - ps: A set of settings that must be applied to the polyline, the fill color is ignored
+func NewPolyline(points []props.Point, ps ...props.Shape) core.Component {
+	return newShape(shapetype.Polyline, points, ps...)
+}
+
+// NewPolylineCol is responsible to create an instance of a polyline wrapped in a Col.
+func NewPolylineCol(size int, points []props.Point, ps ...props.Shape) core.Col {
+	return col.New(size).Add(NewPolyline(points, ps...))
+}
+
+// NewPolylineRow is responsible to create an instance of a polyline wrapped in a Row.
+func NewPolylineRow(height float64, points []props.Point, ps ...props.Shape) core.Row {
+	return row.New(height).Add(col.New().Add(NewPolyline(points, ps...)))
+}
+
+// NewBezier is responsible to create an instance of a path of cubic Bézier curves.
+//   - points: The start point followed by groups of 3 points for each curve: two control points and the end point
+//   - ps: A set of settings that must be applied to the path
+func NewBezier(points []props.Point, ps ...props.Shape) core.Component {
+	return newShape(shapetype.Bezier, points, ps...)
+}
+
+// NewBezierCol is responsible to create an instance of a Bézier path wrapped in a Col.
+func NewBezierCol(size int, points []props.Point, ps ...props.Shape) core.Col {
+	return col.New(size).Add(NewBezier(points, ps...))
+}
+
+// NewBezierRow is responsible to create an instance of a Bézier path wrapped in a Row.
+func NewBezierRow(height float64, points []props.Point, ps ...props.Shape) core.Row {
+	return row.New(height).Add(col.New().Add(NewBezier(points, ps...)))
+}
+
+// NewArrow is responsible to create an instance of an arrow.
+//   - from: The start of the arrow, in percent of the cell
+//   - to: The point of the arrowhead, in percent of the cell
+//   - ps: A set of settings that must be applied to the arrow, the arrowhead is filled with the stroke color
+func NewArrow(from, to props.Point, ps ...props.Shape) core.Component {
+	return newShape(shapetype.Arrow, []props.Point{from, to}, ps...)
+}
+
+// NewArrowCol is responsible to create an instance of an arrow wrapped in a Col.
+func NewArrowCol(size int, from, to props.Point, ps ...props.Shape) core.Col {
+	return col.New(size).Add(NewArrow(from, to, ps...))
+}
+
+// NewArrowRow is responsible to create an instance of an arrow wrapped in a Row.
+func NewArrowRow(height float64, from, to props.Point, ps ...props.Shape) core.Row {
+	return row.New(height).Add(col.New().Add(NewArrow(from, to, ps...)))
+}
+
+// Render renders a Shape into a PDF context.
+func (s *Shape) Render(provider core.Provider, cell *entity.Cell) {
+	switch s.shapeType {
+	case shapetype.Rectangle:
+		provider.AddRectangle(cell, &s.prop)
+	case shapetype.Ellipse:
+		provider.AddEllipse(cell, &s.prop)
+	case shapetype.Circle:
+		provider.AddEllipse(s.getCircleCell(cell), &s.prop)
+	case shapetype.Polygon:
+		provider.AddPolygon(s.points, cell, &s.prop)
+	case shapetype.Polyline:
+		provider.AddPolyline(s.points, cell, &s.prop)
+	case shapetype.Bezier:
+		provider.AddBezier(s.points, cell, &s.prop)
+	case shapetype.Arrow:
+		provider.AddArrow(s.points[0], s.points[1], cell, &s.prop)
+	}
+}
+
+// GetStructure returns the Structure of a Shape.
+func (s *Shape) GetStructure() *node.Node[core.Structure] {
+	details := s.prop.ToMap()
+	if len(s.points) > 0 {
+		var points []string
+		for _, point := range s.points {
+			points = append(points, point.ToString())
+		}
+		details["points"] = points
+	}
+
+	str := core.Structure{
+		Type:    string(s.shapeType),
+		Details: details,
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height that the shape will have in the PDF,
+// as shapes fill the cell only the stroke width is considered.
+func (s *Shape) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	return s.prop.StrokeWidth
+}
+
+// SetConfig sets the config.
+func (s *Shape) SetConfig(config *entity.Config) {
+	s.config = config
+}
+
+func (s *Shape) getCircleCell(cell *entity.Cell) *entity.Cell {
+	diameter := cell.Width
+	if cell.Height < diameter {
+		diameter = cell.Height
+	}
+
+	return &entity.Cell{
+		X:      cell.X + (cell.Width-diameter)/2,
+		Y:      cell.Y + (cell.Height-diameter)/2,
+		Width:  diameter,
+		Height: diameter,
+	}
+}
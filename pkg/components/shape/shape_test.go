@@ -0,0 +1,230 @@
+package shape_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/shape"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+var triangle = []props.Point{{X: 50, Y: 0}, {X: 100, Y: 100}, {X: 0, Y: 100}}
+
+func TestNewRectangle(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := shape.NewRectangle()
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_rectangle_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Act
+		sut := shape.NewRectangle(fixture.ShapeProp())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_rectangle_custom_prop.json")
+	})
+}
+
+func TestNewRectangleCol(t *testing.T) {
+	// Act
+	sut := shape.NewRectangleCol(12, fixture.ShapeProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_rectangle_col.json")
+}
+
+func TestNewRectangleRow(t *testing.T) {
+	// Act
+	sut := shape.NewRectangleRow(10, fixture.ShapeProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_rectangle_row.json")
+}
+
+func TestNewEllipse(t *testing.T) {
+	// Act
+	sut := shape.NewEllipse(fixture.ShapeProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_ellipse.json")
+}
+
+func TestNewCircle(t *testing.T) {
+	// Act
+	sut := shape.NewCircle(fixture.ShapeProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_circle.json")
+}
+
+func TestNewPolygon(t *testing.T) {
+	// Act
+	sut := shape.NewPolygon(triangle, fixture.ShapeProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_polygon.json")
+}
+
+func TestNewPolyline(t *testing.T) {
+	// Act
+	sut := shape.NewPolyline(triangle, fixture.ShapeProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_polyline.json")
+}
+
+func TestNewBezier(t *testing.T) {
+	// Arrange
+	points := []props.Point{{X: 0, Y: 100}, {X: 0, Y: 0}, {X: 100, Y: 0}, {X: 100, Y: 100}}
+
+	// Act
+	sut := shape.NewBezier(points, fixture.ShapeProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_bezier.json")
+}
+
+func TestNewArrow(t *testing.T) {
+	// Act
+	sut := shape.NewArrow(props.Point{X: 0, Y: 50}, props.Point{X: 100, Y: 50}, fixture.ShapeProp())
+
+	// Assert
+	test.New(t).Assert(sut.GetStructure()).Equals("components/shapes/new_arrow.json")
+}
+
+func TestShape_Render(t *testing.T) {
+	t.Run("when shape is a rectangle, should call provider correctly", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+		sut := shape.NewRectangle(prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddRectangle(&cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddRectangle", 1)
+	})
+	t.Run("when shape is an ellipse, should call provider correctly", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+		sut := shape.NewEllipse(prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddEllipse(&cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddEllipse", 1)
+	})
+	t.Run("when shape is a circle, should call provider with a centralized square cell", func(t *testing.T) {
+		// Arrange
+		cell := entity.Cell{X: 10, Y: 20, Width: 100, Height: 40}
+		prop := fixture.ShapeProp()
+		sut := shape.NewCircle(prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddEllipse(&entity.Cell{X: 40, Y: 20, Width: 40, Height: 40}, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddEllipse", 1)
+	})
+	t.Run("when shape is a polygon, should call provider correctly", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+		sut := shape.NewPolygon(triangle, prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddPolygon(triangle, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddPolygon", 1)
+	})
+	t.Run("when shape is a polyline, should call provider correctly", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+		sut := shape.NewPolyline(triangle, prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddPolyline(triangle, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddPolyline", 1)
+	})
+	t.Run("when shape is a bezier, should call provider correctly", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+		sut := shape.NewBezier(triangle, prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddBezier(triangle, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddBezier", 1)
+	})
+	t.Run("when shape is an arrow, should call provider correctly", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		prop := fixture.ShapeProp()
+		from, to := props.Point{X: 0, Y: 0}, props.Point{X: 100, Y: 100}
+		sut := shape.NewArrow(from, to, prop)
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().AddArrow(from, to, &cell, &prop)
+
+		// Act
+		sut.Render(provider, &cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddArrow", 1)
+	})
+}
+
+func TestShape_GetHeight(t *testing.T) {
+	// Arrange
+	cell := fixture.CellEntity()
+	sut := shape.NewRectangle(props.Shape{StrokeWidth: 0.8})
+
+	// Act
+	height := sut.GetHeight(mocks.NewProvider(t), &cell)
+
+	// Assert
+	assert.Equal(t, 0.8, height)
+}
+
+func TestShape_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := shape.NewRectangle()
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
@@ -0,0 +1,177 @@
+package signature
+
+import (
+	"github.com/johnfercher/go-tree/node"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	defaultSignatureHeight = 15.0
+	// nameTop is the space between the line and the signer name.
+	nameTop = 1.0
+)
+
+// Block is a signature with the image of the signature over the line and the signer name, role, date and note
+// stacked below it.
+type Block struct {
+	signer    entity.Signer
+	prop      props.Signature
+	imageProp props.Rect
+	config    *entity.Config
+}
+
+// blockText is one of the texts printed below the line of a Block.
+type blockText struct {
+	value string
+	prop  *props.Text
+}
+
+// NewBlock is responsible to create an instance of a signature Block.
+//   - signer: The name, role, date, note and image of the person who signs
+//   - ps: A set of settings that must be applied to the block, the role, date and note use DetailFontSize
+func NewBlock(signer entity.Signer, ps ...props.Signature) core.Component {
+	prop := props.Signature{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+	prop.MakeValid(fontfamily.Arial)
+
+	if prop.SignatureHeight <= 0 {
+		prop.SignatureHeight = defaultSignatureHeight
+	}
+
+	if prop.DetailFontSize <= 0 {
+		prop.DetailFontSize = prop.FontSize
+	}
+
+	imageProp := props.Rect{Percent: 100, Align: align.Center, VerticalAlign: align.Bottom}
+	imageProp.MakeValid()
+
+	return &Block{
+		signer:    signer,
+		prop:      prop,
+		imageProp: imageProp,
+	}
+}
+
+// NewBlockCol is responsible to create an instance of a signature Block wrapped in a Col.
+func NewBlockCol(size int, signer entity.Signer, ps ...props.Signature) core.Col {
+	block := NewBlock(signer, ps...)
+	return col.New(size).Add(block)
+}
+
+// NewBlockRow is responsible to create an instance of a signature Block wrapped in a Row.
+func NewBlockRow(height float64, signer entity.Signer, ps ...props.Signature) core.Row {
+	block := NewBlock(signer, ps...)
+	c := col.New().Add(block)
+	return row.New(height).Add(c)
+}
+
+// NewAutoBlockRow is responsible to create an instance of a signature Block wrapped in a automatic Row.
+func NewAutoBlockRow(signer entity.Signer, ps ...props.Signature) core.Row {
+	block := NewBlock(signer, ps...)
+	c := col.New().Add(block)
+	return row.New().Add(c)
+}
+
+// Render renders a Block into a PDF context. The texts are placed at the bottom of the cell and the
+// signature space takes the rest of the height.
+func (b *Block) Render(provider core.Provider, cell *entity.Cell) {
+	texts := b.getTexts()
+	textsHeight := 0.0
+	heights := make([]float64, len(texts))
+	for i, text := range texts {
+		heights[i] = getTextHeight(provider, text, cell.Width)
+		textsHeight += heights[i]
+	}
+
+	signatureHeight := cell.Height - textsHeight - b.prop.LineThickness
+	if signatureHeight < 0 {
+		signatureHeight = 0
+	}
+
+	if b.signer.Image != nil && signatureHeight > 0 {
+		imageCell := &entity.Cell{X: cell.X, Y: cell.Y, Width: cell.Width, Height: signatureHeight}
+		provider.AddImageFromBytes(b.signer.Image.Bytes, imageCell, &b.imageProp, b.signer.Image.Extension)
+	}
+
+	y := cell.Y + signatureHeight
+	lineCell := &entity.Cell{X: cell.X, Y: y, Width: cell.Width, Height: b.prop.LineThickness}
+	provider.AddLine(lineCell, b.prop.ToLineProp(50))
+	y += b.prop.LineThickness
+
+	for i, text := range texts {
+		provider.AddText(text.value, &entity.Cell{X: cell.X, Y: y, Width: cell.Width, Height: heights[i]}, text.prop)
+		y += heights[i]
+	}
+}
+
+// GetStructure returns the Structure of a Block.
+func (b *Block) GetStructure() *node.Node[core.Structure] {
+	str := core.Structure{
+		Type:    "signatureblock",
+		Value:   b.signer.Name,
+		Details: b.signer.AppendMap(b.prop.ToMap()),
+	}
+
+	return node.New(str)
+}
+
+// GetHeight returns the height that the block will have in the PDF, the texts that don't fit the cell width
+// are broken in lines.
+func (b *Block) GetHeight(provider core.Provider, cell *entity.Cell) float64 {
+	height := b.prop.SignatureHeight + b.prop.LineThickness
+	for _, text := range b.getTexts() {
+		height += getTextHeight(provider, text, cell.Width)
+	}
+
+	return height
+}
+
+// SetConfig sets the config.
+func (b *Block) SetConfig(config *entity.Config) {
+	b.config = config
+}
+
+// getTexts returns the texts that are sent, the name uses the signature font and the others the detail font size.
+func (b *Block) getTexts() []blockText {
+	var texts []blockText
+	if b.signer.Name != "" {
+		texts = append(texts, blockText{value: b.signer.Name, prop: b.prop.ToTextProp(align.Center, nameTop, 0)})
+	}
+
+	details := []string{b.signer.Role, b.signer.Date}
+	for _, detail := range details {
+		if detail != "" {
+			texts = append(texts, blockText{value: detail, prop: b.getDetailProp(fontstyle.Normal)})
+		}
+	}
+
+	if b.signer.Note != "" {
+		texts = append(texts, blockText{value: b.signer.Note, prop: b.getDetailProp(fontstyle.Italic)})
+	}
+
+	return texts
+}
+
+func (b *Block) getDetailProp(style fontstyle.Type) *props.Text {
+	prop := b.prop.ToTextProp(align.Center, 0, 0)
+	prop.Style = style
+	prop.Size = b.prop.DetailFontSize
+	return prop
+}
+
+func getTextHeight(provider core.Provider, text blockText, width float64) float64 {
+	prop := text.prop
+	amountLines := provider.GetLinesQuantity(text.value, prop, width-prop.Left-prop.Right)
+	fontHeight := provider.GetFontHeight(&props.Font{Family: prop.Family, Style: prop.Style, Size: prop.Size, Color: prop.Color})
+	return float64(amountLines)*fontHeight + float64(amountLines-1)*prop.VerticalPadding + prop.Top + prop.Bottom
+}
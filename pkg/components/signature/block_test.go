@@ -0,0 +1,155 @@
+package signature_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/components/signature"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+)
+
+func TestNewBlock(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := signature.NewBlock(fixtureSigner())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/signatures/new_signature_block_default_prop.json")
+	})
+	t.Run("when prop is sent, should use the provided", func(t *testing.T) {
+		// Arrange
+		prop := fixture.SignatureProp()
+		prop.SignatureHeight = 20
+		prop.DetailFontSize = 7
+
+		// Act
+		sut := signature.NewBlock(fixtureSigner(), prop)
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/signatures/new_signature_block_custom_prop.json")
+	})
+}
+
+func TestNewBlockCol(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := signature.NewBlockCol(6, fixtureSigner())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/signatures/new_signature_block_col_default_prop.json")
+	})
+}
+
+func TestNewBlockRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := signature.NewBlockRow(40, fixtureSigner())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/signatures/new_signature_block_row_default_prop.json")
+	})
+}
+
+func TestNewAutoBlockRow(t *testing.T) {
+	t.Run("when prop is not sent, should use default", func(t *testing.T) {
+		// Act
+		sut := signature.NewAutoBlockRow(fixtureSigner())
+
+		// Assert
+		test.New(t).Assert(sut.GetStructure()).Equals("components/signatures/new_signature_block_auto_row_default_prop.json")
+	})
+}
+
+func TestBlock_Render(t *testing.T) {
+	t.Run("should place the image over the line and stack the texts below it", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 10, Y: 20, Width: 80, Height: 40}
+		signer := fixtureSigner()
+		sut := signature.NewBlock(signer, props.Signature{LineThickness: 1})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(3)
+		provider.EXPECT().GetLinesQuantity(signer.Note, mock.Anything, 80.0).Return(2)
+		provider.EXPECT().GetLinesQuantity(mock.Anything, mock.Anything, 80.0).Return(1)
+		provider.EXPECT().AddImageFromBytes(signer.Image.Bytes, &entity.Cell{X: 10, Y: 20, Width: 80, Height: 23},
+			mock.Anything, extension.Png)
+		provider.EXPECT().AddLine(&entity.Cell{X: 10, Y: 43, Width: 80, Height: 1}, mock.Anything)
+		provider.EXPECT().AddText(signer.Name, &entity.Cell{X: 10, Y: 44, Width: 80, Height: 4}, mock.Anything)
+		provider.EXPECT().AddText(signer.Role, &entity.Cell{X: 10, Y: 48, Width: 80, Height: 3}, mock.Anything)
+		provider.EXPECT().AddText(signer.Date, &entity.Cell{X: 10, Y: 51, Width: 80, Height: 3}, mock.Anything)
+		provider.EXPECT().AddText(signer.Note, &entity.Cell{X: 10, Y: 54, Width: 80, Height: 6}, mock.Anything)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddImageFromBytes", 1)
+		provider.AssertNumberOfCalls(t, "AddLine", 1)
+		provider.AssertNumberOfCalls(t, "AddText", 4)
+	})
+	t.Run("when image is not sent, should only draw the line and the texts", func(t *testing.T) {
+		// Arrange
+		cell := &entity.Cell{X: 10, Y: 20, Width: 80, Height: 40}
+		sut := signature.NewBlock(entity.Signer{Name: "Maria Silva"}, props.Signature{LineThickness: 1})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(3)
+		provider.EXPECT().GetLinesQuantity("Maria Silva", mock.Anything, 80.0).Return(1)
+		provider.EXPECT().AddLine(&entity.Cell{X: 10, Y: 55, Width: 80, Height: 1}, mock.Anything)
+		provider.EXPECT().AddText("Maria Silva", &entity.Cell{X: 10, Y: 56, Width: 80, Height: 4}, mock.Anything)
+
+		// Act
+		sut.Render(provider, cell)
+
+		// Assert
+		provider.AssertNumberOfCalls(t, "AddImageFromBytes", 0)
+		provider.AssertNumberOfCalls(t, "AddText", 1)
+	})
+}
+
+func TestBlock_SetConfig(t *testing.T) {
+	t.Run("should call correctly", func(t *testing.T) {
+		// Arrange
+		sut := signature.NewBlock(fixtureSigner())
+
+		// Act
+		sut.SetConfig(nil)
+	})
+}
+
+func TestBlock_GetHeight(t *testing.T) {
+	t.Run("should sum the signature space, the line and the lines of each text", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellEntity()
+		signer := fixtureSigner()
+		sut := signature.NewBlock(signer, props.Signature{LineThickness: 1, SignatureHeight: 20})
+
+		provider := mocks.NewProvider(t)
+		provider.EXPECT().GetFontHeight(mock.Anything).Return(3)
+		provider.EXPECT().GetLinesQuantity(signer.Note, mock.Anything, cell.Width).Return(2)
+		provider.EXPECT().GetLinesQuantity(mock.Anything, mock.Anything, cell.Width).Return(1)
+
+		// Act
+		height := sut.GetHeight(provider, &cell)
+
+		// Assert
+		assert.Equal(t, 20.0+1+4+3+3+6, height)
+	})
+}
+
+func fixtureSigner() entity.Signer {
+	return entity.Signer{
+		Name:  "Maria Silva",
+		Role:  "Chief Executive Officer",
+		Date:  "2024-05-01",
+		Note:  "Signed electronically on 2024-05-01 10:32 UTC",
+		Image: &entity.Image{Bytes: []byte{1, 2, 3}, Extension: extension.Png},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/johnfercher/maroto/v2"
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
 	"github.com/johnfercher/maroto/v2/pkg/components/signature"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 )
 
 // ExampleNew demonstrates how to create a signature component.
@@ -36,3 +37,30 @@ func ExampleNewRow() {
 
 	// generate document
 }
+
+// ExampleNewBlock demonstrates how to create a signature block with the signer details.
+func ExampleNewBlock() {
+	m := maroto.New()
+
+	signer := entity.Signer{
+		Name: "Maria Silva",
+		Role: "Chief Executive Officer",
+		Date: "2024-05-01",
+		Note: "Signed electronically",
+	}
+	block := signature.NewBlock(signer)
+	col := col.New(6).Add(block)
+	m.AddAutoRow(col)
+
+	// generate document
+}
+
+// ExampleNewAutoBlockRow demonstrates how to create a signature block wrapped into a row with automatic height.
+func ExampleNewAutoBlockRow() {
+	m := maroto.New()
+
+	signer := entity.Signer{Name: "Maria Silva", Role: "Chief Executive Officer"}
+	m.AddRows(signature.NewAutoBlockRow(signer))
+
+	// generate document
+}
@@ -0,0 +1,48 @@
+package table
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/consts/pagesize"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// autoCol is a column whose size depends on the max grid size, which is only known when the
+// config is set, so the column is created again with its size when the config is set.
+type autoCol struct {
+	core.Col
+	getSize    func(maxGridSize int) int
+	components []core.Component
+	style      *props.Cell
+}
+
+// newAutoCol is responsible to create a column sized by the max grid size of the config,
+// until the config is set the default max grid size is used.
+func newAutoCol(getSize func(maxGridSize int) int, components ...core.Component) core.Col {
+	return &autoCol{
+		Col:        col.New(getSize(pagesize.DefaultMaxGridSum)).Add(components...),
+		getSize:    getSize,
+		components: components,
+	}
+}
+
+// Add is responsible to add a component to the column.
+func (c *autoCol) Add(components ...core.Component) core.Col {
+	c.components = append(c.components, components...)
+	c.Col.Add(components...)
+	return c
+}
+
+// WithStyle sets the style for the column.
+func (c *autoCol) WithStyle(style *props.Cell) core.Col {
+	c.style = style
+	c.Col.WithStyle(style)
+	return c
+}
+
+// SetConfig creates the column with the size given by the max grid size and set its config.
+func (c *autoCol) SetConfig(config *entity.Config) {
+	c.Col = col.New(c.getSize(config.MaxGridSize)).Add(c.components...).WithStyle(c.style)
+	c.Col.SetConfig(config)
+}
@@ -0,0 +1,81 @@
+package table_test
+
+import (
+	"fmt"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/table"
+	"github.com/johnfercher/maroto/v2/pkg/consts/aggregate"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// ExampleBuild demonstrates how to create a table from a collection of structs.
+func ExampleBuild() {
+	type Item struct {
+		Description string
+		Price       float64 `maroto:"price"`
+	}
+
+	items := []Item{
+		{Description: "item 0", Price: 10},
+		{Description: "item 1", Price: 12.5},
+	}
+
+	columns := []table.Column[Item]{
+		{Title: "Description", Field: "Description", Size: 8},
+		{Title: "Price", Field: "price", Size: 4, Align: align.Right, Format: func(value any) string {
+			return fmt.Sprintf("$ %.2f", value)
+		}},
+	}
+
+	rows, _ := table.Build(items, columns, props.Table{
+		StripeStyle:  &props.Cell{BackgroundColor: &props.Color{Red: 230, Green: 230, Blue: 230}},
+		EmptyMessage: "no items",
+	})
+
+	m := maroto.New()
+	m.AddRows(rows...)
+
+	// generate document
+}
+
+// ExampleBuildGrouped demonstrates how to create a table grouped by a key with subtotals and a total.
+func ExampleBuildGrouped() {
+	type Transaction struct {
+		Month       string
+		Description string
+		Amount      float64
+	}
+
+	transactions := []Transaction{
+		{Month: "January", Description: "transaction 0", Amount: 10},
+		{Month: "January", Description: "transaction 1", Amount: 12.5},
+		{Month: "February", Description: "transaction 2", Amount: 7},
+	}
+
+	columns := []table.Column[Transaction]{
+		{Title: "Description", Field: "Description", Size: 8},
+		{Title: "Amount", Field: "Amount", Size: 4, Align: align.Right},
+	}
+
+	group := table.Group[Transaction]{
+		Key: func(t Transaction) string {
+			return t.Month
+		},
+		Aggregates: []table.Aggregate[Transaction]{
+			{Column: 1, Function: aggregate.Sum},
+		},
+	}
+
+	rows, _ := table.BuildGrouped(transactions, columns, group, props.Table{
+		GroupHeaderText: props.Text{Style: fontstyle.Bold},
+		FooterText:      props.Text{Style: fontstyle.Bold},
+	})
+
+	m := maroto.New()
+	m.AddRows(rows...)
+
+	// generate document
+}
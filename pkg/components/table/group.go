@@ -0,0 +1,277 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/aggregate"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+const (
+	defaultSubtotalLabel = "Subtotal"
+	defaultTotalLabel    = "Total"
+)
+
+// Group defines how the elements of a table are grouped and summarized.
+type Group[T any] struct {
+	// Key gets the group key of an element, elements are grouped in the order their keys first appear.
+	Key func(element T) string
+	// Title converts the group key to the text of the group header.
+	// Default: the group key.
+	Title func(key string) string
+	// Aggregates defines the values written in the subtotal and total rows.
+	Aggregates []Aggregate[T]
+	// SubtotalLabel is the text written in the first column of the subtotal rows.
+	// Default: "Subtotal"
+	SubtotalLabel string
+	// TotalLabel is the text written in the first column of the total row.
+	// Default: "Total"
+	TotalLabel string
+}
+
+// Aggregate defines a value computed from the elements of a group and written under a column.
+type Aggregate[T any] struct {
+	// Column is the index of the column where the aggregate is written, starting at 0.
+	Column int
+	// Function is the aggregate function applied to the values.
+	Function aggregate.Type
+	// Value gets the numeric value of an element.
+	// Default: the value of the column converted to float64.
+	Value func(element T) float64
+	// Format converts the aggregate result to text.
+	// Default: fmt.Sprintf("%.2f", value), or fmt.Sprintf("%d", value) for aggregate.Count.
+	Format func(value float64) string
+}
+
+// groupedRow is a row which repeats its group header when moved to a new page.
+type groupedRow struct {
+	core.Row
+	headers []core.Row
+}
+
+// GetHeaderRows returns the rows repeated before this row when it is moved to a new page.
+func (g *groupedRow) GetHeaderRows() []core.Row {
+	return g.headers
+}
+
+// BuildGrouped is responsible to receive a collection of elements, the columns definition
+// and the group definition and build a table with a header, a subtotal row for each group
+// and a total row. The group header is repeated when a group is split across pages.
+func BuildGrouped[T any](elements []T, columns []Column[T], group Group[T], ps ...props.Table) ([]core.Row, error) {
+	prop := props.Table{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+
+	if group.Key == nil {
+		return nil, errors.New("group key not defined")
+	}
+
+	if group.SubtotalLabel == "" {
+		group.SubtotalLabel = defaultSubtotalLabel
+	}
+
+	if group.TotalLabel == "" {
+		group.TotalLabel = defaultTotalLabel
+	}
+
+	getters, err := getValueGetters(columns)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregates, err := getAggregateGetters(group.Aggregates, getters)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(elements) == 0 {
+		return Build(elements, columns, prop)
+	}
+
+	keys, groups := split(elements, group.Key)
+
+	rows := []core.Row{buildHeader(columns, &prop)}
+	for _, key := range keys {
+		groupRows, err := buildGroup(key, groups[key], columns, getters, group, aggregates, &prop)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, groupRows...)
+	}
+
+	totalRow, err := buildFooter(group.TotalLabel, elements, columns, group, aggregates, &prop)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(rows, totalRow), nil
+}
+
+// getAggregateGetters validates the aggregates and returns, for each aggregate, a function to get its value.
+func getAggregateGetters[T any](aggregates []Aggregate[T], getters []func(T) (any, error)) ([]func(T) (float64, error), error) {
+	aggregateGetters := make([]func(T) (float64, error), len(aggregates))
+	for i, agg := range aggregates {
+		if !agg.Function.IsValid() {
+			return nil, fmt.Errorf("invalid aggregate function %s", agg.Function)
+		}
+
+		if agg.Column < 0 || agg.Column >= len(getters) {
+			return nil, fmt.Errorf("column %d not found", agg.Column)
+		}
+
+		if agg.Value != nil {
+			value := agg.Value
+			aggregateGetters[i] = func(element T) (float64, error) {
+				return value(element), nil
+			}
+			continue
+		}
+
+		getter := getters[agg.Column]
+		aggregateGetters[i] = func(element T) (float64, error) {
+			value, err := getter(element)
+			if err != nil {
+				return 0, err
+			}
+
+			return toFloat(value)
+		}
+	}
+
+	return aggregateGetters, nil
+}
+
+func split[T any](elements []T, getKey func(element T) string) ([]string, map[string][]T) {
+	var keys []string
+	groups := make(map[string][]T)
+	for _, element := range elements {
+		key := getKey(element)
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], element)
+	}
+
+	return keys, groups
+}
+
+func buildGroup[T any](key string, elements []T, columns []Column[T], getters []func(T) (any, error), group Group[T],
+	aggregates []func(T) (float64, error), prop *props.Table,
+) ([]core.Row, error) {
+	title := key
+	if group.Title != nil {
+		title = group.Title(key)
+	}
+
+	header := newRow(prop).Add(newSpanCol(columns, title, prop.GroupHeaderText))
+	if prop.GroupHeaderStyle != nil {
+		header.WithStyle(prop.GroupHeaderStyle)
+	}
+
+	headers := []core.Row{header}
+	rows := []core.Row{header}
+	for i, element := range elements {
+		contentRow, err := buildContent(element, i, columns, getters, prop)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, &groupedRow{Row: contentRow, headers: headers})
+	}
+
+	subtotalRow, err := buildFooter(group.SubtotalLabel, elements, columns, group, aggregates, prop)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(rows, &groupedRow{Row: subtotalRow, headers: headers}), nil
+}
+
+func buildFooter[T any](label string, elements []T, columns []Column[T], group Group[T],
+	aggregates []func(T) (float64, error), prop *props.Table,
+) (core.Row, error) {
+	values := make([]string, len(columns))
+	values[0] = label
+
+	for i, agg := range group.Aggregates {
+		result, err := aggregateValues(agg.Function, aggregates[i], elements)
+		if err != nil {
+			return nil, err
+		}
+
+		values[agg.Column] = formatAggregate(agg, result)
+	}
+
+	var cols []core.Col
+	for i := range columns {
+		cols = append(cols, newCol(columns, i, values[i], prop.FooterText))
+	}
+
+	r := newRow(prop).Add(cols...)
+	if prop.FooterStyle != nil {
+		r.WithStyle(prop.FooterStyle)
+	}
+
+	return r, nil
+}
+
+func aggregateValues[T any](function aggregate.Type, getter func(T) (float64, error), elements []T) (float64, error) {
+	if function == aggregate.Count {
+		return float64(len(elements)), nil
+	}
+
+	var result float64
+	for i, element := range elements {
+		value, err := getter(element)
+		if err != nil {
+			return 0, err
+		}
+
+		switch {
+		case i == 0 && function != aggregate.Sum && function != aggregate.Avg:
+			result = value
+		case function == aggregate.Min:
+			result = math.Min(result, value)
+		case function == aggregate.Max:
+			result = math.Max(result, value)
+		default:
+			result += value
+		}
+	}
+
+	if function == aggregate.Avg {
+		result /= float64(len(elements))
+	}
+
+	return result, nil
+}
+
+func formatAggregate[T any](agg Aggregate[T], value float64) string {
+	if agg.Format != nil {
+		return agg.Format(value)
+	}
+
+	if agg.Function == aggregate.Count {
+		return fmt.Sprintf("%d", int(value))
+	}
+
+	return fmt.Sprintf("%.2f", value)
+}
+
+func toFloat(value any) (float64, error) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	default:
+		return 0, fmt.Errorf("value %v is not numeric", value)
+	}
+}
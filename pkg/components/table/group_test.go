@@ -0,0 +1,145 @@
+package table_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/page"
+	"github.com/johnfercher/maroto/v2/pkg/components/table"
+	"github.com/johnfercher/maroto/v2/pkg/consts/aggregate"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildGrouped(t *testing.T) {
+	t.Run("when group key is not defined, should return error", func(t *testing.T) {
+		// Act
+		rows, err := table.BuildGrouped(buildProducts(2), buildColumns(), table.Group[product]{})
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, rows)
+	})
+	t.Run("when aggregate column does not exist, should return error", func(t *testing.T) {
+		// Arrange
+		group := buildGroup()
+		group.Aggregates = []table.Aggregate[product]{{Column: 4, Function: aggregate.Sum}}
+
+		// Act
+		rows, err := table.BuildGrouped(buildProducts(2), buildColumns(), group)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, rows)
+	})
+	t.Run("when aggregate function is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		group := buildGroup()
+		group.Aggregates = []table.Aggregate[product]{{Column: 1, Function: "invalid"}}
+
+		// Act
+		rows, err := table.BuildGrouped(buildProducts(2), buildColumns(), group)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, rows)
+	})
+	t.Run("when aggregate column is not numeric, should return error", func(t *testing.T) {
+		// Arrange
+		group := buildGroup()
+		group.Aggregates = []table.Aggregate[product]{{Column: 0, Function: aggregate.Sum}}
+
+		// Act
+		rows, err := table.BuildGrouped(buildProducts(2), buildColumns(), group)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, rows)
+	})
+	t.Run("when elements are empty and there is an empty message, should return header and message", func(t *testing.T) {
+		// Act
+		rows, err := table.BuildGrouped(nil, buildColumns(), buildGroup(), props.Table{EmptyMessage: "no products"})
+		p := page.New().Add(rows...)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(p.GetStructure()).Equals("components/tables/build_empty.json")
+	})
+	t.Run("when elements are valid, should return group headers, subtotals and total", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellProp()
+		prop := props.Table{
+			HeaderStyle:      &cell,
+			GroupHeaderText:  props.Text{Size: 11},
+			GroupHeaderStyle: &cell,
+			FooterStyle:      &cell,
+			RowHeight:        5,
+		}
+
+		// Act
+		rows, err := table.BuildGrouped(buildProducts(5), buildColumns(), buildGroup(), prop)
+		p := page.New().Add(rows...)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(p.GetStructure()).Equals("components/tables/build_grouped.json")
+	})
+	t.Run("when columns have the same title, should write the aggregate in the column of its index", func(t *testing.T) {
+		// Arrange
+		columns := []table.Column[product]{
+			{Title: "Price", Field: "price"},
+			{Title: "Price", Value: func(p product) any { return p.Price * 2 }},
+		}
+		group := table.Group[product]{
+			Key:        func(product) string { return "all" },
+			Aggregates: []table.Aggregate[product]{{Column: 1, Function: aggregate.Sum}},
+		}
+
+		// Act
+		rows, err := table.BuildGrouped(buildProducts(3), columns, group)
+
+		// Assert
+		assert.Nil(t, err)
+		subtotal := rows[len(rows)-2].GetColumns()
+		assert.Equal(t, "Subtotal", subtotal[0].GetStructure().GetNexts()[0].GetData().Value)
+		assert.Equal(t, "9.00", subtotal[1].GetStructure().GetNexts()[0].GetData().Value)
+	})
+	t.Run("when elements are valid, should repeat group header on content and subtotal rows", func(t *testing.T) {
+		// Act
+		rows, err := table.BuildGrouped(buildProducts(2), buildColumns(), buildGroup())
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, rows, 8)
+		for _, r := range rows[2:4] {
+			headedRow, ok := r.(core.HeadedRow)
+			assert.True(t, ok)
+			assert.Equal(t, []core.Row{rows[1]}, headedRow.GetHeaderRows())
+		}
+		_, ok := rows[7].(core.HeadedRow)
+		assert.False(t, ok)
+	})
+}
+
+func buildGroup() table.Group[product] {
+	return table.Group[product]{
+		Key: func(p product) string {
+			return fmt.Sprintf("%t", p.Quantity != nil)
+		},
+		Title: func(key string) string {
+			return "in stock: " + key
+		},
+		Aggregates: []table.Aggregate[product]{
+			{Column: 1, Function: aggregate.Sum},
+			{Column: 2, Function: aggregate.Count},
+			{Column: 3, Function: aggregate.Max, Value: func(p product) float64 {
+				return float64(len(p.Name))
+			}, Format: func(value float64) string {
+				return fmt.Sprintf("%.0f", value)
+			}},
+		},
+	}
+}
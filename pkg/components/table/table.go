@@ -0,0 +1,256 @@
+// Package table implements creation of tables from a collection of elements.
+package table
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/johnfercher/maroto/v2/pkg/components/row"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/core"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// tagName is the struct tag which can be used to name a field, ex: `maroto:"price"`.
+const tagName = "maroto"
+
+// Column defines how a value of an element is written in a table.
+type Column[T any] struct {
+	// Title is the text of the column header.
+	Title string
+	// Size is the grid size of the column.
+	// Default: the max grid size divided by the amount of columns, the last column also receives the remainder.
+	Size int
+	// Field is the name of the struct field, or the name defined by the `maroto` struct tag,
+	// used to get the column value. It is required when Value is not defined.
+	Field string
+	// Value gets the column value from an element, when defined Field is ignored.
+	Value func(element T) any
+	// Format converts the column value to text.
+	// Default: fmt.Sprintf("%v", value)
+	Format func(value any) string
+	// Align of the header and content texts.
+	// Default: the align of the table props.
+	Align align.Type
+}
+
+// Build is responsible to receive a collection of elements and the columns definition
+// and build the header and content rows of a table.
+func Build[T any](elements []T, columns []Column[T], ps ...props.Table) ([]core.Row, error) {
+	prop := props.Table{}
+	if len(ps) > 0 {
+		prop = ps[0]
+	}
+
+	getters, err := getValueGetters(columns)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(elements) == 0 && prop.EmptyMessage == "" {
+		return nil, errors.New("empty array")
+	}
+
+	rows := []core.Row{buildHeader(columns, &prop)}
+
+	if len(elements) == 0 {
+		rows = append(rows, newRow(&prop).Add(newSpanCol(columns, prop.EmptyMessage, prop.ContentText)))
+		return rows, nil
+	}
+
+	for i, element := range elements {
+		r, err := buildContent(element, i, columns, getters, &prop)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r)
+	}
+
+	return rows, nil
+}
+
+func buildHeader[T any](columns []Column[T], prop *props.Table) core.Row {
+	var cols []core.Col
+	for i, column := range columns {
+		cols = append(cols, newCol(columns, i, column.Title, prop.HeaderText))
+	}
+
+	r := newRow(prop).Add(cols...)
+	if prop.HeaderStyle != nil {
+		r.WithStyle(prop.HeaderStyle)
+	}
+
+	return r
+}
+
+func buildContent[T any](element T, index int, columns []Column[T], getters []func(T) (any, error),
+	prop *props.Table,
+) (core.Row, error) {
+	var cols []core.Col
+	for i, column := range columns {
+		value, err := getters[i](element)
+		if err != nil {
+			return nil, err
+		}
+
+		cols = append(cols, newCol(columns, i, format(column, value), prop.ContentText))
+	}
+
+	r := newRow(prop).Add(cols...)
+	if prop.StripeStyle != nil && index%2 == 1 {
+		r.WithStyle(prop.StripeStyle)
+	}
+
+	return r, nil
+}
+
+func newRow(prop *props.Table) core.Row {
+	if prop.RowHeight > 0 {
+		return row.New(prop.RowHeight)
+	}
+
+	return row.New()
+}
+
+func newCol[T any](columns []Column[T], index int, value string, textProp props.Text) core.Col {
+	if columns[index].Align != "" {
+		textProp.Align = columns[index].Align
+	}
+
+	return newAutoCol(func(maxGridSize int) int {
+		return getSize(columns, index, maxGridSize)
+	}, text.New(value, textProp))
+}
+
+// newSpanCol creates a column as wide as all the columns of the table together.
+func newSpanCol[T any](columns []Column[T], value string, textProp props.Text) core.Col {
+	return newAutoCol(func(maxGridSize int) int {
+		return getSizeSum(columns, maxGridSize)
+	}, text.New(value, textProp))
+}
+
+// getSize returns the size of a column, the columns without size share the max grid size
+// and the last one receives the remainder, so they fill the whole row.
+func getSize[T any](columns []Column[T], index, maxGridSize int) int {
+	if columns[index].Size > 0 {
+		return columns[index].Size
+	}
+
+	size := maxGridSize / len(columns)
+	if index == len(columns)-1 {
+		size += maxGridSize % len(columns)
+	}
+
+	return size
+}
+
+func getSizeSum[T any](columns []Column[T], maxGridSize int) int {
+	sum := 0
+	for i := range columns {
+		sum += getSize(columns, i, maxGridSize)
+	}
+
+	return sum
+}
+
+func format[T any](column Column[T], value any) string {
+	if column.Format != nil {
+		return column.Format(value)
+	}
+
+	if value == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+// getValueGetters validates the columns and returns, for each column, a function to get its value.
+func getValueGetters[T any](columns []Column[T]) ([]func(T) (any, error), error) {
+	if len(columns) == 0 {
+		return nil, errors.New("no column defined")
+	}
+
+	getters := make([]func(T) (any, error), len(columns))
+	for i, column := range columns {
+		if column.Value != nil {
+			value := column.Value
+			getters[i] = func(element T) (any, error) {
+				return value(element), nil
+			}
+			continue
+		}
+
+		if column.Field == "" {
+			return nil, fmt.Errorf("column %s must define a field or a value", column.Title)
+		}
+
+		getter, err := getFieldGetter[T](column.Field)
+		if err != nil {
+			return nil, err
+		}
+		getters[i] = getter
+	}
+
+	return getters, nil
+}
+
+func getFieldGetter[T any](field string) (func(T) (any, error), error) {
+	elementType := reflect.TypeOf((*T)(nil)).Elem()
+	for elementType.Kind() == reflect.Pointer {
+		elementType = elementType.Elem()
+	}
+
+	if elementType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("column field %s requires a struct element, got %s", field, elementType.Kind())
+	}
+
+	fieldIndex, ok := getFieldIndex(elementType, field)
+	if !ok {
+		return nil, fmt.Errorf("field %s not found in %s", field, elementType.Name())
+	}
+
+	return func(element T) (any, error) {
+		value := reflect.ValueOf(element)
+		for value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				return nil, errors.New("nil element in array")
+			}
+			value = value.Elem()
+		}
+
+		// A field promoted through a nil embedded pointer has no value, so the cell is empty.
+		fieldValue, err := value.FieldByIndexErr(fieldIndex)
+		if err != nil {
+			return nil, nil
+		}
+
+		if fieldValue.Kind() == reflect.Pointer && fieldValue.IsNil() {
+			return nil, nil
+		}
+
+		return reflect.Indirect(fieldValue).Interface(), nil
+	}, nil
+}
+
+func getFieldIndex(elementType reflect.Type, field string) ([]int, bool) {
+	for _, structField := range reflect.VisibleFields(elementType) {
+		tag := structField.Tag.Get(tagName)
+		if !structField.IsExported() || tag == "" {
+			continue
+		}
+
+		if tag == field {
+			return structField.Index, true
+		}
+	}
+
+	structField, ok := elementType.FieldByName(field)
+	if !ok || !structField.IsExported() {
+		return nil, false
+	}
+
+	return structField.Index, true
+}
@@ -0,0 +1,209 @@
+package table_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/components/page"
+	"github.com/johnfercher/maroto/v2/pkg/components/table"
+	"github.com/johnfercher/maroto/v2/pkg/config"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/johnfercher/maroto/v2/pkg/test"
+	"github.com/stretchr/testify/assert"
+)
+
+type details struct {
+	Brand string
+}
+
+type detailedProduct struct {
+	*details
+	Name string
+}
+
+type product struct {
+	Name     string
+	Price    float64 `maroto:"price"`
+	Quantity *int
+	secret   string
+}
+
+func TestBuild(t *testing.T) {
+	t.Run("when there is no column, should return error", func(t *testing.T) {
+		// Act
+		rows, err := table.Build(buildProducts(2), nil)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, rows)
+	})
+	t.Run("when field does not exist, should return error", func(t *testing.T) {
+		// Arrange
+		columns := []table.Column[product]{{Title: "Invalid", Field: "Invalid"}}
+
+		// Act
+		rows, err := table.Build(buildProducts(2), columns)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, rows)
+	})
+	t.Run("when column has neither field nor value, should return error", func(t *testing.T) {
+		// Arrange
+		columns := []table.Column[product]{{Title: "Empty"}}
+
+		// Act
+		rows, err := table.Build(buildProducts(2), columns)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, rows)
+	})
+	t.Run("when field is not exported, should return error", func(t *testing.T) {
+		// Arrange
+		columns := []table.Column[product]{{Title: "Secret", Field: "secret"}}
+
+		// Act
+		rows, err := table.Build(buildProducts(2), columns)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, rows)
+	})
+	t.Run("when element is not a struct and field is used, should return error", func(t *testing.T) {
+		// Arrange
+		columns := []table.Column[string]{{Title: "Value", Field: "Value"}}
+
+		// Act
+		rows, err := table.Build([]string{"value"}, columns)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, rows)
+	})
+	t.Run("when elements are empty and there is no empty message, should return error", func(t *testing.T) {
+		// Act
+		rows, err := table.Build(nil, buildColumns())
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, rows)
+	})
+	t.Run("when elements are empty and there is an empty message, should return header and message", func(t *testing.T) {
+		// Act
+		rows, err := table.Build(nil, buildColumns(), props.Table{EmptyMessage: "no products"})
+		p := page.New().Add(rows...)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(p.GetStructure()).Equals("components/tables/build_empty.json")
+	})
+	t.Run("when element pointer is nil, should return error", func(t *testing.T) {
+		// Arrange
+		columns := []table.Column[*product]{{Title: "Name", Field: "Name"}}
+
+		// Act
+		rows, err := table.Build([]*product{nil}, columns)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, rows)
+	})
+	t.Run("when elements are valid, should return header and content rows", func(t *testing.T) {
+		// Arrange
+		cell := fixture.CellProp()
+		prop := props.Table{
+			HeaderText:  props.Text{Size: 12},
+			HeaderStyle: &cell,
+			StripeStyle: &cell,
+			RowHeight:   5,
+		}
+
+		// Act
+		rows, err := table.Build(buildProducts(3), buildColumns(), prop)
+		p := page.New().Add(rows...)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(p.GetStructure()).Equals("components/tables/build.json")
+	})
+	t.Run("when embedded pointer is nil, should write an empty cell", func(t *testing.T) {
+		// Arrange
+		elements := []detailedProduct{{Name: "product"}}
+		columns := []table.Column[detailedProduct]{{Title: "Name", Field: "Name"}, {Title: "Brand", Field: "Brand"}}
+
+		// Act
+		rows, err := table.Build(elements, columns)
+
+		// Assert
+		assert.Nil(t, err)
+		cell := rows[1].GetColumns()[1].GetStructure().GetNexts()[0].GetData()
+		assert.Equal(t, "", cell.Value)
+	})
+	t.Run("when columns have no size, should share the max grid size of the config", func(t *testing.T) {
+		// Arrange
+		cfg := config.NewBuilder().WithMaxGridSize(20).Build()
+		columns := []table.Column[product]{
+			{Title: "Name", Field: "Name"},
+			{Title: "Price", Field: "price"},
+			{Title: "Quantity", Field: "Quantity"},
+		}
+
+		// Act
+		rows, err := table.Build(buildProducts(1), columns)
+		maroto.New(cfg).AddRows(rows...)
+
+		// Assert
+		assert.Nil(t, err)
+		cols := rows[1].GetColumns()
+		assert.Equal(t, 6, cols[0].GetSize())
+		assert.Equal(t, 6, cols[1].GetSize())
+		assert.Equal(t, 8, cols[2].GetSize())
+	})
+	t.Run("when elements are pointers, should return header and content rows", func(t *testing.T) {
+		// Arrange
+		products := buildProducts(2)
+		columns := []table.Column[*product]{{Title: "Name", Field: "Name"}, {Title: "Price", Field: "price"}}
+
+		// Act
+		rows, err := table.Build([]*product{&products[0], &products[1]}, columns)
+		p := page.New().Add(rows...)
+
+		// Assert
+		assert.Nil(t, err)
+		test.New(t).Assert(p.GetStructure()).Equals("components/tables/build_from_pointer.json")
+	})
+}
+
+func buildColumns() []table.Column[product] {
+	return []table.Column[product]{
+		{Title: "Name", Field: "Name", Size: 6},
+		{Title: "Price", Field: "price", Size: 3, Align: align.Right, Format: func(value any) string {
+			return fmt.Sprintf("$ %.2f", value)
+		}},
+		{Title: "Quantity", Field: "Quantity", Size: 2, Align: align.Center},
+		{Title: "Code", Size: 1, Value: func(p product) any {
+			return len(p.Name)
+		}},
+	}
+}
+
+func buildProducts(qtd int) []product {
+	var products []product
+	for i := 0; i < qtd; i++ {
+		product := product{
+			Name:  fmt.Sprintf("product(%d)", i),
+			Price: float64(i) * 1.5,
+		}
+		if i%2 == 0 {
+			quantity := i * 10
+			product.Quantity = &quantity
+		}
+		products = append(products, product)
+	}
+
+	return products
+}
@@ -2,6 +2,7 @@
 package config
 
 import (
+	"io/fs"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/consts/pagesize"
 	"github.com/johnfercher/maroto/v2/pkg/consts/provider"
+	"github.com/johnfercher/maroto/v2/pkg/loader"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
@@ -46,8 +48,13 @@ type Builder interface {
 	WithCreationDate(time time.Time) Builder
 	WithCustomFonts([]*entity.CustomFont) Builder
 	WithBackgroundImage([]byte, extension.Type) Builder
+	WithBackgroundImageProp(prop props.Rect) Builder
 	WithDisableAutoPageBreak(disabled bool) Builder
 	WithKeywords(keywordsStr string, isUTF8 bool) Builder
+	WithImageMaxDPI(dpi float64) Builder
+	WithImageJPEGQuality(quality int) Builder
+	WithFileSystem(fsys fs.FS) Builder
+	WithLoader(loader loader.Loader) Builder
 	Build() *entity.Config
 }
 
@@ -67,8 +74,11 @@ type CfgBuilder struct {
 	orientation          orientation.Type
 	metadata             *entity.Metadata
 	backgroundImage      *entity.Image
+	backgroundImageProp  *props.Rect
 	disableAutoPageBreak bool
 	generationMode       generation.Mode
+	imageOptimization    *entity.ImageOptimization
+	loader               loader.Loader
 }
 
 // NewBuilder is responsible to create an instance of Builder.
@@ -90,6 +100,7 @@ func NewBuilder() Builder {
 		},
 		generationMode: generation.Sequential,
 		chunkWorkers:   1,
+		loader:         loader.New(),
 	}
 }
 
@@ -376,6 +387,12 @@ func (b *CfgBuilder) WithBackgroundImage(bytes []byte, ext extension.Type) Build
 	return b
 }
 
+// WithBackgroundImageProp defines how the background image is drawn, like its opacity, grayscale or clip.
+func (b *CfgBuilder) WithBackgroundImageProp(prop props.Rect) Builder {
+	b.backgroundImageProp = &prop
+	return b
+}
+
 // WithDisableAutoPageBreak defines the option to disable automatic page breaks.
 func (b *CfgBuilder) WithDisableAutoPageBreak(disabled bool) Builder {
 	b.disableAutoPageBreak = disabled
@@ -400,6 +417,57 @@ func (b *CfgBuilder) WithKeywords(keywordsStr string, isUTF8 bool) Builder {
 	return b
 }
 
+// WithImageMaxDPI defines the maximum pixel density of the images at their printed size,
+// denser images are downsampled before being embedded.
+func (b *CfgBuilder) WithImageMaxDPI(dpi float64) Builder {
+	if dpi <= 0 {
+		return b
+	}
+
+	if b.imageOptimization == nil {
+		b.imageOptimization = &entity.ImageOptimization{}
+	}
+
+	b.imageOptimization.MaxDPI = dpi
+	return b
+}
+
+// WithImageJPEGQuality defines the quality, from 1 to 100, used to re-encode jpeg images and downsampled photos.
+func (b *CfgBuilder) WithImageJPEGQuality(quality int) Builder {
+	if quality < 1 || quality > 100 {
+		return b
+	}
+
+	if b.imageOptimization == nil {
+		b.imageOptimization = &entity.ImageOptimization{}
+	}
+
+	b.imageOptimization.JPEGQuality = quality
+	return b
+}
+
+// WithFileSystem defines the filesystem, like an embed.FS, used to read images and fonts by path.
+// It also reads the fonts added to a repository by file, unless the repository has its own loader.
+func (b *CfgBuilder) WithFileSystem(fsys fs.FS) Builder {
+	if fsys == nil {
+		return b
+	}
+
+	b.loader = loader.NewFS(fsys)
+	return b
+}
+
+// WithLoader defines a custom loader used to read images and fonts by path, e.g. from an object storage.
+// It also reads the fonts added to a repository by file, unless the repository has its own loader.
+func (b *CfgBuilder) WithLoader(loader loader.Loader) Builder {
+	if loader == nil {
+		return b
+	}
+
+	b.loader = loader
+	return b
+}
+
 // Build finalizes the customization returning the entity.Config.
 func (b *CfgBuilder) Build() *entity.Config {
 	if b.pageNumber != nil {
@@ -421,7 +489,10 @@ func (b *CfgBuilder) Build() *entity.Config {
 		Metadata:             b.metadata,
 		CustomFonts:          b.customFonts,
 		BackgroundImage:      b.backgroundImage,
+		BackgroundImageProp:  b.backgroundImageProp,
 		DisableAutoPageBreak: b.disableAutoPageBreak,
+		ImageOptimization:    b.imageOptimization,
+		Loader:               b.loader,
 	}
 }
 
@@ -3,8 +3,11 @@ package config_test
 import (
 	"fmt"
 	"testing"
+	"testing/fstest"
 	"time"
 
+	"github.com/johnfercher/maroto/v2/mocks"
+
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/consts/generation"
 	"github.com/johnfercher/maroto/v2/pkg/consts/protection"
@@ -61,6 +64,7 @@ func TestBuilder_Build(t *testing.T) {
 	assert.Nil(t, cfg.Metadata)
 	assert.Nil(t, cfg.BackgroundImage)
 	assert.False(t, cfg.DisableAutoPageBreak)
+	assert.Equal(t, "*loader.osLoader", fmt.Sprintf("%T", cfg.Loader))
 }
 
 func TestBuilder_WithPageSize(t *testing.T) {
@@ -802,6 +806,19 @@ func TestCfgBuilder_WithBackgroundImage(t *testing.T) {
 	})
 }
 
+func TestCfgBuilder_WithBackgroundImageProp(t *testing.T) {
+	t.Run("when with background prop, should apply", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithBackgroundImageProp(props.Rect{Opacity: 0.2, Grayscale: true}).Build()
+
+		// Assert
+		assert.Equal(t, &props.Rect{Opacity: 0.2, Grayscale: true}, cfg.BackgroundImageProp)
+	})
+}
+
 func TestBuilder_WithDisableAutoPageBreak(t *testing.T) {
 	t.Run("when disable auto page break is false, should not change the default value", func(t *testing.T) {
 		// Arrange
@@ -848,3 +865,99 @@ func TestBuilder_WithKeywords(t *testing.T) {
 		assert.Equal(t, true, cfg.Metadata.KeywordsStr.UTF8)
 	})
 }
+
+func TestBuilder_WithImageMaxDPI(t *testing.T) {
+	t.Run("when dpi is invalid, should ignore", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithImageMaxDPI(0).Build()
+
+		// Assert
+		assert.Nil(t, cfg.ImageOptimization)
+	})
+	t.Run("when dpi is valid, should apply", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithImageMaxDPI(150).WithImageJPEGQuality(80).Build()
+
+		// Assert
+		assert.Equal(t, &entity.ImageOptimization{MaxDPI: 150, JPEGQuality: 80}, cfg.ImageOptimization)
+	})
+}
+
+func TestBuilder_WithImageJPEGQuality(t *testing.T) {
+	t.Run("when quality is greater than 100, should ignore", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithImageJPEGQuality(101).Build()
+
+		// Assert
+		assert.Nil(t, cfg.ImageOptimization)
+	})
+	t.Run("when quality is valid, should apply", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithImageJPEGQuality(70).Build()
+
+		// Assert
+		assert.Equal(t, &entity.ImageOptimization{JPEGQuality: 70}, cfg.ImageOptimization)
+	})
+}
+
+func TestBuilder_WithFileSystem(t *testing.T) {
+	t.Run("when file system is nil, should keep os loader", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithFileSystem(nil).Build()
+
+		// Assert
+		assert.Equal(t, "*loader.osLoader", fmt.Sprintf("%T", cfg.Loader))
+	})
+	t.Run("when file system is sent, should read assets from it", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+		fsys := fstest.MapFS{"logo.png": &fstest.MapFile{Data: []byte{1, 2, 3}}}
+
+		// Act
+		cfg := sut.WithFileSystem(fsys).Build()
+
+		// Assert
+		bytes, err := cfg.Loader.Load("logo.png")
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{1, 2, 3}, bytes)
+	})
+}
+
+func TestBuilder_WithLoader(t *testing.T) {
+	t.Run("when loader is nil, should keep os loader", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+
+		// Act
+		cfg := sut.WithLoader(nil).Build()
+
+		// Assert
+		assert.Equal(t, "*loader.osLoader", fmt.Sprintf("%T", cfg.Loader))
+	})
+	t.Run("when loader is sent, should apply", func(t *testing.T) {
+		// Arrange
+		sut := config.NewBuilder()
+		loader := mocks.NewLoader(t)
+
+		// Act
+		cfg := sut.WithLoader(loader).Build()
+
+		// Assert
+		assert.Equal(t, loader, cfg.Loader)
+	})
+}
@@ -0,0 +1,23 @@
+// Package aggregate contains all aggregate functions.
+package aggregate
+
+// Type is a representation of an aggregate function.
+type Type string
+
+const (
+	// Sum represents the sum of the values.
+	Sum Type = "sum"
+	// Count represents the amount of values.
+	Count Type = "count"
+	// Avg represents the average of the values.
+	Avg Type = "avg"
+	// Min represents the smallest value.
+	Min Type = "min"
+	// Max represents the greatest value.
+	Max Type = "max"
+)
+
+// IsValid checks if the aggregate function is valid.
+func (t Type) IsValid() bool {
+	return t == Sum || t == Count || t == Avg || t == Min || t == Max
+}
@@ -0,0 +1,25 @@
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/aggregate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when aggregate is invalid, should be invalid", func(t *testing.T) {
+		// Arrange
+		aggregateType := aggregate.Type("invalid")
+
+		// Act & Assert
+		assert.False(t, aggregateType.IsValid())
+	})
+	t.Run("when aggregate is avg, should be valid", func(t *testing.T) {
+		// Arrange
+		aggregateType := aggregate.Avg
+
+		// Act & Assert
+		assert.True(t, aggregateType.IsValid())
+	})
+}
@@ -1,15 +1,42 @@
+// Package barcode contains all the barcode symbologies supported by maroto.
 package barcode
 
+// Type is a representation of a barcode symbology.
 type Type string
 
 const (
 	// Code128 represents the default barcode type of maroto.
 	Code128 Type = "code128"
-	// EAN represents the ean barcode type.
+	// EAN represents the ean barcode type, it accepts EAN-13 and EAN-8 codes.
 	EAN Type = "ean"
-	// Codabar   Type = "codabar"
-	// Code39    Type = "code39"
-	// Code93    Type = "code93"
-	// PDF417    Type = "pdf417"
-	// TwoOfFive Type = "twooffive"
+	// EAN8 represents the ean-8 barcode type, it accepts 7 digits or 8 digits with the check digit.
+	EAN8 Type = "ean8"
+	// UPCA represents the upc-a barcode type, it accepts 11 digits or 12 digits with the check digit.
+	UPCA Type = "upca"
+	// UPCE represents the zero suppressed upc-e barcode type, it accepts 6, 7 or 8 digits.
+	UPCE Type = "upce"
+	// Code39 represents the code 39 barcode type, it accepts digits, uppercase letters, space and - . $ / + %.
+	Code39 Type = "code39"
+	// Code93 represents the code 93 barcode type, it accepts the same characters as Code39.
+	Code93 Type = "code93"
+	// Codabar represents the codabar barcode type, it must start and end with A, B, C or D.
+	Codabar Type = "codabar"
+	// I2of5 represents the interleaved 2 of 5 barcode type, it accepts an even amount of digits.
+	I2of5 Type = "i2of5"
+	// GS1128 represents the gs1-128 barcode type, it accepts application identifiers in parentheses,
+	// ex: (01)09501101530003(17)250101(10)AB12. Values with parentheses must be sent as elements to code.NewGS1Bar.
+	GS1128 Type = "gs1128"
+	// Boleto represents the interleaved 2 of 5 barcode of a brazilian boleto, it accepts the 44 digits of the boleto
+	// and prints the digitable line as the human readable text.
+	Boleto Type = "boleto"
 )
+
+// IsValid checks if the barcode type is valid.
+func (t Type) IsValid() bool {
+	switch t {
+	case Code128, EAN, EAN8, UPCA, UPCE, Code39, Code93, Codabar, I2of5, GS1128, Boleto:
+		return true
+	default:
+		return false
+	}
+}
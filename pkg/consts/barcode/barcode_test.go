@@ -0,0 +1,39 @@
+package barcode_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when type is unknown, should be invalid", func(t *testing.T) {
+		// Arrange
+		barcodeType := barcode.Type("pharmacode")
+
+		// Act & Assert
+		assert.False(t, barcodeType.IsValid())
+	})
+	t.Run("when type is upce, should be valid", func(t *testing.T) {
+		// Arrange
+		barcodeType := barcode.UPCE
+
+		// Act & Assert
+		assert.True(t, barcodeType.IsValid())
+	})
+	t.Run("when type is gs1128, should be valid", func(t *testing.T) {
+		// Arrange
+		barcodeType := barcode.GS1128
+
+		// Act & Assert
+		assert.True(t, barcodeType.IsValid())
+	})
+	t.Run("when type is boleto, should be valid", func(t *testing.T) {
+		// Arrange
+		barcodeType := barcode.Boleto
+
+		// Act & Assert
+		assert.True(t, barcodeType.IsValid())
+	})
+}
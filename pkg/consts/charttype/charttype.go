@@ -0,0 +1,28 @@
+// Package charttype contains all chart types.
+package charttype
+
+// Type is a representation of a chart type.
+type Type string
+
+const (
+	// Bar represents a chart with the series side by side in vertical bars.
+	Bar Type = "bar"
+	// StackedBar represents a chart with the series stacked in vertical bars.
+	StackedBar Type = "stacked_bar"
+	// Line represents a chart with the series drawn as lines.
+	Line Type = "line"
+	// Pie represents a chart with the values of the first series drawn as slices of a circle.
+	Pie Type = "pie"
+	// Donut represents a pie chart with a hole in the center.
+	Donut Type = "donut"
+)
+
+// IsValid checks if the chart type is valid.
+func (t Type) IsValid() bool {
+	return t == Bar || t == StackedBar || t == Line || t == Pie || t == Donut
+}
+
+// IsCircular checks if the chart type is drawn as a circle.
+func (t Type) IsCircular() bool {
+	return t == Pie || t == Donut
+}
@@ -0,0 +1,42 @@
+package charttype_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/charttype"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when chart type is invalid, should be invalid", func(t *testing.T) {
+		// Arrange
+		chartType := charttype.Type("invalid")
+
+		// Act & Assert
+		assert.False(t, chartType.IsValid())
+	})
+	t.Run("when chart type is stacked bar, should be valid", func(t *testing.T) {
+		// Arrange
+		chartType := charttype.StackedBar
+
+		// Act & Assert
+		assert.True(t, chartType.IsValid())
+	})
+}
+
+func TestType_IsCircular(t *testing.T) {
+	t.Run("when chart type is bar, should not be circular", func(t *testing.T) {
+		// Arrange
+		chartType := charttype.Bar
+
+		// Act & Assert
+		assert.False(t, chartType.IsCircular())
+	})
+	t.Run("when chart type is donut, should be circular", func(t *testing.T) {
+		// Arrange
+		chartType := charttype.Donut
+
+		// Act & Assert
+		assert.True(t, chartType.IsCircular())
+	})
+}
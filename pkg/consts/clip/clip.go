@@ -0,0 +1,17 @@
+// Package clip contains all the shapes that can mask an image.
+package clip
+
+// Type is a representation of the shape which masks an image.
+type Type string
+
+const (
+	// Circle masks the image with the greatest circle centered inside the image area.
+	Circle Type = "circle"
+	// RoundedRect masks the image with a rectangle with rounded corners.
+	RoundedRect Type = "rounded_rect"
+)
+
+// IsValid checks if the clip is valid.
+func (t Type) IsValid() bool {
+	return t == Circle || t == RoundedRect
+}
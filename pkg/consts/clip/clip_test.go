@@ -0,0 +1,25 @@
+package clip_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/clip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when clip is empty, should be invalid", func(t *testing.T) {
+		// Arrange
+		shape := clip.Type("")
+
+		// Act & Assert
+		assert.False(t, shape.IsValid())
+	})
+	t.Run("when clip is circle, should be valid", func(t *testing.T) {
+		// Arrange
+		shape := clip.Circle
+
+		// Act & Assert
+		assert.True(t, shape.IsValid())
+	})
+}
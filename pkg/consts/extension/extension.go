@@ -11,9 +11,19 @@ const (
 	Jpeg Type = "jpeg"
 	// Png represents a png extension.
 	Png Type = "png"
+	// Svg represents a svg extension, svg images are drawn as vector graphics.
+	Svg Type = "svg"
+	// Gif represents a gif extension, only the first frame is used.
+	Gif Type = "gif"
+	// Bmp represents a bmp extension.
+	Bmp Type = "bmp"
+	// Tiff represents a tiff extension.
+	Tiff Type = "tiff"
+	// Webp represents a webp extension.
+	Webp Type = "webp"
 )
 
 // IsValid checks if the extension is valid.
 func (t Type) IsValid() bool {
-	return t == Jpg || t == Jpeg || t == Png
+	return t == Jpg || t == Jpeg || t == Png || t == Svg || t == Gif || t == Bmp || t == Tiff || t == Webp
 }
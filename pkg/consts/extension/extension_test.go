@@ -33,6 +33,41 @@ func TestType_IsValid(t *testing.T) {
 		// Act
 		extensionType := extension.Png
 
+		// Act & Assert
+		assert.True(t, extensionType.IsValid())
+	})
+	t.Run("when type is svg, should be valid", func(t *testing.T) {
+		// Act
+		extensionType := extension.Svg
+
+		// Act & Assert
+		assert.True(t, extensionType.IsValid())
+	})
+	t.Run("when type is gif, should be valid", func(t *testing.T) {
+		// Act
+		extensionType := extension.Gif
+
+		// Act & Assert
+		assert.True(t, extensionType.IsValid())
+	})
+	t.Run("when type is bmp, should be valid", func(t *testing.T) {
+		// Act
+		extensionType := extension.Bmp
+
+		// Act & Assert
+		assert.True(t, extensionType.IsValid())
+	})
+	t.Run("when type is tiff, should be valid", func(t *testing.T) {
+		// Act
+		extensionType := extension.Tiff
+
+		// Act & Assert
+		assert.True(t, extensionType.IsValid())
+	})
+	t.Run("when type is webp, should be valid", func(t *testing.T) {
+		// Act
+		extensionType := extension.Webp
+
 		// Act & Assert
 		assert.True(t, extensionType.IsValid())
 	})
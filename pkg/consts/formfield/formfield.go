@@ -0,0 +1,28 @@
+// Package formfield contains all form field types.
+package formfield
+
+// Type is a representation of an interactive form field type.
+type Type string
+
+const (
+	// Text represents a field where the user types a single line or many lines of text.
+	Text Type = "text"
+	// CheckBox represents a field which is checked or unchecked.
+	CheckBox Type = "checkbox"
+	// RadioGroup represents a field where the user selects one of the options.
+	RadioGroup Type = "radio_group"
+	// Dropdown represents a field where the user selects one of the options in a list.
+	Dropdown Type = "dropdown"
+	// Signature represents an empty field where the document is digitally signed.
+	Signature Type = "signature"
+)
+
+// IsValid checks if the form field type is valid.
+func (t Type) IsValid() bool {
+	return t == Text || t == CheckBox || t == RadioGroup || t == Dropdown || t == Signature
+}
+
+// HasOptions checks if the form field type requires options.
+func (t Type) HasOptions() bool {
+	return t == RadioGroup || t == Dropdown
+}
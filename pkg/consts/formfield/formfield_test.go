@@ -0,0 +1,42 @@
+package formfield_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/formfield"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when form field type is invalid, should be invalid", func(t *testing.T) {
+		// Arrange
+		fieldType := formfield.Type("invalid")
+
+		// Act & Assert
+		assert.False(t, fieldType.IsValid())
+	})
+	t.Run("when form field type is radio group, should be valid", func(t *testing.T) {
+		// Arrange
+		fieldType := formfield.RadioGroup
+
+		// Act & Assert
+		assert.True(t, fieldType.IsValid())
+	})
+}
+
+func TestType_HasOptions(t *testing.T) {
+	t.Run("when form field type is text, should not have options", func(t *testing.T) {
+		// Arrange
+		fieldType := formfield.Text
+
+		// Act & Assert
+		assert.False(t, fieldType.HasOptions())
+	})
+	t.Run("when form field type is dropdown, should have options", func(t *testing.T) {
+		// Arrange
+		fieldType := formfield.Dropdown
+
+		// Act & Assert
+		assert.True(t, fieldType.HasOptions())
+	})
+}
@@ -0,0 +1,25 @@
+// Package liststyle contains all list marker styles.
+package liststyle
+
+// Type is a representation of a list marker style.
+type Type string
+
+const (
+	// Bullet represents a marker made by a bullet glyph, ex: •.
+	Bullet Type = "bullet"
+	// Decimal represents a marker made by decimal numbers, ex: 1, 2, 3.
+	Decimal Type = "decimal"
+	// LowerAlpha represents a marker made by lowercase letters, ex: a, b, c.
+	LowerAlpha Type = "lower_alpha"
+	// UpperAlpha represents a marker made by uppercase letters, ex: A, B, C.
+	UpperAlpha Type = "upper_alpha"
+	// LowerRoman represents a marker made by lowercase roman numbers, ex: i, ii, iii.
+	LowerRoman Type = "lower_roman"
+	// UpperRoman represents a marker made by uppercase roman numbers, ex: I, II, III.
+	UpperRoman Type = "upper_roman"
+)
+
+// IsValid checks if the list style is valid.
+func (t Type) IsValid() bool {
+	return t == Bullet || t == Decimal || t == LowerAlpha || t == UpperAlpha || t == LowerRoman || t == UpperRoman
+}
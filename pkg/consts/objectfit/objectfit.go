@@ -0,0 +1,19 @@
+// Package objectfit contains all the ways an image can be fitted inside a cell.
+package objectfit
+
+// Type is a representation of how an image is resized to fit a cell.
+type Type string
+
+const (
+	// Contain resizes the image keeping its proportion, so the whole image is visible inside the cell.
+	Contain Type = "contain"
+	// Cover resizes the image keeping its proportion, so the image fills the cell and the overflow is cropped.
+	Cover Type = "cover"
+	// Fill stretches the image to fill the cell, ignoring its proportion.
+	Fill Type = "fill"
+)
+
+// IsValid checks if the object fit is valid.
+func (t Type) IsValid() bool {
+	return t == Contain || t == Cover || t == Fill
+}
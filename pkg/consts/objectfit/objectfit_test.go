@@ -0,0 +1,25 @@
+package objectfit_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/objectfit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when object fit is empty, should be invalid", func(t *testing.T) {
+		// Arrange
+		fit := objectfit.Type("")
+
+		// Act & Assert
+		assert.False(t, fit.IsValid())
+	})
+	t.Run("when object fit is cover, should be valid", func(t *testing.T) {
+		// Arrange
+		fit := objectfit.Cover
+
+		// Act & Assert
+		assert.True(t, fit.IsValid())
+	})
+}
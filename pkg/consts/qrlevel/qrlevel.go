@@ -0,0 +1,21 @@
+// Package qrlevel contains all the error correction levels of a qrcode.
+package qrlevel
+
+// Type is a representation of the error correction level of a qrcode.
+type Type string
+
+const (
+	// L recovers 7% of the code.
+	L Type = "L"
+	// M recovers 15% of the code.
+	M Type = "M"
+	// Q recovers 25% of the code.
+	Q Type = "Q"
+	// H recovers 30% of the code, it is required to draw a logo over the code.
+	H Type = "H"
+)
+
+// IsValid checks if the error correction level is valid.
+func (t Type) IsValid() bool {
+	return t == L || t == M || t == Q || t == H
+}
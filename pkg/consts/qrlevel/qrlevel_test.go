@@ -0,0 +1,25 @@
+package qrlevel_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/qrlevel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when level is empty, should be invalid", func(t *testing.T) {
+		// Arrange
+		level := qrlevel.Type("")
+
+		// Act & Assert
+		assert.False(t, level.IsValid())
+	})
+	t.Run("when level is h, should be valid", func(t *testing.T) {
+		// Arrange
+		level := qrlevel.H
+
+		// Act & Assert
+		assert.True(t, level.IsValid())
+	})
+}
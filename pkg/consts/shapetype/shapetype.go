@@ -0,0 +1,22 @@
+// Package shapetype contains all shape types.
+package shapetype
+
+// Type is a representation of a shape type.
+type Type string
+
+const (
+	// Rectangle represents a rectangle, with rounded corners when a radius is defined.
+	Rectangle Type = "rectangle"
+	// Ellipse represents an ellipse.
+	Ellipse Type = "ellipse"
+	// Circle represents a circle.
+	Circle Type = "circle"
+	// Polygon represents a closed shape defined by points.
+	Polygon Type = "polygon"
+	// Polyline represents an open path of straight lines defined by points.
+	Polyline Type = "polyline"
+	// Bezier represents a path of cubic Bézier curves defined by points.
+	Bezier Type = "bezier"
+	// Arrow represents a straight line with an arrowhead in the end.
+	Arrow Type = "arrow"
+)
@@ -0,0 +1,21 @@
+// Package tabstop contains all tab stop alignments.
+package tabstop
+
+// Type is a representation of a tab stop alignment.
+type Type string
+
+const (
+	// Left represents a tab stop where the text starts at the stop position.
+	Left Type = "left"
+	// Right represents a tab stop where the text ends at the stop position.
+	Right Type = "right"
+	// Center represents a tab stop where the text is centered on the stop position.
+	Center Type = "center"
+	// Decimal represents a tab stop where the decimal separator is placed at the stop position.
+	Decimal Type = "decimal"
+)
+
+// IsValid checks if the tab stop alignment is valid.
+func (t Type) IsValid() bool {
+	return t == Left || t == Right || t == Center || t == Decimal
+}
@@ -0,0 +1,25 @@
+package tabstop_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/tabstop"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when tab stop is invalid, should be invalid", func(t *testing.T) {
+		// Arrange
+		tabStop := tabstop.Type("invalid")
+
+		// Act & Assert
+		assert.False(t, tabStop.IsValid())
+	})
+	t.Run("when tab stop is decimal, should be valid", func(t *testing.T) {
+		// Arrange
+		tabStop := tabstop.Decimal
+
+		// Act & Assert
+		assert.True(t, tabStop.IsValid())
+	})
+}
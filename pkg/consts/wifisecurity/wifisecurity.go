@@ -0,0 +1,19 @@
+// Package wifisecurity contains all the security types of a Wi-Fi network.
+package wifisecurity
+
+// Type is a representation of the security type of a Wi-Fi network.
+type Type string
+
+const (
+	// WPA is used by WPA, WPA2 and WPA3 networks.
+	WPA Type = "WPA"
+	// WEP is used by legacy WEP networks.
+	WEP Type = "WEP"
+	// None is used by open networks, which have no password.
+	None Type = "nopass"
+)
+
+// IsValid checks if the security type is valid.
+func (t Type) IsValid() bool {
+	return t == WPA || t == WEP || t == None
+}
@@ -0,0 +1,25 @@
+package wifisecurity_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/wifisecurity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestType_IsValid(t *testing.T) {
+	t.Run("when security is empty, should be invalid", func(t *testing.T) {
+		// Arrange
+		security := wifisecurity.Type("")
+
+		// Act & Assert
+		assert.False(t, security.IsValid())
+	})
+	t.Run("when security is wpa, should be valid", func(t *testing.T) {
+		// Arrange
+		security := wifisecurity.WPA
+
+		// Act & Assert
+		assert.True(t, security.IsValid())
+	})
+}
@@ -1,12 +1,10 @@
 package core
 
 import (
-	"github.com/google/uuid"
 	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
 	"github.com/johnfercher/maroto/v2/pkg/props"
-	"github.com/jung-kurt/gofpdf"
 )
 
 // Math is the abstraction which deals with useful calc.
@@ -17,25 +15,46 @@ type Math interface {
 
 // Code is the abstraction which deals of how to add QrCodes or Barcode in a PDF.
 type Code interface {
-	GenQr(code string) (*entity.Image, error)
-	GenDataMatrix(code string) (*entity.Image, error)
+	GenQr(code string, prop *props.QrCode) (*entity.Image, error)
+	GenDataMatrix(code string, prop *props.Rect) (*entity.Image, error)
 	GenBar(code string, cell *entity.Cell, prop *props.Barcode) (*entity.Image, error)
+	GenPDF417(code string, prop *props.PDF417) (*entity.Image, error)
+	GenAztec(code string, prop *props.Aztec) (*entity.Image, error)
+	GenVectorQr(code string, prop *props.QrCode) (*entity.Image, error)
+	GenVectorDataMatrix(code string, prop *props.Rect) (*entity.Image, error)
+	GenVectorBar(code string, prop *props.Barcode) (*entity.Image, error)
 }
 
 // Image is the abstraction which deals of how to add images in a PDF.
 type Image interface {
 	Add(img *entity.Image, cell *entity.Cell, margins *entity.Margins, prop *props.Rect, extension extension.Type, flow bool) error
-	GetImageInfo(img *entity.Image, extension extension.Type) (*gofpdf.ImageInfoType, uuid.UUID)
+	GetDimensions(img *entity.Image) (*entity.Dimensions, error)
 }
 
 type Line interface {
 	Add(cell *entity.Cell, prop *props.Line)
 }
 
+// Shape is the abstraction which deals of how to draw shapes in a PDF.
+type Shape interface {
+	AddRectangle(cell *entity.Cell, prop *props.Shape)
+	AddEllipse(cell *entity.Cell, prop *props.Shape)
+	AddPolygon(points []props.Point, cell *entity.Cell, prop *props.Shape) error
+	AddPolyline(points []props.Point, cell *entity.Cell, prop *props.Shape) error
+	AddBezier(points []props.Point, cell *entity.Cell, prop *props.Shape) error
+	AddArrow(from, to props.Point, cell *entity.Cell, prop *props.Shape) error
+}
+
+// Chart is the abstraction which deals of how to draw charts in a PDF.
+type Chart interface {
+	Add(chart *entity.Chart, cell *entity.Cell, prop *props.Chart) error
+}
+
 // Text is the abstraction which deals of how to add text inside PDF.
 type Text interface {
 	Add(text string, cell *entity.Cell, textProp *props.Text)
 	GetLinesQuantity(text string, textProp *props.Text, colWidth float64) int
+	GetStringWidth(text string, textProp *props.Text) float64
 }
 
 // Font is the abstraction which deals of how to set fontstyle configurations.
@@ -52,3 +71,9 @@ type Font interface {
 	SetColor(color *props.Color)
 	GetColor() *props.Color
 }
+
+// Form is the abstraction which deals of how to add interactive form fields in a PDF.
+type Form interface {
+	Add(field *entity.FormField, cell *entity.Cell, prop *props.FormField) error
+	Apply(pdf []byte) ([]byte, error)
+}
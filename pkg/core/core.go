@@ -65,6 +65,13 @@ type Row interface {
 	Render(provider Provider, cell entity.Cell)
 }
 
+// HeadedRow is the interface of a row that needs other rows to be repeated before it
+// when it is moved to a new page, ex: the group header of a table.
+type HeadedRow interface {
+	Row
+	GetHeaderRows() []Row
+}
+
 // Page is the interface that wraps the basic methods of a page.
 type Page interface {
 	Node
@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+// Series is a named sequence of values drawn in a chart, each value matches a label of the chart.
+type Series struct {
+	Name   string
+	Values []float64
+	// Color of the series, when nil a color of the chart palette is used.
+	Color *props.Color
+}
+
+// Chart is the data drawn in a chart.
+type Chart struct {
+	Labels []string
+	Series []Series
+}
+
+// ToString returns a string representation of the series.
+func (s *Series) ToString() string {
+	var values []string
+	for _, value := range s.Values {
+		values = append(values, strconv.FormatFloat(value, 'f', -1, 64))
+	}
+
+	if s.Color != nil {
+		return fmt.Sprintf("%s(%s, %s)", s.Name, strings.Join(values, ", "), s.Color.ToString())
+	}
+
+	return fmt.Sprintf("%s(%s)", s.Name, strings.Join(values, ", "))
+}
+
+// AppendMap appends the chart data to a map.
+func (c *Chart) AppendMap(m map[string]interface{}) map[string]interface{} {
+	if len(c.Labels) > 0 {
+		m["chart_labels"] = c.Labels
+	}
+
+	if len(c.Series) > 0 {
+		var series []string
+		for _, s := range c.Series {
+			series = append(series, s.ToString())
+		}
+		m["chart_series"] = series
+	}
+
+	return m
+}
+
+// Validate checks if the chart data can be drawn.
+func (c *Chart) Validate() error {
+	if len(c.Labels) == 0 {
+		return errors.New("chart without labels")
+	}
+
+	if len(c.Series) == 0 {
+		return errors.New("chart without series")
+	}
+
+	for _, s := range c.Series {
+		if len(s.Values) != len(c.Labels) {
+			return fmt.Errorf("series %s has %d values, but chart has %d labels", s.Name, len(s.Values), len(c.Labels))
+		}
+
+		for _, value := range s.Values {
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				return fmt.Errorf("series %s has the value %v, but values must be finite numbers", s.Name, value)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeries_ToString(t *testing.T) {
+	t.Run("when color is not defined, should return name and values", func(t *testing.T) {
+		// Arrange
+		sut := Series{Name: "sales", Values: []float64{1, 2.5}}
+
+		// Act & Assert
+		assert.Equal(t, "sales(1, 2.5)", sut.ToString())
+	})
+	t.Run("when color is defined, should return name, values and color", func(t *testing.T) {
+		// Arrange
+		sut := Series{Name: "sales", Values: []float64{1}, Color: &props.RedColor}
+
+		// Act & Assert
+		assert.Equal(t, "sales(1, RGB(255, 0, 0))", sut.ToString())
+	})
+}
+
+func TestChart_AppendMap(t *testing.T) {
+	// Arrange
+	sut := fixtureChart()
+	m := make(map[string]interface{})
+
+	// Act
+	m = sut.AppendMap(m)
+
+	// Assert
+	assert.Equal(t, []string{"jan", "feb"}, m["chart_labels"])
+	assert.Equal(t, []string{"sales(1, 2)"}, m["chart_series"])
+}
+
+func TestChart_Validate(t *testing.T) {
+	t.Run("when there are no labels, should return error", func(t *testing.T) {
+		// Arrange
+		sut := fixtureChart()
+		sut.Labels = nil
+
+		// Act & Assert
+		assert.NotNil(t, sut.Validate())
+	})
+	t.Run("when there are no series, should return error", func(t *testing.T) {
+		// Arrange
+		sut := fixtureChart()
+		sut.Series = nil
+
+		// Act & Assert
+		assert.NotNil(t, sut.Validate())
+	})
+	t.Run("when series values does not match labels, should return error", func(t *testing.T) {
+		// Arrange
+		sut := fixtureChart()
+		sut.Series[0].Values = []float64{1}
+
+		// Act & Assert
+		assert.NotNil(t, sut.Validate())
+	})
+	t.Run("when series has values that are not finite, should return error", func(t *testing.T) {
+		for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+			// Arrange
+			sut := fixtureChart()
+			sut.Series[0].Values[0] = value
+
+			// Act
+			err := sut.Validate()
+
+			// Assert
+			assert.EqualError(t, err, fmt.Sprintf("series %s has the value %v, but values must be finite numbers",
+				sut.Series[0].Name, value))
+		}
+	})
+	t.Run("when chart is valid, should not return error", func(t *testing.T) {
+		// Arrange
+		sut := fixtureChart()
+
+		// Act & Assert
+		assert.Nil(t, sut.Validate())
+	})
+}
+
+func fixtureChart() Chart {
+	return Chart{
+		Labels: []string{"jan", "feb"},
+		Series: []Series{{Name: "sales", Values: []float64{1, 2}}},
+	}
+}
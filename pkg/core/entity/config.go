@@ -3,6 +3,7 @@ package entity
 import (
 	"github.com/johnfercher/maroto/v2/pkg/consts/generation"
 	"github.com/johnfercher/maroto/v2/pkg/consts/provider"
+	"github.com/johnfercher/maroto/v2/pkg/loader"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
@@ -22,7 +23,10 @@ type Config struct {
 	Compression          bool
 	Metadata             *Metadata
 	BackgroundImage      *Image
+	BackgroundImageProp  *props.Rect
 	DisableAutoPageBreak bool
+	ImageOptimization    *ImageOptimization
+	Loader               loader.Loader
 }
 
 // ToMap converts Config to a map[string]interface{} .
@@ -76,9 +80,19 @@ func (c *Config) ToMap() map[string]interface{} {
 		m = c.BackgroundImage.AppendMap(m)
 	}
 
+	if c.BackgroundImageProp != nil {
+		for key, value := range c.BackgroundImageProp.ToMap() {
+			m["config_background_"+key] = value
+		}
+	}
+
 	if c.DisableAutoPageBreak {
 		m["config_disable_auto_page_break"] = c.DisableAutoPageBreak
 	}
 
+	if c.ImageOptimization != nil {
+		m = c.ImageOptimization.AppendMap(m)
+	}
+
 	return m
 }
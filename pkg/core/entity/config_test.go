@@ -58,6 +58,9 @@ func TestConfig_ToMap(t *testing.T) {
 	assert.Equal(t, 100.0, m["background_dimension_width"])
 	assert.Equal(t, 200.0, m["background_dimension_height"])
 	assert.Equal(t, true, m["config_disable_auto_page_break"])
+	assert.Equal(t, 300.0, m["config_image_max_dpi"])
+	assert.Equal(t, 85, m["config_image_jpeg_quality"])
+	assert.Equal(t, 0.3, m["config_background_prop_opacity"])
 }
 
 func fixtureConfig() Config {
@@ -83,7 +86,9 @@ func fixtureConfig() Config {
 		Compression:          true,
 		Metadata:             &metadata,
 		BackgroundImage:      &image,
+		BackgroundImageProp:  &props.Rect{Opacity: 0.3},
 		DisableAutoPageBreak: true,
+		ImageOptimization:    &ImageOptimization{MaxDPI: 300, JPEGQuality: 85},
 	}
 }
 
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/formfield"
+)
+
+// FormField is an interactive field of the AcroForm of a document, which is filled in with a PDF reader.
+type FormField struct {
+	Type formfield.Type
+	// Name identifies the field when the form is read, it must be unique in the document.
+	Name string
+	// Value is the default text of text fields and the selected option of radio groups and dropdowns.
+	Value string
+	// Options are the choices of radio groups and dropdowns.
+	Options []string
+	// Checked is the default state of checkboxes.
+	Checked bool
+}
+
+// AppendMap appends the form field data to a map.
+func (f *FormField) AppendMap(m map[string]interface{}) map[string]interface{} {
+	if f.Type != "" {
+		m["form_field_type"] = f.Type
+	}
+
+	if f.Name != "" {
+		m["form_field_name"] = f.Name
+	}
+
+	if f.Value != "" {
+		m["form_field_value"] = f.Value
+	}
+
+	if len(f.Options) > 0 {
+		m["form_field_options"] = f.Options
+	}
+
+	if f.Checked {
+		m["form_field_checked"] = f.Checked
+	}
+
+	return m
+}
+
+// Validate checks if the form field can be added to a document.
+func (f *FormField) Validate() error {
+	if !f.Type.IsValid() {
+		return fmt.Errorf("form field type %s is not supported", f.Type)
+	}
+
+	if f.Name == "" {
+		return errors.New("form field without name")
+	}
+
+	// Periods separate the names of parent and child fields in a PDF.
+	if strings.Contains(f.Name, ".") {
+		return fmt.Errorf("form field name %s must not have periods", f.Name)
+	}
+
+	if !f.Type.HasOptions() {
+		return nil
+	}
+
+	if len(f.Options) == 0 {
+		return fmt.Errorf("form field %s without options", f.Name)
+	}
+
+	options := make(map[string]bool)
+	for _, option := range f.Options {
+		if option == "" || options[option] {
+			return fmt.Errorf("form field %s options must be unique and not empty", f.Name)
+		}
+		options[option] = true
+	}
+
+	if f.Value != "" && !options[f.Value] {
+		return fmt.Errorf("form field %s value %s is not one of the options", f.Name, f.Value)
+	}
+
+	return nil
+}
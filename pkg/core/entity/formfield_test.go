@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/formfield"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormField_AppendMap(t *testing.T) {
+	// Arrange
+	sut := fixtureFormField()
+	sut.Checked = true
+	m := make(map[string]interface{})
+
+	// Act
+	m = sut.AppendMap(m)
+
+	// Assert
+	assert.Equal(t, formfield.RadioGroup, m["form_field_type"])
+	assert.Equal(t, "plan", m["form_field_name"])
+	assert.Equal(t, "basic", m["form_field_value"])
+	assert.Equal(t, []string{"basic", "premium"}, m["form_field_options"])
+	assert.Equal(t, true, m["form_field_checked"])
+}
+
+func TestFormField_Validate(t *testing.T) {
+	t.Run("when type is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		sut := fixtureFormField()
+		sut.Type = "invalid"
+
+		// Act & Assert
+		assert.NotNil(t, sut.Validate())
+	})
+	t.Run("when name is empty, should return error", func(t *testing.T) {
+		// Arrange
+		sut := fixtureFormField()
+		sut.Name = ""
+
+		// Act & Assert
+		assert.NotNil(t, sut.Validate())
+	})
+	t.Run("when name has periods, should return error", func(t *testing.T) {
+		// Arrange
+		sut := fixtureFormField()
+		sut.Name = "customer.plan"
+
+		// Act & Assert
+		assert.NotNil(t, sut.Validate())
+	})
+	t.Run("when options are empty, should return error", func(t *testing.T) {
+		// Arrange
+		sut := fixtureFormField()
+		sut.Options = nil
+
+		// Act & Assert
+		assert.NotNil(t, sut.Validate())
+	})
+	t.Run("when options are repeated, should return error", func(t *testing.T) {
+		// Arrange
+		sut := fixtureFormField()
+		sut.Options = []string{"basic", "basic"}
+
+		// Act & Assert
+		assert.NotNil(t, sut.Validate())
+	})
+	t.Run("when value is not one of the options, should return error", func(t *testing.T) {
+		// Arrange
+		sut := fixtureFormField()
+		sut.Value = "gold"
+
+		// Act & Assert
+		assert.NotNil(t, sut.Validate())
+	})
+	t.Run("when text field has no options, should not return error", func(t *testing.T) {
+		// Arrange
+		sut := FormField{Type: formfield.Text, Name: "email", Value: "any text"}
+
+		// Act & Assert
+		assert.Nil(t, sut.Validate())
+	})
+	t.Run("when radio group is valid, should not return error", func(t *testing.T) {
+		// Arrange
+		sut := fixtureFormField()
+
+		// Act & Assert
+		assert.Nil(t, sut.Validate())
+	})
+}
+
+func fixtureFormField() FormField {
+	return FormField{
+		Type:    formfield.RadioGroup,
+		Name:    "plan",
+		Value:   "basic",
+		Options: []string{"basic", "premium"},
+	}
+}
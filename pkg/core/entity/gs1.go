@@ -0,0 +1,14 @@
+package entity
+
+// GS1Element is an application identifier with its value, ex: (01) with a GTIN or (17) with an expiry date.
+type GS1Element struct {
+	// AI is the application identifier without parentheses, ex: 01.
+	AI string
+	// Value is the data of the application identifier, dates use the format YYMMDD.
+	Value string
+}
+
+// ToString returns the human readable representation of the element, ex: (01)09501101530003.
+func (g *GS1Element) ToString() string {
+	return "(" + g.AI + ")" + g.Value
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGS1Element_ToString(t *testing.T) {
+	// Arrange
+	sut := GS1Element{AI: "17", Value: "260131"}
+
+	// Act
+	s := sut.ToString()
+
+	// Assert
+	assert.Equal(t, "(17)260131", s)
+}
@@ -0,0 +1,22 @@
+package entity
+
+// ImageOptimization is the configuration used to reduce the size of the images embedded in the document.
+type ImageOptimization struct {
+	// MaxDPI is the maximum pixel density of an image at its printed size, denser images are downsampled.
+	MaxDPI float64
+	// JPEGQuality is the quality, from 1 to 100, used to re-encode jpeg images and downsampled photos.
+	JPEGQuality int
+}
+
+// AppendMap adds the ImageOptimization fields to the map.
+func (i *ImageOptimization) AppendMap(m map[string]interface{}) map[string]interface{} {
+	if i.MaxDPI != 0 {
+		m["config_image_max_dpi"] = i.MaxDPI
+	}
+
+	if i.JPEGQuality != 0 {
+		m["config_image_jpeg_quality"] = i.JPEGQuality
+	}
+
+	return m
+}
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageOptimization_AppendMap(t *testing.T) {
+	// Arrange
+	sut := ImageOptimization{MaxDPI: 150, JPEGQuality: 80}
+	m := make(map[string]interface{})
+
+	// Act
+	m = sut.AppendMap(m)
+
+	// Assert
+	assert.Equal(t, 150.0, m["config_image_max_dpi"])
+	assert.Equal(t, 80, m["config_image_jpeg_quality"])
+}
@@ -0,0 +1,36 @@
+package entity
+
+// Signer is the person who signs a signature block.
+type Signer struct {
+	// Name of the signer, printed below the line.
+	Name string
+	// Role is the role or title of the signer, ex: Chief Executive Officer.
+	Role string
+	// Date is when the document is signed, already formatted, ex: 2024-05-01.
+	Date string
+	// Note is printed in italic below the other texts, ex: Signed electronically on 2024-05-01 10:32 UTC.
+	Note string
+	// Image is a scanned signature placed over the line, only Bytes and Extension are used.
+	Image *Image
+}
+
+// AppendMap adds the Signer fields to the map, the name is not added since it is the value of the component.
+func (s *Signer) AppendMap(m map[string]interface{}) map[string]interface{} {
+	if s.Role != "" {
+		m["entity_signer_role"] = s.Role
+	}
+
+	if s.Date != "" {
+		m["entity_signer_date"] = s.Date
+	}
+
+	if s.Note != "" {
+		m["entity_signer_note"] = s.Note
+	}
+
+	if s.Image != nil {
+		m = s.Image.AppendMap(m)
+	}
+
+	return m
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+)
+
+func TestSigner_AppendMap(t *testing.T) {
+	// Arrange
+	sut := Signer{
+		Name:  "Maria Silva",
+		Role:  "Director",
+		Date:  "2024-05-01",
+		Note:  "Signed electronically",
+		Image: &Image{Bytes: []byte{1, 2, 3}, Extension: extension.Png},
+	}
+	m := make(map[string]interface{})
+
+	// Act
+	m = sut.AppendMap(m)
+
+	// Assert
+	assert.Equal(t, "Director", m["entity_signer_role"])
+	assert.Equal(t, "2024-05-01", m["entity_signer_date"])
+	assert.Equal(t, "Signed electronically", m["entity_signer_note"])
+	assert.Equal(t, extension.Png, m["entity_extension"])
+	assert.Nil(t, m["entity_signer_name"])
+}
@@ -14,16 +14,30 @@ type Provider interface {
 
 	// Features
 	AddLine(cell *entity.Cell, prop *props.Line)
+	AddRectangle(cell *entity.Cell, prop *props.Shape)
+	AddEllipse(cell *entity.Cell, prop *props.Shape)
+	AddPolygon(points []props.Point, cell *entity.Cell, prop *props.Shape)
+	AddPolyline(points []props.Point, cell *entity.Cell, prop *props.Shape)
+	AddBezier(points []props.Point, cell *entity.Cell, prop *props.Shape)
+	AddArrow(from, to props.Point, cell *entity.Cell, prop *props.Shape)
+	AddChart(chart *entity.Chart, cell *entity.Cell, prop *props.Chart)
 	AddText(text string, cell *entity.Cell, prop *props.Text)
+	AddFormField(field *entity.FormField, cell *entity.Cell, prop *props.FormField)
 	GetFontHeight(prop *props.Font) float64
 	GetLinesQuantity(text string, textProp *props.Text, colWidth float64) int
+	GetStringWidth(text string, textProp *props.Text) float64
 	AddMatrixCode(code string, cell *entity.Cell, prop *props.Rect)
-	AddQrCode(code string, cell *entity.Cell, rect *props.Rect)
+	AddQrCode(code string, cell *entity.Cell, prop *props.QrCode)
 	AddBarCode(code string, cell *entity.Cell, prop *props.Barcode)
-	GetDimensionsByMatrixCode(code string) (*entity.Dimensions, error)
+	AddPDF417(code string, cell *entity.Cell, prop *props.PDF417)
+	AddAztec(code string, cell *entity.Cell, prop *props.Aztec)
+	GetDimensionsByBarCode(code string, prop *props.Barcode) (*entity.Dimensions, error)
+	GetDimensionsByMatrixCode(code string, prop *props.Rect) (*entity.Dimensions, error)
+	GetDimensionsByPDF417(code string, prop *props.PDF417) (*entity.Dimensions, error)
+	GetDimensionsByAztec(code string, prop *props.Aztec) (*entity.Dimensions, error)
 	GetDimensionsByImageByte(bytes []byte, extension extension.Type) (*entity.Dimensions, error)
 	GetDimensionsByImage(file string) (*entity.Dimensions, error)
-	GetDimensionsByQrCode(code string) (*entity.Dimensions, error)
+	GetDimensionsByQrCode(code string, prop *props.QrCode) (*entity.Dimensions, error)
 	AddImageFromFile(value string, cell *entity.Cell, prop *props.Rect)
 	AddImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type)
 	AddBackgroundImageFromBytes(bytes []byte, cell *entity.Cell, prop *props.Rect, extension extension.Type)
@@ -0,0 +1,41 @@
+// Package loader implements the asset loaders used to read images and fonts.
+package loader
+
+import (
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// Loader is the abstraction to read assets, like images and fonts, by its path.
+type Loader interface {
+	Load(path string) ([]byte, error)
+}
+
+type osLoader struct{}
+
+// New creates a Loader which reads from the operating system filesystem.
+func New() Loader {
+	return &osLoader{}
+}
+
+// Load reads the file from the operating system filesystem.
+func (o *osLoader) Load(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+type fsLoader struct {
+	fsys fs.FS
+}
+
+// NewFS creates a Loader which reads from a fs.FS, like an embed.FS.
+func NewFS(fsys fs.FS) Loader {
+	return &fsLoader{
+		fsys: fsys,
+	}
+}
+
+// Load reads the file from the fs.FS, the paths are slash-separated and a leading slash is ignored.
+func (f *fsLoader) Load(path string) ([]byte, error) {
+	return fs.ReadFile(f.fsys, strings.TrimPrefix(path, "/"))
+}
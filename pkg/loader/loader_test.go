@@ -0,0 +1,81 @@
+package loader_test
+
+import (
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/loader"
+)
+
+func TestNew(t *testing.T) {
+	// Act
+	sut := loader.New()
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*loader.osLoader", fmt.Sprintf("%T", sut))
+}
+
+func TestOsLoader_Load(t *testing.T) {
+	t.Run("when file does not exist, should return error", func(t *testing.T) {
+		// Arrange
+		sut := loader.New()
+
+		// Act
+		bytes, err := sut.Load("invalid_file.png")
+
+		// Assert
+		assert.Nil(t, bytes)
+		assert.NotNil(t, err)
+	})
+	t.Run("when file exists, should return its content", func(t *testing.T) {
+		// Arrange
+		sut := loader.New()
+
+		// Act
+		bytes, err := sut.Load("loader.go")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.NotEmpty(t, bytes)
+	})
+}
+
+func TestNewFS(t *testing.T) {
+	// Act
+	sut := loader.NewFS(fstest.MapFS{})
+
+	// Assert
+	assert.NotNil(t, sut)
+	assert.Equal(t, "*loader.fsLoader", fmt.Sprintf("%T", sut))
+}
+
+func TestFsLoader_Load(t *testing.T) {
+	t.Run("when file does not exist, should return error", func(t *testing.T) {
+		// Arrange
+		sut := loader.NewFS(fstest.MapFS{})
+
+		// Act
+		bytes, err := sut.Load("images/logo.png")
+
+		// Assert
+		assert.Nil(t, bytes)
+		assert.NotNil(t, err)
+	})
+	t.Run("when file exists, should return its content", func(t *testing.T) {
+		// Arrange
+		sut := loader.NewFS(fstest.MapFS{
+			"images/logo.png": &fstest.MapFile{Data: []byte{1, 2, 3}},
+		})
+
+		// Act
+		bytes, err := sut.Load("/images/logo.png")
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{1, 2, 3}, bytes)
+	})
+}
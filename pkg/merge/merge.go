@@ -3,12 +3,13 @@ package merge
 
 import (
 	"bytes"
+	"errors"
 	"io"
 
 	"github.com/pdfcpu/pdfcpu/pkg/api"
 )
 
-// Bytes merges PDFs from byte slices.
+// Bytes merges PDFs from byte slices. The images found in more than one PDF are embedded once.
 func Bytes(pdfs ...[]byte) ([]byte, error) {
 	readers := make([]io.ReadSeeker, len(pdfs))
 	for i, pdf := range pdfs {
@@ -25,6 +26,10 @@ func Bytes(pdfs ...[]byte) ([]byte, error) {
 }
 
 func mergePdfs(readers []io.ReadSeeker, writer io.Writer, dividerPage bool) error {
+	if len(readers) == 0 {
+		return errors.New("there are no pdfs to merge")
+	}
+
 	conf := api.LoadConfiguration()
 	conf.WriteXRefStream = false
 	return api.MergeRaw(readers, writer, dividerPage, conf)
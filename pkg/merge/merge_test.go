@@ -1,31 +1,112 @@
 package merge_test
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/form"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
 	"github.com/johnfercher/maroto/v2/pkg/components/text"
 	"github.com/johnfercher/maroto/v2/pkg/merge"
 )
 
 func TestBytes(t *testing.T) {
-	// Arrange
-	m1 := maroto.New()
-	m1.AddRows(text.NewRow(10, "text1"))
-	doc1, _ := m1.Generate()
-	doc1Bytes := doc1.GetBytes()
+	t.Run("when pdfs are valid, should merge them", func(t *testing.T) {
+		// Arrange
+		m1 := maroto.New()
+		m1.AddRows(text.NewRow(10, "text1"))
+		doc1, _ := m1.Generate()
+		doc1Bytes := doc1.GetBytes()
 
-	m2 := maroto.New()
-	m2.AddRows(text.NewRow(10, "text2"))
-	doc2, _ := m2.Generate()
-	doc2Bytes := doc2.GetBytes()
+		m2 := maroto.New()
+		m2.AddRows(text.NewRow(10, "text2"))
+		doc2, _ := m2.Generate()
+		doc2Bytes := doc2.GetBytes()
 
-	// Act
-	bytes, err := merge.Bytes(doc1Bytes, doc2Bytes)
+		// Act
+		bytes, err := merge.Bytes(doc1Bytes, doc2Bytes)
 
-	// Assert
+		// Assert
+		assert.Nil(t, err)
+		assert.InDelta(t, len(doc1Bytes)+len(doc2Bytes), len(bytes), 500)
+	})
+	t.Run("when there are no pdfs, should return error", func(t *testing.T) {
+		// Act
+		bytes, err := merge.Bytes()
+
+		// Assert
+		assert.Nil(t, bytes)
+		assert.NotNil(t, err)
+	})
+	t.Run("when pdfs have the same image, should embed the image once", func(t *testing.T) {
+		// Arrange
+		doc1 := newImagePDF(t)
+		doc2 := newImagePDF(t)
+
+		// Act
+		pdf, err := merge.Bytes(doc1, doc2)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, 1, getImagesQuantity(t, pdf))
+	})
+	t.Run("when pdfs have form fields with the same name, should merge them", func(t *testing.T) {
+		// Arrange
+		doc1 := newFormPDF(t, "name")
+		doc2 := newFormPDF(t, "name")
+
+		// Act
+		pdf, err := merge.Bytes(doc1, doc2)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, getFieldNames(t, pdf), 2)
+	})
+}
+
+func newImagePDF(t *testing.T) []byte {
+	m := maroto.New()
+	m.AddRows(image.NewFromFileRow(40, "../../docs/assets/images/biplane.jpg"))
+	doc, err := m.Generate()
+	assert.Nil(t, err)
+	return doc.GetBytes()
+}
+
+func getImagesQuantity(t *testing.T, pdf []byte) int {
+	ctx, err := api.ReadContext(bytes.NewReader(pdf), model.NewDefaultConfiguration())
+	assert.Nil(t, err)
+
+	quantity := 0
+	for _, entry := range ctx.Table {
+		streamDict, ok := entry.Object.(types.StreamDict)
+		if ok && streamDict.Subtype() != nil && *streamDict.Subtype() == "Image" {
+			quantity++
+		}
+	}
+	return quantity
+}
+
+func newFormPDF(t *testing.T, name string) []byte {
+	m := maroto.New()
+	m.AddRows(form.NewTextFieldRow(10, name, "value"))
+	doc, err := m.Generate()
 	assert.Nil(t, err)
-	assert.InDelta(t, len(doc1Bytes)+len(doc2Bytes), len(bytes), 500)
+	return doc.GetBytes()
+}
+
+func getFieldNames(t *testing.T, pdf []byte) []string {
+	fields, err := api.FormFields(bytes.NewReader(pdf), model.NewDefaultConfiguration())
+	assert.Nil(t, err)
+
+	var names []string
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+	return names
 }
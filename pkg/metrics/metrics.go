@@ -165,6 +165,8 @@ func (s *SizeMetric) String() string {
 type Report struct {
 	TimeMetrics []TimeMetric
 	SizeMetric  SizeMetric
+	// ImageMetrics are the sizes of the images before and after the image optimization, when it is enabled.
+	ImageMetrics []SizeMetric
 }
 
 // Normalize normalizes the report.
@@ -175,6 +177,10 @@ func (r *Report) Normalize() *Report {
 
 	r.SizeMetric.Normalize()
 
+	for i := range r.ImageMetrics {
+		r.ImageMetrics[i].Normalize()
+	}
+
 	return r
 }
 
@@ -194,6 +200,9 @@ func (r *Report) Save(file string) error {
 		content += metric.String() + "\n"
 	}
 	content += r.SizeMetric.String() + "\n"
+	for _, metric := range r.ImageMetrics {
+		content += metric.String() + "\n"
+	}
 
 	f, err := os.Create(file)
 	if err != nil {
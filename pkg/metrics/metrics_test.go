@@ -264,3 +264,22 @@ func TestSizeMetric_String(t *testing.T) {
 	// Assert
 	assert.Equal(t, "keyMetric -> 2000.00b", s)
 }
+
+func TestReport_Normalize(t *testing.T) {
+	// Arrange
+	report := &metrics.Report{
+		SizeMetric: metrics.SizeMetric{Key: "file_size", Size: metrics.Size{Value: 3000, Scale: metrics.Byte}},
+		ImageMetrics: []metrics.SizeMetric{
+			{Key: "images_original_size", Size: metrics.Size{Value: 2000000, Scale: metrics.Byte}},
+			{Key: "images_saved_size", Size: metrics.Size{Value: 500, Scale: metrics.Byte}},
+		},
+	}
+
+	// Act
+	report.Normalize()
+
+	// Assert
+	assert.Equal(t, "file_size -> 3.00Kb", report.SizeMetric.String())
+	assert.Equal(t, "images_original_size -> 2.00Mb", report.ImageMetrics[0].String())
+	assert.Equal(t, "images_saved_size -> 500.00b", report.ImageMetrics[1].String())
+}
@@ -0,0 +1,139 @@
+package payload
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	boletoLength     = 44
+	boletoMaxAmount  = 99999999.99
+	boletoRealCode   = "9"
+	boletoFactorDays = 9000
+)
+
+// boletoBaseDate is the date of the due date factor 1000 after it restarted, factors count the days from it
+// and restart at 1000 after 9999.
+var boletoBaseDate = time.Date(2025, time.February, 22, 0, 0, 0, 0, time.UTC)
+
+// Boleto is the barcode of a Brazilian boleto bancário, following the FEBRABAN layout.
+type Boleto struct {
+	// Bank is the code of the bank with 3 digits, ex: 001.
+	Bank string
+	// Currency is the currency code with 1 digit. Default: 9 (real)
+	Currency string
+	// DueDate is converted to the due date factor, a zero date means that the boleto has no due date.
+	DueDate time.Time
+	// Amount in reais, up to 99999999.99. Zero lets the payer choose the amount.
+	Amount float64
+	// FreeField is the field defined by each bank with 25 digits, which has the agreement and the our number.
+	FreeField string
+}
+
+// Build validates the boleto and returns the 44 digits encoded in the Interleaved 2 of 5 barcode.
+func (b *Boleto) Build() (string, error) {
+	currency := b.Currency
+	if currency == "" {
+		currency = boletoRealCode
+	}
+
+	if len(b.Bank) != 3 || !isDigits(b.Bank) {
+		return "", errors.New("boleto bank must have 3 digits")
+	}
+
+	if len(currency) != 1 || !isDigits(currency) {
+		return "", errors.New("boleto currency must have 1 digit")
+	}
+
+	if b.Amount > boletoMaxAmount {
+		return "", fmt.Errorf("boleto amount must be at most %.2f", boletoMaxAmount)
+	}
+
+	if err := validateAmount("boleto", b.Amount); err != nil {
+		return "", err
+	}
+
+	if len(b.FreeField) != 25 || !isDigits(b.FreeField) {
+		return "", errors.New("boleto free field must have 25 digits")
+	}
+
+	cents := int64(math.Round(b.Amount * 100))
+	code := fmt.Sprintf("%s%s%04d%010d%s", b.Bank, currency, getBoletoFactor(b.DueDate), cents, b.FreeField)
+
+	return code[:4] + string(getBoletoCheckDigit(code)) + code[4:], nil
+}
+
+// DigitableLine validates the boleto and returns its formatted digitable line.
+func (b *Boleto) DigitableLine() (string, error) {
+	code, err := b.Build()
+	if err != nil {
+		return "", err
+	}
+
+	return GetBoletoDigitableLine(code)
+}
+
+// GetBoletoDigitableLine returns the digitable line of the 44 digits of a boleto barcode, the free field is split
+// in three fields with their check digits, ex: 00190.50095 40144.816069 06809.350314 3 37370000000100.
+func GetBoletoDigitableLine(code string) (string, error) {
+	if len(code) != boletoLength || !isDigits(code) {
+		return "", errors.New("boleto barcode must have 44 digits")
+	}
+
+	if getBoletoCheckDigit(code[:4]+code[5:]) != code[4] {
+		return "", errors.New("boleto barcode check digit does not match")
+	}
+
+	first := code[:4] + code[19:24]
+	second := code[24:34]
+	third := code[34:44]
+
+	first += string(getMod10CheckDigit(first))
+	second += string(getMod10CheckDigit(second))
+	third += string(getMod10CheckDigit(third))
+
+	return fmt.Sprintf("%s.%s %s.%s %s.%s %s %s", first[:5], first[5:], second[:5], second[5:], third[:5], third[5:],
+		code[4:5], code[5:19]), nil
+}
+
+// getBoletoFactor returns the due date factor, which is 1000 plus the days since the base date, a zero date returns zero.
+func getBoletoFactor(dueDate time.Time) int {
+	if dueDate.IsZero() {
+		return 0
+	}
+
+	date := time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(math.Round(date.Sub(boletoBaseDate).Hours() / 24))
+
+	return ((days%boletoFactorDays)+boletoFactorDays)%boletoFactorDays + 1000
+}
+
+// getBoletoCheckDigit is the modulo 11 of the 43 digits of the barcode, with weights from 2 to 9 starting
+// from the right. The results 0, 10 and 11 become 1.
+func getBoletoCheckDigit(digits string) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		weight := (len(digits)-1-i)%8 + 2
+		sum += int(digits[i]-'0') * weight
+	}
+
+	digit := 11 - sum%11
+	if digit == 0 || digit >= 10 {
+		return '1'
+	}
+
+	return byte('0' + digit)
+}
+
+// getMod10CheckDigit weights the digits from the right with 2 and 1, the digits of each product are summed.
+func getMod10CheckDigit(digits string) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		product := int(digits[i]-'0') * (2 - (len(digits)-1-i)%2)
+		sum += product/10 + product%10
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,110 @@
+package payload_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/johnfercher/maroto/v2/pkg/payload"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoleto_Build(t *testing.T) {
+	t.Run("when boleto is valid, should return the 44 digits with the check digit", func(t *testing.T) {
+		// Arrange
+		sut := fixtureBoleto()
+
+		// Act
+		s, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "00193373700000001000500940144816060680935031", s)
+	})
+	t.Run("when due date is after the factor restart, should start again from 1000", func(t *testing.T) {
+		// Arrange
+		before := fixtureBoleto()
+		before.DueDate = time.Date(2025, time.February, 21, 0, 0, 0, 0, time.UTC)
+		after := fixtureBoleto()
+		after.DueDate = time.Date(2025, time.February, 22, 0, 0, 0, 0, time.UTC)
+
+		// Act
+		beforeCode, _ := before.Build()
+		afterCode, _ := after.Build()
+
+		// Assert
+		assert.Equal(t, "9999", beforeCode[5:9])
+		assert.Equal(t, "1000", afterCode[5:9])
+	})
+	t.Run("when due date is not sent, should use factor zero", func(t *testing.T) {
+		// Arrange
+		sut := fixtureBoleto()
+		sut.DueDate = time.Time{}
+
+		// Act
+		s, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "0000", s[5:9])
+	})
+
+	invalid := map[string]payload.Boleto{
+		"boleto bank must have 3 digits":                  {Bank: "1", FreeField: "0500940144816060680935031"},
+		"boleto currency must have 1 digit":               {Bank: "001", Currency: "R$", FreeField: "0500940144816060680935031"},
+		"boleto amount must be at most 99999999.99":       {Bank: "001", Amount: 100000000, FreeField: "0500940144816060680935031"},
+		"boleto amount must be from 0.01 to 999999999.99": {Bank: "001", Amount: -1, FreeField: "0500940144816060680935031"},
+		"boleto free field must have 25 digits":           {Bank: "001", FreeField: "05009401448160606809"},
+	}
+	for message, sut := range invalid {
+		t.Run("when boleto is invalid, should return "+message, func(t *testing.T) {
+			// Act
+			s, err := sut.Build()
+
+			// Assert
+			assert.EqualError(t, err, message)
+			assert.Empty(t, s)
+		})
+	}
+}
+
+func TestBoleto_DigitableLine(t *testing.T) {
+	t.Run("when boleto is valid, should return the formatted line", func(t *testing.T) {
+		// Arrange
+		sut := fixtureBoleto()
+
+		// Act
+		line, err := sut.DigitableLine()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "00190.50095 40144.816069 06809.350314 3 37370000000100", line)
+	})
+}
+
+func TestGetBoletoDigitableLine(t *testing.T) {
+	t.Run("when barcode does not have 44 digits, should return error", func(t *testing.T) {
+		// Act
+		line, err := payload.GetBoletoDigitableLine("0019337370000000100050094014481606068093503")
+
+		// Assert
+		assert.EqualError(t, err, "boleto barcode must have 44 digits")
+		assert.Empty(t, line)
+	})
+	t.Run("when check digit does not match, should return error", func(t *testing.T) {
+		// Act
+		line, err := payload.GetBoletoDigitableLine("00194373700000001000500940144816060680935031")
+
+		// Assert
+		assert.EqualError(t, err, "boleto barcode check digit does not match")
+		assert.Empty(t, line)
+	})
+}
+
+func fixtureBoleto() payload.Boleto {
+	return payload.Boleto{
+		Bank:      "001",
+		DueDate:   time.Date(2007, time.December, 31, 0, 0, 0, 0, time.UTC),
+		Amount:    1,
+		FreeField: "0500940144816060680935031",
+	}
+}
@@ -0,0 +1,136 @@
+package payload
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	vCardSpecial  = "\\;,"
+	meCardSpecial = "\\;,:\""
+)
+
+// Contact is a business card, which can be encoded as a vCard or as a MeCard.
+type Contact struct {
+	// FirstName is the given name of the contact.
+	FirstName string
+	// LastName is the family name of the contact.
+	LastName string
+	// Organization is the company of the contact.
+	Organization string
+	// Title is the job title of the contact.
+	Title string
+	// Phone is the phone number of the contact.
+	Phone string
+	// Email is the email of the contact.
+	Email string
+	// URL is the website of the contact.
+	URL string
+	// Address is the postal address of the contact.
+	Address *Address
+	// Note is a free text about the contact.
+	Note string
+}
+
+// VCard validates the contact and returns a vCard 3.0 payload.
+func (c *Contact) VCard() (string, error) {
+	if err := c.validate(); err != nil {
+		return "", err
+	}
+
+	lines := []string{"BEGIN:VCARD", "VERSION:3.0"}
+	lines = append(lines, "N:"+escape(c.LastName, vCardSpecial)+";"+escape(c.FirstName, vCardSpecial))
+	lines = append(lines, "FN:"+escape(c.getFullName(), vCardSpecial))
+	lines = appendVCardLine(lines, "ORG", c.Organization)
+	lines = appendVCardLine(lines, "TITLE", c.Title)
+	lines = appendVCardLine(lines, "TEL", c.Phone)
+	lines = appendVCardLine(lines, "EMAIL", c.Email)
+	lines = appendVCardLine(lines, "URL", c.URL)
+	if c.Address != nil {
+		a := c.Address
+		fields := []string{"", "", getStreet(a), a.Town, a.Region, a.PostalCode, a.Country}
+		for i := range fields {
+			fields[i] = escape(fields[i], vCardSpecial)
+		}
+		lines = append(lines, "ADR:"+strings.Join(fields, ";"))
+	}
+	lines = appendVCardLine(lines, "NOTE", c.Note)
+	lines = append(lines, "END:VCARD")
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// MeCard validates the contact and returns a MeCard payload, which is shorter than a vCard.
+// MeCard has no organization and title fields.
+func (c *Contact) MeCard() (string, error) {
+	if err := c.validate(); err != nil {
+		return "", err
+	}
+
+	name := escape(c.LastName, meCardSpecial)
+	if c.FirstName != "" {
+		name += "," + escape(c.FirstName, meCardSpecial)
+	}
+
+	var payload strings.Builder
+	payload.WriteString("MECARD:N:" + name + ";")
+	appendMeCardField(&payload, "TEL", c.Phone)
+	appendMeCardField(&payload, "EMAIL", c.Email)
+	appendMeCardField(&payload, "URL", c.URL)
+	if c.Address != nil {
+		a := c.Address
+		fields := []string{getStreet(a), a.Town, a.Region, a.PostalCode, a.Country}
+		appendMeCardField(&payload, "ADR", strings.Join(removeEmpty(fields), ", "))
+	}
+	appendMeCardField(&payload, "NOTE", c.Note)
+	payload.WriteString(";")
+
+	return payload.String(), nil
+}
+
+func (c *Contact) validate() error {
+	if c.FirstName == "" && c.LastName == "" {
+		return errors.New("contact must have a first name or a last name")
+	}
+
+	if c.Email != "" && !isEmail(c.Email) {
+		return errors.New("contact email is not valid")
+	}
+
+	return nil
+}
+
+func (c *Contact) getFullName() string {
+	return strings.TrimSpace(c.FirstName + " " + c.LastName)
+}
+
+func appendVCardLine(lines []string, name, value string) []string {
+	if value == "" {
+		return lines
+	}
+
+	value = strings.ReplaceAll(escape(value, vCardSpecial), "\n", "\\n")
+	return append(lines, name+":"+value)
+}
+
+func appendMeCardField(payload *strings.Builder, name, value string) {
+	if value == "" {
+		return
+	}
+
+	payload.WriteString(name + ":" + escape(value, meCardSpecial) + ";")
+}
+
+func getStreet(address *Address) string {
+	return strings.TrimSpace(address.Street + " " + address.BuildingNumber)
+}
+
+func removeEmpty(values []string) []string {
+	var result []string
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,81 @@
+package payload_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/payload"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContact_VCard(t *testing.T) {
+	t.Run("when contact is valid, should return the vcard with escaped fields", func(t *testing.T) {
+		// Arrange
+		sut := fixtureContact()
+
+		// Act
+		s, err := sut.VCard()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "BEGIN:VCARD\nVERSION:3.0\nN:Silva;Maria\nFN:Maria Silva\nORG:Maroto\\, Inc.\n"+
+			"TEL:+5511987654321\nEMAIL:maria@example.com\nADR:;;Rua A 10;Sao Paulo;SP;01000-000;BR\n"+
+			"NOTE:line 1\\nline 2\nEND:VCARD", s)
+	})
+	t.Run("when contact has no name, should return error", func(t *testing.T) {
+		// Arrange
+		sut := payload.Contact{Phone: "+5511987654321"}
+
+		// Act
+		s, err := sut.VCard()
+
+		// Assert
+		assert.EqualError(t, err, "contact must have a first name or a last name")
+		assert.Empty(t, s)
+	})
+}
+
+func TestContact_MeCard(t *testing.T) {
+	t.Run("when contact is valid, should return the mecard with escaped fields", func(t *testing.T) {
+		// Arrange
+		sut := fixtureContact()
+		sut.Note = "call: mornings"
+
+		// Act
+		s, err := sut.MeCard()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "MECARD:N:Silva,Maria;TEL:+5511987654321;EMAIL:maria@example.com;"+
+			"ADR:Rua A 10\\, Sao Paulo\\, SP\\, 01000-000\\, BR;NOTE:call\\: mornings;;", s)
+	})
+	t.Run("when email is invalid, should return error", func(t *testing.T) {
+		// Arrange
+		sut := payload.Contact{FirstName: "Maria", Email: "maria"}
+
+		// Act
+		s, err := sut.MeCard()
+
+		// Assert
+		assert.EqualError(t, err, "contact email is not valid")
+		assert.Empty(t, s)
+	})
+}
+
+func fixtureContact() payload.Contact {
+	return payload.Contact{
+		FirstName:    "Maria",
+		LastName:     "Silva",
+		Organization: "Maroto, Inc.",
+		Phone:        "+5511987654321",
+		Email:        "maria@example.com",
+		Address: &payload.Address{
+			Street:         "Rua A",
+			BuildingNumber: "10",
+			PostalCode:     "01000-000",
+			Town:           "Sao Paulo",
+			Region:         "SP",
+			Country:        "BR",
+		},
+		Note: "line 1\nline 2",
+	}
+}
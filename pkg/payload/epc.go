@@ -0,0 +1,101 @@
+package payload
+
+import (
+	"errors"
+	"strings"
+)
+
+const epcMaxBytes = 331
+
+// EPC is a SEPA credit transfer following the European Payments Council guideline EPC069-12, version 002.
+type EPC struct {
+	// BIC of the beneficiary bank, optional inside the European Economic Area.
+	BIC string
+	// Name of the beneficiary, up to 70 characters.
+	Name string
+	// IBAN of the beneficiary, spaces are removed.
+	IBAN string
+	// Amount in euros, from 0.01 to 999999999.99. Zero lets the payer choose the amount.
+	Amount float64
+	// Purpose is a four letters ISO 20022 purpose code, ex: CHAR.
+	Purpose string
+	// Reference is a structured creditor reference, up to 35 characters. It can't be sent with Text.
+	Reference string
+	// Text is the unstructured remittance information, up to 140 characters. It can't be sent with Reference.
+	Text string
+	// Information is a note from the beneficiary to the payer, up to 70 characters.
+	Information string
+}
+
+// Build validates the transfer and returns the payload of the qrcode.
+func (e *EPC) Build() (string, error) {
+	iban := normalizeIBAN(e.IBAN)
+	if err := validateIBAN(iban); err != nil {
+		return "", err
+	}
+
+	bic := strings.ToUpper(strings.ReplaceAll(e.BIC, " ", ""))
+	if err := validateBIC(bic); err != nil {
+		return "", err
+	}
+
+	if err := e.validateFields(); err != nil {
+		return "", err
+	}
+
+	amount := formatAmount(e.Amount)
+	if amount != "" {
+		amount = "EUR" + amount
+	}
+
+	lines := []string{
+		"BCD", "002", "1", "SCT", bic, e.Name, iban, amount, e.Purpose, e.Reference, e.Text, e.Information,
+	}
+	payload := strings.TrimRight(strings.Join(lines, "\n"), "\n")
+	if len(payload) > epcMaxBytes {
+		return "", errors.New("epc payload must have at most 331 bytes")
+	}
+
+	return payload, nil
+}
+
+func (e *EPC) validateFields() error {
+	if err := validateLength("epc name", e.Name, 70, true); err != nil {
+		return err
+	}
+
+	if err := validateAmount("epc", e.Amount); err != nil {
+		return err
+	}
+
+	if e.Purpose != "" && (len(e.Purpose) != 4 || !isAlphanumeric(e.Purpose)) {
+		return errors.New("epc purpose must have 4 uppercase letters or digits")
+	}
+
+	if e.Reference != "" && e.Text != "" {
+		return errors.New("epc reference and text can't be sent together")
+	}
+
+	if err := validateLength("epc reference", e.Reference, 35, false); err != nil {
+		return err
+	}
+
+	if err := validateLength("epc text", e.Text, 140, false); err != nil {
+		return err
+	}
+
+	return validateLength("epc information", e.Information, 70, false)
+}
+
+// validateBIC checks a bank identifier code, which has 8 or 11 characters. An empty BIC is valid.
+func validateBIC(bic string) error {
+	if bic == "" {
+		return nil
+	}
+
+	if (len(bic) != 8 && len(bic) != 11) || !isLetters(bic[:6]) || !isAlphanumeric(bic[6:]) {
+		return errors.New("bic must have 8 or 11 characters, starting with the bank and the country letters")
+	}
+
+	return nil
+}
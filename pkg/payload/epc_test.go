@@ -0,0 +1,66 @@
+package payload_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/payload"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEPC_Build(t *testing.T) {
+	t.Run("when transfer is valid, should return the lines of the guideline", func(t *testing.T) {
+		// Arrange
+		sut := payload.EPC{
+			BIC:     "BPOTBEB1",
+			Name:    "Red Cross of Belgium",
+			IBAN:    "BE72 0000 0000 1616",
+			Amount:  1,
+			Purpose: "CHAR",
+			Text:    "Urgency fund",
+		}
+
+		// Act
+		s, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "BCD\n002\n1\nSCT\nBPOTBEB1\nRed Cross of Belgium\nBE72000000001616\nEUR1.00\nCHAR\n\nUrgency fund", s)
+	})
+	t.Run("when optional fields are not sent, should omit the trailing lines", func(t *testing.T) {
+		// Arrange
+		sut := payload.EPC{Name: "Maroto", IBAN: "DE89370400440532013000"}
+
+		// Act
+		s, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "BCD\n002\n1\nSCT\n\nMaroto\nDE89370400440532013000", s)
+	})
+
+	invalid := map[string]payload.EPC{
+		"iban DE89370400440532013001 check digits do not match": {Name: "Maroto", IBAN: "DE89370400440532013001"},
+		"iban must have from 15 to 34 letters and digits":       {Name: "Maroto", IBAN: "DE89-3704"},
+		"bic must have 8 or 11 characters, starting with the bank and the country letters": {
+			Name: "Maroto", IBAN: "DE89370400440532013000", BIC: "COBA",
+		},
+		"epc name is required":                         {IBAN: "DE89370400440532013000"},
+		"epc amount must be from 0.01 to 999999999.99": {Name: "Maroto", IBAN: "DE89370400440532013000", Amount: -1},
+		"epc amount must have at most 2 decimal places": {
+			Name: "Maroto", IBAN: "DE89370400440532013000", Amount: 1.005,
+		},
+		"epc reference and text can't be sent together": {
+			Name: "Maroto", IBAN: "DE89370400440532013000", Reference: "RF18539007547034", Text: "invoice",
+		},
+	}
+	for message, sut := range invalid {
+		t.Run("when transfer is invalid, should return "+message, func(t *testing.T) {
+			// Act
+			s, err := sut.Build()
+
+			// Assert
+			assert.EqualError(t, err, message)
+			assert.Empty(t, s)
+		})
+	}
+}
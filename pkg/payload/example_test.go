@@ -0,0 +1,120 @@
+package payload_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
+	"github.com/johnfercher/maroto/v2/pkg/components/text"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/qrlevel"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+
+	"github.com/johnfercher/maroto/v2/pkg/payload"
+)
+
+// ExampleEPC_Build demonstrates how to add a SEPA credit transfer qrcode to maroto.
+func ExampleEPC_Build() {
+	m := maroto.New()
+
+	epc := payload.EPC{Name: "Maroto", IBAN: "DE89 3704 0044 0532 0130 00", Amount: 150.25, Text: "Invoice 42"}
+	qr, err := epc.Build()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	m.AddRows(code.NewQrRow(40, qr))
+
+	// generate document
+}
+
+// ExamplePix_Build demonstrates how to add a Pix qrcode to maroto.
+func ExamplePix_Build() {
+	m := maroto.New()
+
+	pix := payload.Pix{Key: "maroto@example.com", Name: "Maroto", City: "SAO PAULO", Amount: 99.9}
+	qr, err := pix.Build()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	m.AddRows(code.NewQrRow(40, qr))
+
+	// generate document
+}
+
+// ExampleSwissQR_Build demonstrates how to add the qrcode of a Swiss QR-bill to maroto.
+func ExampleSwissQR_Build() {
+	m := maroto.New()
+
+	bill := payload.SwissQR{
+		IBAN: "CH58 0079 1123 0008 8901 2",
+		Creditor: payload.Party{
+			Name:    "Robert Schneider AG",
+			Address: payload.Address{Street: "Rue du Lac", BuildingNumber: "1268", PostalCode: "2501", Town: "Biel", Country: "CH"},
+		},
+		Amount: 199.95,
+	}
+	qr, err := bill.Build()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	m.AddRows(code.NewQrRow(46, qr, props.QrCode{ErrorCorrection: qrlevel.M}))
+
+	// generate document
+}
+
+// ExampleContact_VCard demonstrates how to add a business card qrcode to maroto.
+func ExampleContact_VCard() {
+	m := maroto.New()
+
+	contact := payload.Contact{FirstName: "Maria", LastName: "Silva", Phone: "+5511987654321", Email: "maria@example.com"}
+	qr, err := contact.VCard()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	m.AddRows(code.NewQrRow(40, qr))
+
+	// generate document
+}
+
+// ExampleWiFi_Build demonstrates how to add a Wi-Fi qrcode to maroto.
+func ExampleWiFi_Build() {
+	m := maroto.New()
+
+	wifi := payload.WiFi{SSID: "Office", Password: "correct horse battery"}
+	qr, err := wifi.Build()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	m.AddRows(code.NewQrRow(40, qr))
+
+	// generate document
+}
+
+// ExampleBoleto_Build demonstrates how to add the barcode and the digitable line of a boleto to maroto.
+func ExampleBoleto_Build() {
+	m := maroto.New()
+
+	boleto := payload.Boleto{
+		Bank:      "001",
+		DueDate:   time.Date(2026, time.November, 30, 0, 0, 0, 0, time.UTC),
+		Amount:    1500.50,
+		FreeField: "0000001234567890123456717",
+	}
+	barcode, err := boleto.Build()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	line, _ := boleto.DigitableLine()
+	m.AddRows(text.NewRow(10, line, props.Text{Align: align.Right, Style: fontstyle.Bold}))
+	m.AddRows(code.NewAutoBoletoRow(barcode))
+
+	// generate document
+}
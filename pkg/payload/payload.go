@@ -0,0 +1,152 @@
+// Package payload implements the text encoded in payment, contact and Wi-Fi codes.
+// The payloads are validated when built and are meant to be sent to code.NewQr or code.NewBoleto.
+package payload
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxAmount = 999999999.99
+
+// Address is a postal address.
+type Address struct {
+	// Street is the street name, without the building number.
+	Street string
+	// BuildingNumber is the number of the building in the street.
+	BuildingNumber string
+	// PostalCode is the postal code without the country prefix.
+	PostalCode string
+	// Town is the town or city.
+	Town string
+	// Region is the state or province, it is not used by payment payloads.
+	Region string
+	// Country is the two letters ISO 3166 country code, ex: CH.
+	Country string
+}
+
+// Party is a person or company with its address.
+type Party struct {
+	Name    string
+	Address Address
+}
+
+// normalizeIBAN removes the spaces of an IBAN and converts it to uppercase.
+func normalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.ReplaceAll(iban, " ", ""))
+}
+
+// validateIBAN checks the structure and the check digits of a normalized IBAN.
+func validateIBAN(iban string) error {
+	if len(iban) < 15 || len(iban) > 34 || !isAlphanumeric(iban) {
+		return errors.New("iban must have from 15 to 34 letters and digits")
+	}
+
+	if !isLetters(iban[:2]) || !isDigits(iban[2:4]) {
+		return fmt.Errorf("iban %s must start with the country code and two check digits", iban)
+	}
+
+	if !isMod97Valid(iban[4:] + iban[:4]) {
+		return fmt.Errorf("iban %s check digits do not match", iban)
+	}
+
+	return nil
+}
+
+// isMod97Valid checks the ISO 7064 MOD 97-10 check digits used by IBANs and creditor references,
+// letters are converted to numbers from A = 10 to Z = 35.
+func isMod97Valid(value string) bool {
+	var digits strings.Builder
+	for _, r := range value {
+		if r >= 'A' && r <= 'Z' {
+			digits.WriteString(strconv.Itoa(int(r-'A') + 10))
+			continue
+		}
+		digits.WriteRune(r)
+	}
+
+	number, ok := new(big.Int).SetString(digits.String(), 10)
+	if !ok {
+		return false
+	}
+
+	return new(big.Int).Mod(number, big.NewInt(97)).Int64() == 1
+}
+
+// validateAmount checks an amount sent to a payment, zero means that the payer chooses the amount.
+func validateAmount(name string, amount float64) error {
+	if amount == 0 {
+		return nil
+	}
+
+	if amount < 0.01 || amount > maxAmount {
+		return fmt.Errorf("%s amount must be from 0.01 to %.2f", name, maxAmount)
+	}
+
+	if cents := amount * 100; math.Abs(cents-math.Round(cents)) > 1e-6 {
+		return fmt.Errorf("%s amount must have at most 2 decimal places", name)
+	}
+
+	return nil
+}
+
+func formatAmount(amount float64) string {
+	if amount == 0 {
+		return ""
+	}
+
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+// validateLength checks that a field has at most max characters, required fields must have at least one.
+func validateLength(name, value string, max int, required bool) error {
+	length := utf8.RuneCountInString(value)
+	if required && length == 0 {
+		return fmt.Errorf("%s is required", name)
+	}
+
+	if length > max {
+		return fmt.Errorf("%s must have at most %d characters", name, max)
+	}
+
+	return nil
+}
+
+// escape adds a backslash before each special character of a contact or Wi-Fi field.
+func escape(value, special string) string {
+	var escaped strings.Builder
+	for _, r := range value {
+		if strings.ContainsRune(special, r) {
+			escaped.WriteRune('\\')
+		}
+		escaped.WriteRune(r)
+	}
+
+	return escaped.String()
+}
+
+func isDigits(value string) bool {
+	return value != "" && containsOnly(value, func(r rune) bool { return r >= '0' && r <= '9' })
+}
+
+func isLetters(value string) bool {
+	return value != "" && containsOnly(value, func(r rune) bool { return r >= 'A' && r <= 'Z' })
+}
+
+func isAlphanumeric(value string) bool {
+	return containsOnly(value, func(r rune) bool { return (r >= '0' && r <= '9') || (r >= 'A' && r <= 'Z') })
+}
+
+func containsOnly(value string, accept func(r rune) bool) bool {
+	for _, r := range value {
+		if !accept(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,223 @@
+package payload
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	pixGUI         = "br.gov.bcb.pix"
+	pixNoTxID      = "***"
+	pixMaxTemplate = 99
+)
+
+var pixRandomKey = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// Pix is a static Brazilian instant payment, encoded as an EMV BR Code.
+type Pix struct {
+	// Key is the Pix key of the receiver: a CPF, a CNPJ, an email, a phone with +55 or a random key.
+	Key string
+	// Name of the receiver, up to 25 ASCII characters.
+	Name string
+	// City of the receiver, up to 15 ASCII characters.
+	City string
+	// Amount in reais, zero lets the payer choose the amount.
+	Amount float64
+	// Description is a message shown to the payer.
+	Description string
+	// TxID identifies the payment, up to 25 letters and digits. Default: ***
+	TxID string
+	// OneTime indicates that the code must be paid only once.
+	OneTime bool
+}
+
+// Build validates the payment and returns the payload of the qrcode, which ends with its CRC16.
+func (p *Pix) Build() (string, error) {
+	if err := validatePixKey(p.Key); err != nil {
+		return "", err
+	}
+
+	if err := p.validateFields(); err != nil {
+		return "", err
+	}
+
+	account := pixField("00", pixGUI) + pixField("01", p.Key)
+	if p.Description != "" {
+		account += pixField("02", p.Description)
+	}
+	if len(account) > pixMaxTemplate {
+		return "", errors.New("pix key and description must have at most 77 characters together")
+	}
+
+	txID := p.TxID
+	if txID == "" {
+		txID = pixNoTxID
+	}
+
+	var payload strings.Builder
+	payload.WriteString(pixField("00", "01"))
+	if p.OneTime {
+		payload.WriteString(pixField("01", "12"))
+	}
+	payload.WriteString(pixField("26", account))
+	payload.WriteString(pixField("52", "0000"))
+	payload.WriteString(pixField("53", "986"))
+	if p.Amount != 0 {
+		payload.WriteString(pixField("54", formatAmount(p.Amount)))
+	}
+	payload.WriteString(pixField("58", "BR"))
+	payload.WriteString(pixField("59", p.Name))
+	payload.WriteString(pixField("60", p.City))
+	payload.WriteString(pixField("62", pixField("05", txID)))
+	payload.WriteString("6304")
+
+	return payload.String() + fmt.Sprintf("%04X", crc16(payload.String())), nil
+}
+
+func (p *Pix) validateFields() error {
+	if err := validatePixText("pix name", p.Name, 25); err != nil {
+		return err
+	}
+
+	if err := validatePixText("pix city", p.City, 15); err != nil {
+		return err
+	}
+
+	if err := validateAmount("pix", p.Amount); err != nil {
+		return err
+	}
+
+	if p.Description != "" && !isASCII(p.Description) {
+		return errors.New("pix description must only have ASCII characters")
+	}
+
+	if p.TxID != "" && (len(p.TxID) > 25 || !containsOnly(p.TxID, isASCIIAlphanumeric)) {
+		return errors.New("pix txid must have from 1 to 25 letters and digits")
+	}
+
+	return nil
+}
+
+// validatePixKey checks the format of each type of key, CPF and CNPJ keys have their check digits checked.
+func validatePixKey(key string) error {
+	switch {
+	case key == "":
+		return errors.New("pix key is required")
+	case strings.Contains(key, "@"):
+		if len(key) > 77 || !isEmail(key) {
+			return fmt.Errorf("pix key %s is not a valid email", key)
+		}
+	case strings.HasPrefix(key, "+"):
+		if !strings.HasPrefix(key, "+55") || !isDigits(key[1:]) || len(key) < 13 || len(key) > 14 {
+			return fmt.Errorf("pix key %s must be a phone with +55, the area code and the number", key)
+		}
+	case len(key) == 36:
+		if !pixRandomKey.MatchString(key) {
+			return fmt.Errorf("pix key %s is not a valid random key", key)
+		}
+	case len(key) == 11 && isDigits(key):
+		if !isCPFValid(key) {
+			return fmt.Errorf("pix key %s cpf check digits do not match", key)
+		}
+	case len(key) == 14 && isDigits(key):
+		if !isCNPJValid(key) {
+			return fmt.Errorf("pix key %s cnpj check digits do not match", key)
+		}
+	default:
+		return fmt.Errorf("pix key %s must be a cpf, cnpj, email, phone or random key", key)
+	}
+
+	return nil
+}
+
+func validatePixText(name, value string, max int) error {
+	if err := validateLength(name, value, max, true); err != nil {
+		return err
+	}
+
+	if !isASCII(value) {
+		return fmt.Errorf("%s must only have ASCII characters", name)
+	}
+
+	return nil
+}
+
+// isEmail checks that the value has a single @ between the user and a domain with a dot.
+func isEmail(value string) bool {
+	user, domain, found := strings.Cut(value, "@")
+	return found && user != "" && !strings.Contains(domain, "@") && strings.Contains(strings.Trim(domain, "."), ".")
+}
+
+func isCPFValid(cpf string) bool {
+	if strings.Count(cpf, cpf[:1]) == len(cpf) {
+		return false
+	}
+
+	return getCPFCheckDigit(cpf[:9]) == cpf[9] && getCPFCheckDigit(cpf[:10]) == cpf[10]
+}
+
+// getCPFCheckDigit weights the digits from the right starting with 2.
+func getCPFCheckDigit(digits string) byte {
+	sum := 0
+	for i, r := range digits {
+		sum += int(r-'0') * (len(digits) + 1 - i)
+	}
+
+	return byte('0' + sum*10%11%10)
+}
+
+func isCNPJValid(cnpj string) bool {
+	if strings.Count(cnpj, cnpj[:1]) == len(cnpj) {
+		return false
+	}
+
+	return getCNPJCheckDigit(cnpj[:12]) == cnpj[12] && getCNPJCheckDigit(cnpj[:13]) == cnpj[13]
+}
+
+// getCNPJCheckDigit weights the digits from the right from 2 to 9, starting again after 9.
+func getCNPJCheckDigit(digits string) byte {
+	sum := 0
+	for i := range digits {
+		weight := (len(digits)-1-i)%8 + 2
+		sum += int(digits[i]-'0') * weight
+	}
+
+	rest := sum % 11
+	if rest < 2 {
+		return '0'
+	}
+
+	return byte('0' + 11 - rest)
+}
+
+// pixField writes an EMV field, which is the id, the length with two digits and the value.
+func pixField(id, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(value), value)
+}
+
+// crc16 is the CRC-16/CCITT-FALSE checksum required by the BR Code.
+func crc16(value string) uint16 {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(value); i++ {
+		crc ^= uint16(value[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
+
+func isASCII(value string) bool {
+	return containsOnly(value, func(r rune) bool { return r >= ' ' && r <= '~' })
+}
+
+func isASCIIAlphanumeric(r rune) bool {
+	return (r >= '0' && r <= '9') || (r >= 'A' && r <= 'Z') || (r >= 'a' && r <= 'z')
+}
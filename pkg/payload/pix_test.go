@@ -0,0 +1,88 @@
+package payload_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/payload"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPix_Build(t *testing.T) {
+	t.Run("when payment is valid, should return the br code with crc16", func(t *testing.T) {
+		// Arrange
+		sut := payload.Pix{Key: "123e4567-e12b-12d1-a456-426655440000", Name: "Fulano de Tal", City: "BRASILIA"}
+
+		// Act
+		s, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-42665544000052040000530398658"+
+			"02BR5913Fulano de Tal6008BRASILIA62070503***63041D3D", s)
+	})
+	t.Run("when amount, description and txid are sent, should add their fields", func(t *testing.T) {
+		// Arrange
+		sut := payload.Pix{
+			Key:         "52998224725",
+			Name:        "Maroto",
+			City:        "SAO PAULO",
+			Amount:      10.5,
+			Description: "Invoice 1",
+			TxID:        "INV1",
+			OneTime:     true,
+		}
+
+		// Act
+		s, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, s, "010212")
+		assert.Contains(t, s, "0111529982247250209Invoice 1")
+		assert.Contains(t, s, "540510.50")
+		assert.Contains(t, s, "62080504INV1")
+	})
+
+	validKeys := []string{"11222333000181", "maroto@example.com", "+5511987654321"}
+	for _, key := range validKeys {
+		t.Run("when key is "+key+", should be valid", func(t *testing.T) {
+			// Arrange
+			sut := payload.Pix{Key: key, Name: "Maroto", City: "SAO PAULO"}
+
+			// Act
+			_, err := sut.Build()
+
+			// Assert
+			assert.Nil(t, err)
+		})
+	}
+
+	invalid := map[string]payload.Pix{
+		"pix key 52998224724 cpf check digits do not match":     {Key: "52998224724", Name: "Maroto", City: "SAO PAULO"},
+		"pix key 11222333000180 cnpj check digits do not match": {Key: "11222333000180", Name: "Maroto", City: "SAO PAULO"},
+		"pix key maroto@ is not a valid email":                  {Key: "maroto@", Name: "Maroto", City: "SAO PAULO"},
+		"pix key +1555123456 must be a phone with +55, the area code and the number": {
+			Key: "+1555123456", Name: "Maroto", City: "SAO PAULO",
+		},
+		"pix key 12345 must be a cpf, cnpj, email, phone or random key": {Key: "12345", Name: "Maroto", City: "SAO PAULO"},
+		"pix key is required":                      {Name: "Maroto", City: "SAO PAULO"},
+		"pix city must only have ASCII characters": {Key: "52998224725", Name: "Maroto", City: "SÃO PAULO"},
+		"pix name must have at most 25 characters": {
+			Key: "52998224725", Name: "Maroto Generating PDFs in Go", City: "SAO PAULO",
+		},
+		"pix amount must be from 0.01 to 999999999.99": {Key: "52998224725", Name: "Maroto", City: "SAO PAULO", Amount: -10},
+		"pix txid must have from 1 to 25 letters and digits": {
+			Key: "52998224725", Name: "Maroto", City: "SAO PAULO", TxID: "INV-1",
+		},
+	}
+	for message, sut := range invalid {
+		t.Run("when payment is invalid, should return "+message, func(t *testing.T) {
+			// Act
+			s, err := sut.Build()
+
+			// Assert
+			assert.EqualError(t, err, message)
+			assert.Empty(t, s)
+		})
+	}
+}
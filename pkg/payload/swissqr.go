@@ -0,0 +1,193 @@
+package payload
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	swissMaxChars = 997
+
+	swissQRReference       = "QRR"
+	swissCreditorReference = "SCOR"
+	swissNoReference       = "NON"
+)
+
+// swissReferenceTable is the table of the recursive modulo 10 used by the QR reference check digit.
+var swissReferenceTable = [10]int{0, 9, 4, 6, 8, 2, 7, 1, 3, 5}
+
+// SwissQR is the payment part of a Swiss QR-bill, version 2.0. The bill must be printed with qrlevel.M
+// and the Swiss cross, which is not drawn by maroto, in the center of the code.
+type SwissQR struct {
+	// IBAN of the creditor, a CH or LI IBAN or QR-IBAN.
+	IBAN string
+	// Creditor receives the payment, the name, town and country are required.
+	Creditor Party
+	// Amount of the bill, zero lets the payer choose the amount.
+	Amount float64
+	// Currency of the bill, CHF or EUR. Default: CHF
+	Currency string
+	// Debtor pays the bill, it is optional.
+	Debtor *Party
+	// Reference is a QR reference with 27 digits, required by QR-IBANs, or a creditor reference starting with RF.
+	Reference string
+	// Message is the unstructured message to the payer, up to 140 characters.
+	Message string
+	// BillInformation is the structured information used by the payer software, up to 140 characters.
+	BillInformation string
+}
+
+// Build validates the bill and returns the payload of the qrcode.
+func (s *SwissQR) Build() (string, error) {
+	iban := normalizeIBAN(s.IBAN)
+	if err := validateIBAN(iban); err != nil {
+		return "", err
+	}
+
+	if len(iban) != 21 || (!strings.HasPrefix(iban, "CH") && !strings.HasPrefix(iban, "LI")) {
+		return "", fmt.Errorf("swiss qr iban %s must be a CH or LI iban with 21 characters", iban)
+	}
+
+	reference := strings.ReplaceAll(strings.ToUpper(s.Reference), " ", "")
+	referenceType, err := getSwissReferenceType(iban, reference)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.validateFields(); err != nil {
+		return "", err
+	}
+
+	currency := s.Currency
+	if currency == "" {
+		currency = "CHF"
+	}
+
+	lines := []string{"SPC", "0200", "1", iban}
+	lines = append(lines, getSwissParty(&s.Creditor)...)
+	lines = append(lines, getSwissParty(nil)...)
+	lines = append(lines, formatAmount(s.Amount), currency)
+	lines = append(lines, getSwissParty(s.Debtor)...)
+	lines = append(lines, referenceType, reference, s.Message, "EPD")
+	if s.BillInformation != "" {
+		lines = append(lines, s.BillInformation)
+	}
+
+	payload := strings.Join(lines, "\n")
+	if len([]rune(payload)) > swissMaxChars {
+		return "", errors.New("swiss qr payload must have at most 997 characters")
+	}
+
+	return payload, nil
+}
+
+func (s *SwissQR) validateFields() error {
+	if err := validateSwissParty("swiss qr creditor", &s.Creditor); err != nil {
+		return err
+	}
+
+	if s.Debtor != nil {
+		if err := validateSwissParty("swiss qr debtor", s.Debtor); err != nil {
+			return err
+		}
+	}
+
+	if err := validateAmount("swiss qr", s.Amount); err != nil {
+		return err
+	}
+
+	if s.Currency != "" && s.Currency != "CHF" && s.Currency != "EUR" {
+		return errors.New("swiss qr currency must be CHF or EUR")
+	}
+
+	if err := validateLength("swiss qr message", s.Message, 140, false); err != nil {
+		return err
+	}
+
+	return validateLength("swiss qr bill information", s.BillInformation, 140, false)
+}
+
+// getSwissReferenceType checks the reference, QR-IBANs only accept QR references and other IBANs
+// only accept creditor references or no reference.
+func getSwissReferenceType(iban, reference string) (string, error) {
+	if isSwissQRIBAN(iban) {
+		if len(reference) != 27 || !isDigits(reference) {
+			return "", errors.New("swiss qr reference must have 27 digits when the iban is a qr-iban")
+		}
+
+		if getSwissReferenceCheckDigit(reference[:26]) != reference[26] {
+			return "", fmt.Errorf("swiss qr reference %s check digit does not match", reference)
+		}
+
+		return swissQRReference, nil
+	}
+
+	if reference == "" {
+		return swissNoReference, nil
+	}
+
+	if !strings.HasPrefix(reference, "RF") {
+		return "", errors.New("swiss qr reference must be a creditor reference starting with RF when the iban is not a qr-iban")
+	}
+
+	if len(reference) < 5 || len(reference) > 25 || !isAlphanumeric(reference) || !isMod97Valid(reference[4:]+reference[:4]) {
+		return "", fmt.Errorf("swiss qr creditor reference %s is not valid", reference)
+	}
+
+	return swissCreditorReference, nil
+}
+
+// isSwissQRIBAN checks if the institution identification of the IBAN is from 30000 to 31999.
+func isSwissQRIBAN(iban string) bool {
+	iid := iban[4:9]
+	return iid >= "30000" && iid <= "31999"
+}
+
+func getSwissReferenceCheckDigit(digits string) byte {
+	carry := 0
+	for _, r := range digits {
+		carry = swissReferenceTable[(carry+int(r-'0'))%10]
+	}
+
+	return byte('0' + (10-carry)%10)
+}
+
+func validateSwissParty(name string, party *Party) error {
+	if err := validateLength(name+" name", party.Name, 70, true); err != nil {
+		return err
+	}
+
+	address := party.Address
+	if err := validateLength(name+" street", address.Street, 70, false); err != nil {
+		return err
+	}
+
+	if err := validateLength(name+" building number", address.BuildingNumber, 16, false); err != nil {
+		return err
+	}
+
+	if err := validateLength(name+" postal code", address.PostalCode, 16, false); err != nil {
+		return err
+	}
+
+	if err := validateLength(name+" town", address.Town, 35, true); err != nil {
+		return err
+	}
+
+	if len(address.Country) != 2 || !isLetters(address.Country) {
+		return fmt.Errorf("%s country must be a two uppercase letters code", name)
+	}
+
+	return nil
+}
+
+// getSwissParty returns the seven lines of a structured address, they are empty when the party is not sent.
+func getSwissParty(party *Party) []string {
+	if party == nil {
+		return make([]string, 7)
+	}
+
+	address := party.Address
+	return []string{"S", party.Name, address.Street, address.BuildingNumber, address.PostalCode, address.Town, address.Country}
+}
@@ -0,0 +1,115 @@
+package payload_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/payload"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwissQR_Build(t *testing.T) {
+	t.Run("when iban is a qr-iban, should use the qr reference", func(t *testing.T) {
+		// Arrange
+		sut := payload.SwissQR{
+			IBAN:      "CH44 3199 9123 0008 8901 2",
+			Creditor:  fixtureSwissParty(),
+			Amount:    1949.75,
+			Debtor:    &payload.Party{Name: "Pia-Maria Rutschmann-Schnyder", Address: payload.Address{Town: "Rorschach", Country: "CH"}},
+			Reference: "21 00000 00003 13947 14300 09017",
+			Message:   "Order of 15 June 2020",
+		}
+
+		// Act
+		s, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, strings.Join([]string{
+			"SPC", "0200", "1", "CH4431999123000889012",
+			"S", "Robert Schneider AG", "Rue du Lac", "1268", "2501", "Biel", "CH",
+			"", "", "", "", "", "", "",
+			"1949.75", "CHF",
+			"S", "Pia-Maria Rutschmann-Schnyder", "", "", "", "Rorschach", "CH",
+			"QRR", "210000000003139471430009017", "Order of 15 June 2020", "EPD",
+		}, "\n"), s)
+	})
+	t.Run("when iban is not a qr-iban, should use the creditor reference", func(t *testing.T) {
+		// Arrange
+		sut := payload.SwissQR{
+			IBAN:      "CH5800791123000889012",
+			Creditor:  fixtureSwissParty(),
+			Currency:  "EUR",
+			Reference: "RF18 5390 0754 7034",
+		}
+
+		// Act
+		s, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Contains(t, s, "\n\nEUR\n")
+		assert.True(t, strings.HasSuffix(s, "SCOR\nRF18539007547034\n\nEPD"))
+	})
+	t.Run("when reference is not sent, should use non", func(t *testing.T) {
+		// Arrange
+		sut := payload.SwissQR{IBAN: "CH5800791123000889012", Creditor: fixtureSwissParty()}
+
+		// Act
+		s, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.True(t, strings.HasSuffix(s, "NON\n\n\nEPD"))
+	})
+
+	invalid := map[string]payload.SwissQR{
+		"swiss qr iban DE89370400440532013000 must be a CH or LI iban with 21 characters": {
+			IBAN: "DE89370400440532013000", Creditor: fixtureSwissParty(),
+		},
+		"swiss qr reference must have 27 digits when the iban is a qr-iban": {
+			IBAN: "CH4431999123000889012", Creditor: fixtureSwissParty(),
+		},
+		"swiss qr reference 210000000003139471430009018 check digit does not match": {
+			IBAN: "CH4431999123000889012", Creditor: fixtureSwissParty(), Reference: "210000000003139471430009018",
+		},
+		"swiss qr reference must be a creditor reference starting with RF when the iban is not a qr-iban": {
+			IBAN: "CH5800791123000889012", Creditor: fixtureSwissParty(), Reference: "210000000003139471430009017",
+		},
+		"swiss qr creditor reference RF19539007547034 is not valid": {
+			IBAN: "CH5800791123000889012", Creditor: fixtureSwissParty(), Reference: "RF19539007547034",
+		},
+		"swiss qr creditor country must be a two uppercase letters code": {
+			IBAN: "CH5800791123000889012", Creditor: payload.Party{Name: "Maroto", Address: payload.Address{Town: "Biel"}},
+		},
+		"swiss qr currency must be CHF or EUR": {
+			IBAN: "CH5800791123000889012", Creditor: fixtureSwissParty(), Currency: "USD",
+		},
+		"swiss qr amount must have at most 2 decimal places": {
+			IBAN: "CH5800791123000889012", Creditor: fixtureSwissParty(), Amount: 10.001,
+		},
+	}
+	for message, sut := range invalid {
+		t.Run("when bill is invalid, should return "+message, func(t *testing.T) {
+			// Act
+			s, err := sut.Build()
+
+			// Assert
+			assert.EqualError(t, err, message)
+			assert.Empty(t, s)
+		})
+	}
+}
+
+func fixtureSwissParty() payload.Party {
+	return payload.Party{
+		Name: "Robert Schneider AG",
+		Address: payload.Address{
+			Street:         "Rue du Lac",
+			BuildingNumber: "1268",
+			PostalCode:     "2501",
+			Town:           "Biel",
+			Country:        "CH",
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package payload
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/wifisecurity"
+)
+
+const wifiSpecial = "\\;,:\""
+
+var wepHexKey = regexp.MustCompile(`^([0-9A-Fa-f]{10}|[0-9A-Fa-f]{26})$`)
+
+// WiFi is the access to a Wi-Fi network, read by the camera of phones to join the network.
+type WiFi struct {
+	// SSID is the name of the network, up to 32 bytes.
+	SSID string
+	// Security of the network. Default: wifisecurity.WPA
+	Security wifisecurity.Type
+	// Password of the network, it must be empty when the security is wifisecurity.None.
+	Password string
+	// Hidden indicates that the network does not broadcast its SSID.
+	Hidden bool
+}
+
+// Build validates the network and returns the payload of the qrcode.
+func (w *WiFi) Build() (string, error) {
+	security := w.Security
+	if security == "" {
+		security = wifisecurity.WPA
+	}
+
+	if err := w.validate(security); err != nil {
+		return "", err
+	}
+
+	payload := fmt.Sprintf("WIFI:T:%s;S:%s;", security, escape(w.SSID, wifiSpecial))
+	if security != wifisecurity.None {
+		payload += "P:" + escape(w.Password, wifiSpecial) + ";"
+	}
+	if w.Hidden {
+		payload += "H:true;"
+	}
+
+	return payload + ";", nil
+}
+
+func (w *WiFi) validate(security wifisecurity.Type) error {
+	if w.SSID == "" || len(w.SSID) > 32 {
+		return errors.New("wifi ssid must have from 1 to 32 bytes")
+	}
+
+	switch security {
+	case wifisecurity.WPA:
+		if len(w.Password) < 8 || len(w.Password) > 63 {
+			return errors.New("wifi wpa password must have from 8 to 63 characters")
+		}
+	case wifisecurity.WEP:
+		if len(w.Password) != 5 && len(w.Password) != 13 && !wepHexKey.MatchString(w.Password) {
+			return errors.New("wifi wep password must have 5 or 13 characters, or 10 or 26 hexadecimal digits")
+		}
+	case wifisecurity.None:
+		if w.Password != "" {
+			return errors.New("wifi password must be empty when the network has no security")
+		}
+	default:
+		return fmt.Errorf("wifi security %s is not supported", security)
+	}
+
+	return nil
+}
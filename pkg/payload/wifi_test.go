@@ -0,0 +1,56 @@
+package payload_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/wifisecurity"
+	"github.com/johnfercher/maroto/v2/pkg/payload"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWiFi_Build(t *testing.T) {
+	t.Run("when security is not sent, should use wpa and escape the fields", func(t *testing.T) {
+		// Arrange
+		sut := payload.WiFi{SSID: "Office;1", Password: "pass:word", Hidden: true}
+
+		// Act
+		s, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, `WIFI:T:WPA;S:Office\;1;P:pass\:word;H:true;;`, s)
+	})
+	t.Run("when network is open, should omit the password", func(t *testing.T) {
+		// Arrange
+		sut := payload.WiFi{SSID: "Guests", Security: wifisecurity.None}
+
+		// Act
+		s, err := sut.Build()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, "WIFI:T:nopass;S:Guests;;", s)
+	})
+
+	invalid := map[string]payload.WiFi{
+		"wifi ssid must have from 1 to 32 bytes":              {Password: "password"},
+		"wifi wpa password must have from 8 to 63 characters": {SSID: "Office", Password: "short"},
+		"wifi wep password must have 5 or 13 characters, or 10 or 26 hexadecimal digits": {
+			SSID: "Office", Security: wifisecurity.WEP, Password: "123456",
+		},
+		"wifi password must be empty when the network has no security": {
+			SSID: "Office", Security: wifisecurity.None, Password: "password",
+		},
+		"wifi security WPA3 is not supported": {SSID: "Office", Security: "WPA3", Password: "password"},
+	}
+	for message, sut := range invalid {
+		t.Run("when network is invalid, should return "+message, func(t *testing.T) {
+			// Act
+			s, err := sut.Build()
+
+			// Assert
+			assert.EqualError(t, err, message)
+			assert.Empty(t, s)
+		})
+	}
+}
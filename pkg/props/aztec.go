@@ -0,0 +1,95 @@
+package props
+
+// Aztec represents properties from an Aztec code inside a cell.
+type Aztec struct {
+	// Left is the space between the left cell boundary to the code, if center is false.
+	Left float64
+	// Top is space between the upper cell limit to the code, if center is false.
+	Top float64
+	// Percent is how much the code will occupy the cell,
+	// ex 100%: The code will fulfill the entire cell
+	// ex 50%: The greater side from the code will have half the size of the cell.
+	Percent float64
+	// Center define that the code will be vertically and horizontally centralized.
+	Center bool
+	// ErrorCorrectionPercent is the minimum percent of the symbol used for error correction,
+	// from 5 to 95. Default: 23
+	ErrorCorrectionPercent int
+}
+
+// ToMap from Aztec will return a map representation from Aztec.
+func (a *Aztec) ToMap() map[string]interface{} {
+	if a == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if a.Left != 0 {
+		m["prop_left"] = a.Left
+	}
+
+	if a.Top != 0 {
+		m["prop_top"] = a.Top
+	}
+
+	if a.Percent != 0 {
+		m["prop_percent"] = a.Percent
+	}
+
+	if a.Center {
+		m["prop_center"] = a.Center
+	}
+
+	if a.ErrorCorrectionPercent != 0 {
+		m["prop_error_correction_percent"] = a.ErrorCorrectionPercent
+	}
+
+	return m
+}
+
+// ToRectProp from Aztec will return a Rect representation from Aztec.
+func (a *Aztec) ToRectProp() *Rect {
+	return &Rect{
+		Left:    a.Left,
+		Top:     a.Top,
+		Percent: a.Percent,
+		Center:  a.Center,
+	}
+}
+
+// MakeValid from Aztec will make the properties from an Aztec code reliable to fit inside a cell
+// and define default values for an Aztec code.
+func (a *Aztec) MakeValid() {
+	minPercentage := 0.0
+	maxPercentage := 100.0
+	minValue := 0.0
+	defaultErrorCorrection := 23
+	minErrorCorrection := 5
+	maxErrorCorrection := 95
+
+	if a.Percent <= minPercentage || a.Percent > maxPercentage {
+		a.Percent = maxPercentage
+	}
+
+	if a.Center {
+		a.Left = 0
+		a.Top = 0
+	}
+
+	if a.Left < minValue {
+		a.Left = minValue
+	}
+
+	if a.Top < minValue {
+		a.Top = minValue
+	}
+
+	if a.ErrorCorrectionPercent == 0 {
+		a.ErrorCorrectionPercent = defaultErrorCorrection
+	} else if a.ErrorCorrectionPercent < minErrorCorrection {
+		a.ErrorCorrectionPercent = minErrorCorrection
+	} else if a.ErrorCorrectionPercent > maxErrorCorrection {
+		a.ErrorCorrectionPercent = maxErrorCorrection
+	}
+}
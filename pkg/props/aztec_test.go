@@ -0,0 +1,70 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestAztec_ToMap(t *testing.T) {
+	t.Run("when aztec is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.Aztec
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when aztec is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.AztecProp()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, 10.0, m["prop_left"])
+		assert.Equal(t, 10.0, m["prop_top"])
+		assert.Equal(t, 98.0, m["prop_percent"])
+		assert.Equal(t, 33, m["prop_error_correction_percent"])
+	})
+}
+
+func TestAztec_MakeValid(t *testing.T) {
+	t.Run("when error correction percent is not sent, should use 23", func(t *testing.T) {
+		// Arrange
+		prop := props.Aztec{}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 23, prop.ErrorCorrectionPercent)
+		assert.Equal(t, 100.0, prop.Percent)
+	})
+	t.Run("when error correction percent is less than 5, should use 5", func(t *testing.T) {
+		// Arrange
+		prop := props.Aztec{ErrorCorrectionPercent: 1}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 5, prop.ErrorCorrectionPercent)
+	})
+	t.Run("when error correction percent is greater than 95, should use 95", func(t *testing.T) {
+		// Arrange
+		prop := props.Aztec{ErrorCorrectionPercent: 100}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 95, prop.ErrorCorrectionPercent)
+	})
+}
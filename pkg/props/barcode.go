@@ -1,6 +1,29 @@
 package props
 
-import "github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/barcode"
+	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+)
+
+// BarcodeText represents the human readable interpretation printed below the bars of a barcode.
+type BarcodeText struct {
+	// Family of the text, fields not defined use the document default font.
+	Family string
+	// Style of the text.
+	Style fontstyle.Type
+	// Size of the text.
+	Size float64
+	// Color define the font color.
+	Color *Color
+	// Top is the space between the bars and the text.
+	Top float64
+	// Align is the horizontal alignment of the text below the bars, it is not used by the EAN layout. Default: center
+	Align align.Type
+	// EANLayout splits the digits of EAN and UPC codes in the groups delimited by the guard bars,
+	// printing the number system and the check digit outside the bars as in retail labels.
+	EANLayout bool
+}
 
 // Barcode represents properties from a barcode inside a cell.
 type Barcode struct {
@@ -19,6 +42,18 @@ type Barcode struct {
 	Center bool
 	// Type represents the barcode type. Default: code128
 	Type barcode.Type
+	// HumanReadable prints the code below the bars, the barcode height includes the text.
+	HumanReadable *BarcodeText
+	// ModuleWidth is the width in mm of the narrowest bar (X-dimension). When it is sent the barcode is not scaled
+	// to the cell, Percent and Proportion are ignored and the cell must fit the bars and the quiet zones.
+	ModuleWidth float64
+	// Height is the height in mm of the bars when ModuleWidth is sent, when it is zero Proportion is used.
+	Height float64
+	// QuietZone is the blank space in modules kept at each side of the bars when ModuleWidth is sent,
+	// ex: 10 for GS1-128 and 11 for EAN-13.
+	QuietZone float64
+	// Vector draws the bars with vector rectangles instead of an image, which is sharp at any size.
+	Vector bool
 }
 
 // ToMap from Barcode will return a map representation from Barcode.
@@ -53,9 +88,39 @@ func (b *Barcode) ToMap() map[string]interface{} {
 		m["prop_center"] = b.Center
 	}
 
+	if b.HumanReadable != nil {
+		b.HumanReadable.appendMap(m)
+	}
+
+	if b.ModuleWidth != 0 {
+		m["prop_module_width"] = b.ModuleWidth
+	}
+
+	if b.Height != 0 {
+		m["prop_height"] = b.Height
+	}
+
+	if b.QuietZone != 0 {
+		m["prop_quiet_zone"] = b.QuietZone
+	}
+
+	if b.Vector {
+		m["prop_vector"] = b.Vector
+	}
+
 	return m
 }
 
+// GetModuleHeight returns the height of the bars when the barcode is sized by ModuleWidth,
+// modules is the amount of modules of the bars.
+func (b *Barcode) GetModuleHeight(modules float64) float64 {
+	if b.Height > 0 {
+		return b.Height
+	}
+
+	return modules * b.ModuleWidth * b.Proportion.Height / b.Proportion.Width
+}
+
 // ToRectProp from Barcode will return a Rect representation from Barcode.
 func (b *Barcode) ToRectProp() *Rect {
 	return &Rect{
@@ -110,4 +175,105 @@ func (b *Barcode) MakeValid() {
 	if b.Type == "" {
 		b.Type = barcode.Code128
 	}
+
+	if b.ModuleWidth < minValue {
+		b.ModuleWidth = minValue
+	}
+
+	if b.Height < minValue {
+		b.Height = minValue
+	}
+
+	if b.QuietZone < minValue {
+		b.QuietZone = minValue
+	}
+
+	if b.HumanReadable != nil {
+		text := *b.HumanReadable
+		text.MakeValid(nil)
+		b.HumanReadable = &text
+	}
+}
+
+// ToTextProp from BarcodeText will return the Text used to print the human readable code.
+func (b *BarcodeText) ToTextProp() *Text {
+	return &Text{
+		Family: b.Family,
+		Style:  b.Style,
+		Size:   b.Size,
+		Color:  b.Color,
+		Top:    b.Top,
+		Align:  b.Align,
+	}
+}
+
+// ToFontProp from BarcodeText will return the Font used to print the human readable code.
+func (b *BarcodeText) ToFontProp() *Font {
+	return &Font{
+		Family: b.Family,
+		Style:  b.Style,
+		Size:   b.Size,
+		Color:  b.Color,
+	}
+}
+
+// MakeValid from BarcodeText will define the font values not sent with the default font.
+func (b *BarcodeText) MakeValid(font *Font) {
+	if font != nil {
+		if b.Family == "" {
+			b.Family = font.Family
+		}
+
+		if b.Style == "" {
+			b.Style = font.Style
+		}
+
+		if b.Size == 0 {
+			b.Size = font.Size
+		}
+
+		if b.Color == nil {
+			b.Color = font.Color
+		}
+	}
+
+	if b.Top < 0 {
+		b.Top = 0
+	}
+
+	if b.Align == "" {
+		b.Align = align.Center
+	}
+}
+
+func (b *BarcodeText) appendMap(m map[string]interface{}) {
+	m["prop_human_readable"] = true
+
+	if b.Family != "" {
+		m["prop_human_readable_font_family"] = b.Family
+	}
+
+	if b.Style != "" {
+		m["prop_human_readable_font_style"] = b.Style
+	}
+
+	if b.Size != 0 {
+		m["prop_human_readable_font_size"] = b.Size
+	}
+
+	if b.Color != nil {
+		m["prop_human_readable_font_color"] = b.Color.ToString()
+	}
+
+	if b.Top != 0 {
+		m["prop_human_readable_top"] = b.Top
+	}
+
+	if b.Align != "" {
+		m["prop_human_readable_align"] = b.Align
+	}
+
+	if b.EANLayout {
+		m["prop_human_readable_ean_layout"] = b.EANLayout
+	}
 }
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+
 	"github.com/johnfercher/maroto/v2/internal/fixture"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
@@ -36,6 +38,16 @@ func TestBarcode_ToMap(t *testing.T) {
 		assert.Equal(t, 3.2, m["prop_proportion_height"])
 		assert.Equal(t, true, m["prop_center"])
 	})
+	t.Run("when barcode is vector, should return map with vector", func(t *testing.T) {
+		// Arrange
+		sut := props.Barcode{Vector: true}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, true, m["prop_vector"])
+	})
 }
 
 func TestBarcode_MakeValid(t *testing.T) {
@@ -175,3 +187,77 @@ func TestBarcode_ToRectProp(t *testing.T) {
 	assert.Equal(t, prop.Percent, rect.Percent)
 	assert.Equal(t, prop.Center, rect.Center)
 }
+
+func TestBarcode_HumanReadable(t *testing.T) {
+	t.Run("when human readable is sent, should return map with its fields", func(t *testing.T) {
+		// Arrange
+		sut := fixture.BarcodeProp()
+		sut.HumanReadable = &props.BarcodeText{Size: 8, Top: 1, EANLayout: true}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, true, m["prop_human_readable"])
+		assert.Equal(t, 8.0, m["prop_human_readable_font_size"])
+		assert.Equal(t, 1.0, m["prop_human_readable_top"])
+		assert.Equal(t, true, m["prop_human_readable_ean_layout"])
+	})
+	t.Run("when human readable is made valid, should not change the prop sent", func(t *testing.T) {
+		// Arrange
+		text := &props.BarcodeText{Top: -1}
+		prop := props.Barcode{HumanReadable: text}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, -1.0, text.Top)
+		assert.Equal(t, 0.0, prop.HumanReadable.Top)
+		assert.Equal(t, align.Center, prop.HumanReadable.Align)
+	})
+	t.Run("when default font is sent, should use it in the fields not defined", func(t *testing.T) {
+		// Arrange
+		font := fixture.FontProp()
+		sut := props.BarcodeText{Size: 8}
+
+		// Act
+		sut.MakeValid(&font)
+
+		// Assert
+		assert.Equal(t, font.Family, sut.Family)
+		assert.Equal(t, font.Style, sut.Style)
+		assert.Equal(t, 8.0, sut.Size)
+	})
+}
+
+func TestBarcode_GetModuleHeight(t *testing.T) {
+	t.Run("when height is sent, should return it", func(t *testing.T) {
+		// Arrange
+		sut := props.Barcode{ModuleWidth: 0.33, Height: 22.85}
+		sut.MakeValid()
+
+		// Act & Assert
+		assert.Equal(t, 22.85, sut.GetModuleHeight(95))
+	})
+	t.Run("when height is not sent, should use the proportion of the bars width", func(t *testing.T) {
+		// Arrange
+		sut := props.Barcode{ModuleWidth: 0.5, Proportion: props.Proportion{Width: 10, Height: 2}}
+		sut.MakeValid()
+
+		// Act & Assert
+		assert.Equal(t, 10.0, sut.GetModuleHeight(100))
+	})
+	t.Run("when module sizes are negative, should become zero", func(t *testing.T) {
+		// Arrange
+		sut := props.Barcode{ModuleWidth: -1, Height: -1, QuietZone: -1}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.0, sut.ModuleWidth)
+		assert.Equal(t, 0.0, sut.Height)
+		assert.Equal(t, 0.0, sut.QuietZone)
+	})
+}
@@ -0,0 +1,188 @@
+package props
+
+import (
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/charttype"
+)
+
+// DefaultChartColors is the palette used to draw the series or slices without a defined color.
+var DefaultChartColors = []Color{
+	{Red: 54, Green: 112, Blue: 198},
+	{Red: 220, Green: 57, Blue: 18},
+	{Red: 255, Green: 153, Blue: 0},
+	{Red: 16, Green: 150, Blue: 24},
+	{Red: 153, Green: 0, Blue: 153},
+	{Red: 0, Green: 153, Blue: 198},
+}
+
+// DefaultChartAxisColor is the color used to draw the axes and grid lines of a chart.
+var DefaultChartAxisColor = Color{Red: 150, Green: 150, Blue: 150}
+
+// Chart represents properties from a chart inside a cell.
+type Chart struct {
+	// Type is the chart type. Default: bar
+	Type charttype.Type
+	// Left is the space between the left cell boundary to the chart, if center is false.
+	Left float64
+	// Top is space between the upper cell limit to the chart, if center is false.
+	Top float64
+	// Percent is how much the chart will occupy the cell width.
+	Percent float64
+	// Proportion is the proportion between width and height of the chart. Default: 16x9
+	Proportion Proportion
+	// Center define that the chart will be vertically and horizontally centralized.
+	Center bool
+	// Colors is the palette used to draw the series, or the slices of pie and donut charts.
+	// Default: DefaultChartColors
+	Colors []Color
+	// AxisColor is the color of the axes and grid lines. Default: DefaultChartAxisColor
+	AxisColor *Color
+	// Text defines the font of the axis labels and legend. Default: the default font with size 8.
+	Text Text
+	// HideAxes hides the axes, grid lines and axis labels of bar and line charts.
+	HideAxes bool
+	// HideLegend hides the legend below the chart.
+	HideLegend bool
+	// LineThickness is the thickness of the lines of line charts.
+	LineThickness float64
+	// HolePercent is how much of the radius of a donut chart is empty. Default: 50
+	HolePercent float64
+}
+
+// ToMap from Chart will return a map representation from Chart.
+func (c *Chart) ToMap() map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	for key, value := range c.Text.ToMap() {
+		m["prop_text_"+strings.TrimPrefix(key, "prop_")] = value
+	}
+
+	if c.Type != "" {
+		m["prop_type"] = c.Type
+	}
+
+	if c.Left != 0 {
+		m["prop_left"] = c.Left
+	}
+
+	if c.Top != 0 {
+		m["prop_top"] = c.Top
+	}
+
+	if c.Percent != 0 {
+		m["prop_percent"] = c.Percent
+	}
+
+	if c.Proportion.Width != 0 {
+		m["prop_proportion_width"] = c.Proportion.Width
+	}
+
+	if c.Proportion.Height != 0 {
+		m["prop_proportion_height"] = c.Proportion.Height
+	}
+
+	if c.Center {
+		m["prop_center"] = c.Center
+	}
+
+	if len(c.Colors) > 0 {
+		var colors []string
+		for _, color := range c.Colors {
+			colors = append(colors, color.ToString())
+		}
+		m["prop_colors"] = colors
+	}
+
+	if c.AxisColor != nil {
+		m["prop_axis_color"] = c.AxisColor.ToString()
+	}
+
+	if c.HideAxes {
+		m["prop_hide_axes"] = c.HideAxes
+	}
+
+	if c.HideLegend {
+		m["prop_hide_legend"] = c.HideLegend
+	}
+
+	if c.LineThickness != 0 {
+		m["prop_line_thickness"] = c.LineThickness
+	}
+
+	if c.HolePercent != 0 {
+		m["prop_hole_percent"] = c.HolePercent
+	}
+
+	return m
+}
+
+// GetColor returns the color of the series or slice in the index.
+func (c *Chart) GetColor(index int) *Color {
+	return &c.Colors[index%len(c.Colors)]
+}
+
+// MakeValid from Chart will make the properties from a chart reliable to fit inside a cell
+// and define default values for a chart.
+func (c *Chart) MakeValid() {
+	minPercentage := 0.0
+	maxPercentage := 100.0
+	minValue := 0.0
+	defaultLabelSize := 8.0
+	defaultLineThickness := 0.6
+	defaultHolePercent := 50.0
+	maxHolePercent := 90.0
+
+	if !c.Type.IsValid() {
+		c.Type = charttype.Bar
+	}
+
+	if c.Percent <= minPercentage || c.Percent > maxPercentage {
+		c.Percent = maxPercentage
+	}
+
+	if c.Center {
+		c.Left = 0
+		c.Top = 0
+	}
+
+	if c.Left < minValue {
+		c.Left = minValue
+	}
+
+	if c.Top < minValue {
+		c.Top = minValue
+	}
+
+	if c.Proportion.Width <= 0 || c.Proportion.Height <= 0 {
+		c.Proportion = Proportion{Width: 16, Height: 9}
+	}
+
+	if len(c.Colors) == 0 {
+		c.Colors = DefaultChartColors
+	}
+
+	if c.AxisColor == nil {
+		c.AxisColor = &DefaultChartAxisColor
+	}
+
+	if c.Text.Size <= 0 {
+		c.Text.Size = defaultLabelSize
+	}
+
+	if c.LineThickness <= 0 {
+		c.LineThickness = defaultLineThickness
+	}
+
+	if c.HolePercent <= 0 {
+		c.HolePercent = defaultHolePercent
+	}
+
+	if c.HolePercent > maxHolePercent {
+		c.HolePercent = maxHolePercent
+	}
+}
@@ -0,0 +1,103 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/charttype"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestChart_ToMap(t *testing.T) {
+	t.Run("when chart is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var prop *props.Chart
+
+		// Act & Assert
+		assert.Nil(t, prop.ToMap())
+	})
+	t.Run("when chart is filled, should return all fields", func(t *testing.T) {
+		// Arrange
+		prop := props.Chart{
+			Type:          charttype.Donut,
+			Left:          1,
+			Top:           2,
+			Percent:       50,
+			Proportion:    props.Proportion{Width: 4, Height: 3},
+			Colors:        []props.Color{props.RedColor},
+			AxisColor:     &props.BlackColor,
+			Text:          props.Text{Size: 7},
+			HideAxes:      true,
+			HideLegend:    true,
+			LineThickness: 1,
+			HolePercent:   40,
+		}
+
+		// Act
+		m := prop.ToMap()
+
+		// Assert
+		assert.Equal(t, charttype.Donut, m["prop_type"])
+		assert.Equal(t, 1.0, m["prop_left"])
+		assert.Equal(t, 2.0, m["prop_top"])
+		assert.Equal(t, 50.0, m["prop_percent"])
+		assert.Equal(t, 4.0, m["prop_proportion_width"])
+		assert.Equal(t, 3.0, m["prop_proportion_height"])
+		assert.Equal(t, []string{"RGB(255, 0, 0)"}, m["prop_colors"])
+		assert.Equal(t, "RGB(0, 0, 0)", m["prop_axis_color"])
+		assert.Equal(t, 7.0, m["prop_text_font_size"])
+		assert.Equal(t, true, m["prop_hide_axes"])
+		assert.Equal(t, true, m["prop_hide_legend"])
+		assert.Equal(t, 1.0, m["prop_line_thickness"])
+		assert.Equal(t, 40.0, m["prop_hole_percent"])
+	})
+}
+
+func TestChart_MakeValid(t *testing.T) {
+	t.Run("when chart is empty, should apply defaults", func(t *testing.T) {
+		// Arrange
+		prop := props.Chart{}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, charttype.Bar, prop.Type)
+		assert.Equal(t, 100.0, prop.Percent)
+		assert.Equal(t, props.Proportion{Width: 16, Height: 9}, prop.Proportion)
+		assert.Equal(t, props.DefaultChartColors, prop.Colors)
+		assert.Equal(t, &props.DefaultChartAxisColor, prop.AxisColor)
+		assert.Equal(t, 8.0, prop.Text.Size)
+		assert.Equal(t, 50.0, prop.HolePercent)
+	})
+	t.Run("when center is true, should reset left and top", func(t *testing.T) {
+		// Arrange
+		prop := props.Chart{Center: true, Left: 10, Top: 10}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.0, prop.Left)
+		assert.Equal(t, 0.0, prop.Top)
+	})
+	t.Run("when hole percent is greater than 90, should apply 90", func(t *testing.T) {
+		// Arrange
+		prop := props.Chart{HolePercent: 95}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 90.0, prop.HolePercent)
+	})
+}
+
+func TestChart_GetColor(t *testing.T) {
+	// Arrange
+	prop := props.Chart{Colors: []props.Color{props.RedColor, props.BlueColor}}
+
+	// Act & Assert
+	assert.Equal(t, &props.BlueColor, prop.GetColor(3))
+}
@@ -0,0 +1,123 @@
+package props
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+)
+
+// FormField represents properties from an interactive form field inside a cell.
+type FormField struct {
+	// Required indicates that the field must be filled in before the form is submitted.
+	Required bool
+	// ReadOnly indicates that the field can not be changed in the PDF reader.
+	ReadOnly bool
+	// MultiLine allows text fields to have more than one line.
+	MultiLine bool
+	// Lines is the number of lines of a multi-line text field in a row with automatic height. Default: 3
+	Lines int
+	// MaxLength is the maximum number of characters of a text field, zero means no limit.
+	MaxLength int
+	// Size is the font size of the text, the field always uses the Helvetica font. Default: 10
+	Size float64
+	// Color of the text and of the marks of checkboxes and radio groups. Default: black
+	Color *Color
+	// Align of the text of text fields and dropdowns, it can be left, center or right. Default: left
+	Align align.Type
+	// BorderColor is the color of the border of the field. Default: black
+	BorderColor *Color
+	// BorderThickness is the width of the border of the field. Default: 0.2
+	BorderThickness float64
+	// BackgroundColor is the color that fills the field, nil keeps it transparent.
+	BackgroundColor *Color
+}
+
+// ToMap from FormField will return a map representation from FormField.
+func (f *FormField) ToMap() map[string]interface{} {
+	if f == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if f.Required {
+		m["prop_required"] = f.Required
+	}
+
+	if f.ReadOnly {
+		m["prop_read_only"] = f.ReadOnly
+	}
+
+	if f.MultiLine {
+		m["prop_multi_line"] = f.MultiLine
+	}
+
+	if f.Lines != 0 {
+		m["prop_lines"] = f.Lines
+	}
+
+	if f.MaxLength != 0 {
+		m["prop_max_length"] = f.MaxLength
+	}
+
+	if f.Size != 0 {
+		m["prop_font_size"] = f.Size
+	}
+
+	if f.Color != nil {
+		m["prop_color"] = f.Color.ToString()
+	}
+
+	if f.Align != "" {
+		m["prop_align"] = f.Align
+	}
+
+	if f.BorderColor != nil {
+		m["prop_border_color"] = f.BorderColor.ToString()
+	}
+
+	if f.BorderThickness != 0 {
+		m["prop_border_thickness"] = f.BorderThickness
+	}
+
+	if f.BackgroundColor != nil {
+		m["prop_background_color"] = f.BackgroundColor.ToString()
+	}
+
+	return m
+}
+
+// MakeValid from FormField will define default values for a form field.
+func (f *FormField) MakeValid() {
+	defaultSize := 10.0
+	defaultLines := 3
+
+	if f.Size <= 0 {
+		f.Size = defaultSize
+	}
+
+	if f.Color == nil {
+		f.Color = &BlackColor
+	}
+
+	if f.Align != align.Center && f.Align != align.Right {
+		f.Align = align.Left
+	}
+
+	if f.BorderColor == nil {
+		f.BorderColor = &BlackColor
+	}
+
+	if f.BorderThickness <= 0 {
+		f.BorderThickness = linestyle.DefaultLineThickness
+	}
+
+	if f.MaxLength < 0 {
+		f.MaxLength = 0
+	}
+
+	if !f.MultiLine {
+		f.Lines = 0
+	} else if f.Lines <= 0 {
+		f.Lines = defaultLines
+	}
+}
@@ -0,0 +1,89 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormField_ToMap(t *testing.T) {
+	t.Run("when form field is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var prop *props.FormField
+
+		// Act & Assert
+		assert.Nil(t, prop.ToMap())
+	})
+	t.Run("when form field is filled, should return all fields", func(t *testing.T) {
+		// Arrange
+		prop := props.FormField{
+			Required:        true,
+			ReadOnly:        true,
+			MultiLine:       true,
+			Lines:           4,
+			MaxLength:       100,
+			Size:            12,
+			Color:           &props.RedColor,
+			Align:           align.Center,
+			BorderColor:     &props.BlackColor,
+			BorderThickness: 0.5,
+			BackgroundColor: &props.WhiteColor,
+		}
+
+		// Act
+		m := prop.ToMap()
+
+		// Assert
+		assert.Equal(t, true, m["prop_required"])
+		assert.Equal(t, true, m["prop_read_only"])
+		assert.Equal(t, true, m["prop_multi_line"])
+		assert.Equal(t, 4, m["prop_lines"])
+		assert.Equal(t, 100, m["prop_max_length"])
+		assert.Equal(t, 12.0, m["prop_font_size"])
+		assert.Equal(t, "RGB(255, 0, 0)", m["prop_color"])
+		assert.Equal(t, align.Center, m["prop_align"])
+		assert.Equal(t, "RGB(0, 0, 0)", m["prop_border_color"])
+		assert.Equal(t, 0.5, m["prop_border_thickness"])
+		assert.Equal(t, "RGB(255, 255, 255)", m["prop_background_color"])
+	})
+}
+
+func TestFormField_MakeValid(t *testing.T) {
+	t.Run("when form field is empty, should apply defaults", func(t *testing.T) {
+		// Arrange
+		prop := props.FormField{}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 10.0, prop.Size)
+		assert.Equal(t, &props.BlackColor, prop.Color)
+		assert.Equal(t, align.Left, prop.Align)
+		assert.Equal(t, &props.BlackColor, prop.BorderColor)
+		assert.Equal(t, 0.2, prop.BorderThickness)
+		assert.Equal(t, 0, prop.Lines)
+	})
+	t.Run("when form field is multi-line without lines, should apply 3 lines", func(t *testing.T) {
+		// Arrange
+		prop := props.FormField{MultiLine: true}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 3, prop.Lines)
+	})
+	t.Run("when align is justify, should apply left", func(t *testing.T) {
+		// Arrange
+		prop := props.FormField{Align: align.Justify}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, align.Left, prop.Align)
+	})
+}
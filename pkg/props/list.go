@@ -0,0 +1,119 @@
+package props
+
+import (
+	"fmt"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/liststyle"
+)
+
+// DefaultListLevels are the list levels used when no level is defined.
+var DefaultListLevels = []ListLevel{
+	{Style: liststyle.Bullet, Bullet: "•"},
+	{Style: liststyle.Bullet, Bullet: "–"},
+	{Style: liststyle.Bullet, Bullet: "·"},
+}
+
+// ListLevel represents the marker of a nesting level inside a list.
+type ListLevel struct {
+	// Style defines if the level is marked by bullets or by a numbering style. Default: liststyle.Bullet
+	Style liststyle.Type
+	// Bullet is the glyph used when Style is liststyle.Bullet. Default: •
+	Bullet string
+	// Suffix is the text written after the number when Style is a numbering style, ex: "." or ")". Default: .
+	Suffix string
+}
+
+// ToString returns a string representation of the ListLevel.
+func (l *ListLevel) ToString() string {
+	if l.Style == liststyle.Bullet {
+		return fmt.Sprintf("%s(%s)", l.Style, l.Bullet)
+	}
+
+	return fmt.Sprintf("%s(%s)", l.Style, l.Suffix)
+}
+
+// MakeValid from ListLevel define default values for a ListLevel.
+func (l *ListLevel) MakeValid() {
+	if !l.Style.IsValid() {
+		l.Style = liststyle.Bullet
+	}
+
+	if l.Style == liststyle.Bullet && l.Bullet == "" {
+		l.Bullet = "•"
+	}
+
+	if l.Style != liststyle.Bullet && l.Suffix == "" {
+		l.Suffix = "."
+	}
+}
+
+// List represents properties from a bulleted or numbered list.
+type List struct {
+	// Levels define the marker of each nesting level, the last level is repeated for deeper items.
+	// Default: DefaultListLevels.
+	Levels []ListLevel
+	// Indent is the horizontal space added for each nesting level. Default: 5
+	Indent float64
+	// MarkerWidth is the minimum space reserved for the marker, the item text is wrapped after it.
+	// When the widest marker of a level does not fit, the space of the level grows to fit it. Default: 5
+	MarkerWidth float64
+	// Text defines the font, color and spacing of items and markers.
+	// Left is added to the indentation of every item.
+	Text Text
+}
+
+// ToMap returns a map with the List fields.
+func (l *List) ToMap() map[string]interface{} {
+	m := l.Text.ToMap()
+
+	if len(l.Levels) > 0 {
+		var levels []string
+		for _, level := range l.Levels {
+			levels = append(levels, level.ToString())
+		}
+		m["prop_levels"] = levels
+	}
+
+	if l.Indent != 0 {
+		m["prop_indent"] = l.Indent
+	}
+
+	if l.MarkerWidth != 0 {
+		m["prop_marker_width"] = l.MarkerWidth
+	}
+
+	return m
+}
+
+// MakeValid from List define default values for a List.
+func (l *List) MakeValid() {
+	if len(l.Levels) == 0 {
+		l.Levels = DefaultListLevels
+	}
+
+	levels := make([]ListLevel, len(l.Levels))
+	copy(levels, l.Levels)
+	for i := range levels {
+		levels[i].MakeValid()
+	}
+	l.Levels = levels
+
+	if l.Indent <= 0 {
+		l.Indent = 5
+	}
+
+	if l.MarkerWidth <= 0 {
+		l.MarkerWidth = 5
+	}
+
+	l.Text.TabStops = nil
+}
+
+// GetLevel returns the ListLevel of a nesting level, the last level is repeated for deeper levels.
+func (l *List) GetLevel(depth int) ListLevel {
+	if depth >= len(l.Levels) {
+		return l.Levels[len(l.Levels)-1]
+	}
+
+	return l.Levels[depth]
+}
@@ -0,0 +1,49 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/liststyle"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestList_MakeValid(t *testing.T) {
+	t.Run("when levels are not defined, should use default levels", func(t *testing.T) {
+		// Arrange
+		prop := props.List{}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, props.DefaultListLevels, prop.Levels)
+		assert.Equal(t, 5.0, prop.Indent)
+		assert.Equal(t, 5.0, prop.MarkerWidth)
+	})
+	t.Run("when numbered level has no suffix, should use dot", func(t *testing.T) {
+		// Arrange
+		prop := props.List{Levels: []props.ListLevel{{Style: liststyle.Decimal}}}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, ".", prop.Levels[0].Suffix)
+	})
+}
+
+func TestList_GetLevel(t *testing.T) {
+	t.Run("when depth is greater than levels, should repeat the last level", func(t *testing.T) {
+		// Arrange
+		prop := props.List{Levels: []props.ListLevel{{Style: liststyle.Decimal}, {Style: liststyle.LowerAlpha}}}
+		prop.MakeValid()
+
+		// Act
+		level := prop.GetLevel(5)
+
+		// Assert
+		assert.Equal(t, liststyle.LowerAlpha, level.Style)
+	})
+}
@@ -0,0 +1,90 @@
+package props
+
+// PDF417 represents properties from a PDF417 code inside a cell.
+type PDF417 struct {
+	// Left is the space between the left cell boundary to the code, if center is false.
+	Left float64
+	// Top is space between the upper cell limit to the code, if center is false.
+	Top float64
+	// Percent is how much the code will occupy the cell,
+	// ex 100%: The code will fulfill the entire cell
+	// ex 50%: The greater side from the code will have half the size of the cell.
+	Percent float64
+	// Center define that the code will be vertically and horizontally centralized.
+	Center bool
+	// ErrorCorrectionLevel is the security level from 1 to 8, each level doubles the amount
+	// of error correction codewords. Default: 2
+	ErrorCorrectionLevel int
+}
+
+// ToMap from PDF417 will return a map representation from PDF417.
+func (p *PDF417) ToMap() map[string]interface{} {
+	if p == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if p.Left != 0 {
+		m["prop_left"] = p.Left
+	}
+
+	if p.Top != 0 {
+		m["prop_top"] = p.Top
+	}
+
+	if p.Percent != 0 {
+		m["prop_percent"] = p.Percent
+	}
+
+	if p.Center {
+		m["prop_center"] = p.Center
+	}
+
+	if p.ErrorCorrectionLevel != 0 {
+		m["prop_error_correction_level"] = p.ErrorCorrectionLevel
+	}
+
+	return m
+}
+
+// ToRectProp from PDF417 will return a Rect representation from PDF417.
+func (p *PDF417) ToRectProp() *Rect {
+	return &Rect{
+		Left:    p.Left,
+		Top:     p.Top,
+		Percent: p.Percent,
+		Center:  p.Center,
+	}
+}
+
+// MakeValid from PDF417 will make the properties from a PDF417 code reliable to fit inside a cell
+// and define default values for a PDF417 code.
+func (p *PDF417) MakeValid() {
+	minPercentage := 0.0
+	maxPercentage := 100.0
+	minValue := 0.0
+	defaultLevel := 2
+	maxLevel := 8
+
+	if p.Percent <= minPercentage || p.Percent > maxPercentage {
+		p.Percent = maxPercentage
+	}
+
+	if p.Center {
+		p.Left = 0
+		p.Top = 0
+	}
+
+	if p.Left < minValue {
+		p.Left = minValue
+	}
+
+	if p.Top < minValue {
+		p.Top = minValue
+	}
+
+	if p.ErrorCorrectionLevel <= 0 || p.ErrorCorrectionLevel > maxLevel {
+		p.ErrorCorrectionLevel = defaultLevel
+	}
+}
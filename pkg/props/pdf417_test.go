@@ -0,0 +1,71 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestPDF417_ToMap(t *testing.T) {
+	t.Run("when pdf417 is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.PDF417
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when pdf417 is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := fixture.PDF417Prop()
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, 10.0, m["prop_left"])
+		assert.Equal(t, 10.0, m["prop_top"])
+		assert.Equal(t, 98.0, m["prop_percent"])
+		assert.Equal(t, 4, m["prop_error_correction_level"])
+	})
+}
+
+func TestPDF417_MakeValid(t *testing.T) {
+	t.Run("when error correction level is not sent, should use 2", func(t *testing.T) {
+		// Arrange
+		prop := props.PDF417{}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 2, prop.ErrorCorrectionLevel)
+		assert.Equal(t, 100.0, prop.Percent)
+	})
+	t.Run("when error correction level is greater than 8, should use 2", func(t *testing.T) {
+		// Arrange
+		prop := props.PDF417{ErrorCorrectionLevel: 9}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 2, prop.ErrorCorrectionLevel)
+	})
+	t.Run("when center is true, should reset left and top", func(t *testing.T) {
+		// Arrange
+		prop := props.PDF417{Left: 5, Top: 5, Center: true}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.0, prop.Left)
+		assert.Equal(t, 0.0, prop.Top)
+	})
+}
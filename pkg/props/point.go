@@ -0,0 +1,17 @@
+package props
+
+import "fmt"
+
+// Point represents a position inside a cell, in percent of the cell size.
+// ex: {X: 0, Y: 0} is the top left corner and {X: 100, Y: 100} is the bottom right corner.
+type Point struct {
+	// X is the horizontal position in percent of the cell width.
+	X float64
+	// Y is the vertical position in percent of the cell height.
+	Y float64
+}
+
+// ToString returns a string representation of the Point.
+func (p *Point) ToString() string {
+	return fmt.Sprintf("(%.2f, %.2f)", p.X, p.Y)
+}
@@ -0,0 +1,163 @@
+package props
+
+import (
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/qrlevel"
+)
+
+// QrCode represents properties from a qrcode inside a cell.
+type QrCode struct {
+	// Left is the space between the left cell boundary to the qrcode, if center is false.
+	Left float64
+	// Top is space between the upper cell limit to the qrcode, if center is false.
+	Top float64
+	// Percent is how much the qrcode will occupy the cell,
+	// ex 100%: The qrcode will fulfill the entire cell
+	// ex 50%: The qrcode will have half the size of the cell.
+	Percent float64
+	// indicate whether only the width should be used as a reference to calculate the component size, disregarding the height
+	// ex true: The component will be scaled only based on the available width, disregarding the available height
+	JustReferenceWidth bool
+	// Center define that the qrcode will be vertically and horizontally centralized.
+	Center bool
+	// ErrorCorrection is how much of the code can be recovered when damaged. Default: qrlevel.M, or qrlevel.H with a logo.
+	ErrorCorrection qrlevel.Type
+	// QuietZone is the amount of blank modules around the code.
+	QuietZone int
+	// Color of the modules. Default: black
+	Color *Color
+	// BackgroundColor of the code and of the quiet zone. Default: white
+	BackgroundColor *Color
+	// Logo is an image drawn in the center of the code, it forces the error correction to qrlevel.H.
+	Logo *QrLogo
+	// Vector draws the qrcode with vector rectangles instead of an image, which is sharp at any size.
+	// QrCodes with a logo are always drawn as an image.
+	Vector bool
+}
+
+// QrLogo represents an image drawn in the center of a qrcode.
+type QrLogo struct {
+	// Bytes of the image.
+	Bytes []byte
+	// Extension of the image, jpg, jpeg or png.
+	Extension extension.Type
+	// Percent is the width of the logo relative to the code, from 1 to 30. Default: 20
+	Percent float64
+}
+
+// ToMap from QrCode will return a map representation from QrCode.
+func (q *QrCode) ToMap() map[string]interface{} {
+	if q == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if q.Left != 0 {
+		m["prop_left"] = q.Left
+	}
+
+	if q.Top != 0 {
+		m["prop_top"] = q.Top
+	}
+
+	if q.Percent != 0 {
+		m["prop_percent"] = q.Percent
+	}
+
+	if q.JustReferenceWidth {
+		m["prop_just_reference_Width"] = q.JustReferenceWidth
+	}
+
+	if q.Center {
+		m["prop_center"] = q.Center
+	}
+
+	if q.ErrorCorrection != "" {
+		m["prop_qr_error_correction"] = q.ErrorCorrection
+	}
+
+	if q.QuietZone != 0 {
+		m["prop_qr_quiet_zone"] = q.QuietZone
+	}
+
+	if q.Color != nil {
+		m["prop_qr_color"] = q.Color.ToString()
+	}
+
+	if q.BackgroundColor != nil {
+		m["prop_qr_background_color"] = q.BackgroundColor.ToString()
+	}
+
+	if q.Logo != nil {
+		m["prop_qr_logo_extension"] = q.Logo.Extension
+		m["prop_qr_logo_percent"] = q.Logo.Percent
+	}
+
+	if q.Vector {
+		m["prop_vector"] = q.Vector
+	}
+
+	return m
+}
+
+// ToRectProp from QrCode will return a Rect representation from QrCode.
+func (q *QrCode) ToRectProp() *Rect {
+	return &Rect{
+		Left:               q.Left,
+		Top:                q.Top,
+		Percent:            q.Percent,
+		JustReferenceWidth: q.JustReferenceWidth,
+		Center:             q.Center,
+	}
+}
+
+// MakeValid from QrCode will make the properties from a qrcode reliable to fit inside a cell
+// and define default values for a qrcode.
+func (q *QrCode) MakeValid() {
+	minPercentage := 0.0
+	maxPercentage := 100.0
+	minValue := 0.0
+	defaultLogoPercent := 20.0
+	maxLogoPercent := 30.0
+
+	if q.Percent <= minPercentage || q.Percent > maxPercentage {
+		q.Percent = maxPercentage
+	}
+
+	if q.Center {
+		q.Left = 0
+		q.Top = 0
+	}
+
+	if q.Left < minValue {
+		q.Left = minValue
+	}
+
+	if q.Top < minValue {
+		q.Top = minValue
+	}
+
+	if !q.ErrorCorrection.IsValid() {
+		q.ErrorCorrection = qrlevel.M
+	}
+
+	if q.QuietZone < 0 {
+		q.QuietZone = 0
+	}
+
+	if q.Logo == nil {
+		return
+	}
+
+	q.ErrorCorrection = qrlevel.H
+	q.Vector = false
+
+	logo := *q.Logo
+	if logo.Percent <= 0 {
+		logo.Percent = defaultLogoPercent
+	} else if logo.Percent > maxLogoPercent {
+		logo.Percent = maxLogoPercent
+	}
+	q.Logo = &logo
+}
@@ -0,0 +1,118 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
+	"github.com/johnfercher/maroto/v2/pkg/consts/qrlevel"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestQrCode_MakeValid(t *testing.T) {
+	t.Run("when error correction is not sent, should use m", func(t *testing.T) {
+		// Arrange
+		sut := props.QrCode{QuietZone: -1}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, qrlevel.M, sut.ErrorCorrection)
+		assert.Equal(t, 0, sut.QuietZone)
+	})
+	t.Run("when logo is sent, should use h and default percent", func(t *testing.T) {
+		// Arrange
+		logo := &props.QrLogo{Bytes: []byte{1}, Extension: extension.Png}
+		sut := props.QrCode{ErrorCorrection: qrlevel.L, Logo: logo}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, qrlevel.H, sut.ErrorCorrection)
+		assert.Equal(t, 20.0, sut.Logo.Percent)
+		assert.Equal(t, 0.0, logo.Percent)
+	})
+	t.Run("when logo percent is greater than 30, should become 30", func(t *testing.T) {
+		// Arrange
+		sut := props.QrCode{Logo: &props.QrLogo{Percent: 50}}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 30.0, sut.Logo.Percent)
+	})
+	t.Run("when logo is sent, should not be vector", func(t *testing.T) {
+		// Arrange
+		sut := props.QrCode{Vector: true, Logo: &props.QrLogo{}}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.False(t, sut.Vector)
+	})
+	t.Run("when percent is not sent, should use 100", func(t *testing.T) {
+		// Arrange
+		sut := props.QrCode{}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 100.0, sut.Percent)
+	})
+	t.Run("when center is true, should reset left and top", func(t *testing.T) {
+		// Arrange
+		sut := props.QrCode{Left: 10, Top: 10, Center: true}
+
+		// Act
+		sut.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.0, sut.Left)
+		assert.Equal(t, 0.0, sut.Top)
+	})
+}
+
+func TestQrCode_ToMap(t *testing.T) {
+	t.Run("when qrcode is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var sut *props.QrCode
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Nil(t, m)
+	})
+	t.Run("when qrcode is filled, should return map filled correctly", func(t *testing.T) {
+		// Arrange
+		sut := props.QrCode{
+			Left:            10,
+			Top:             5,
+			Percent:         98,
+			ErrorCorrection: qrlevel.Q,
+			QuietZone:       4,
+			Color:           &props.Color{Red: 10},
+			BackgroundColor: &props.WhiteColor,
+			Vector:          true,
+		}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, 10.0, m["prop_left"])
+		assert.Equal(t, 5.0, m["prop_top"])
+		assert.Equal(t, 98.0, m["prop_percent"])
+		assert.Equal(t, qrlevel.Q, m["prop_qr_error_correction"])
+		assert.Equal(t, 4, m["prop_qr_quiet_zone"])
+		assert.Equal(t, "RGB(10, 0, 0)", m["prop_qr_color"])
+		assert.Equal(t, "RGB(255, 255, 255)", m["prop_qr_background_color"])
+		assert.Equal(t, true, m["prop_vector"])
+	})
+}
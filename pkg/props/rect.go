@@ -1,5 +1,33 @@
 package props
 
+import (
+	"strings"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/clip"
+	"github.com/johnfercher/maroto/v2/pkg/consts/objectfit"
+)
+
+// Crop represents the region of an image that will be drawn, all values are percentages of the image size.
+type Crop struct {
+	// Left is the start of the region from the left side of the image.
+	Left float64
+	// Top is the start of the region from the top side of the image.
+	Top float64
+	// Width is the width of the region.
+	Width float64
+	// Height is the height of the region.
+	Height float64
+}
+
+// Caption represents a text drawn below an image.
+type Caption struct {
+	// Value is the caption text.
+	Value string
+	// Prop is the caption style, fields not defined use the document default font.
+	Prop Text
+}
+
 // Rect represents properties from a rectangle (Image, QrCode or Barcode) inside a cell.
 type Rect struct {
 	// Left is the space between the left cell boundary to the rectangle, if center is false.
@@ -15,6 +43,36 @@ type Rect struct {
 	JustReferenceWidth bool
 	// Center define that the barcode will be vertically and horizontally centralized.
 	Center bool
+	// Fit defines how the image is resized to the cell, the default objectfit.Contain keeps the whole image visible.
+	Fit objectfit.Type
+	// Align anchors the rectangle horizontally with align.Left, align.Center or align.Right, if center is false.
+	// With objectfit.Cover it also defines which side of the image is kept.
+	Align align.Type
+	// VerticalAlign anchors the rectangle vertically with align.Top, align.Middle or align.Bottom, if center is false.
+	// With objectfit.Cover it also defines which side of the image is kept.
+	VerticalAlign align.Type
+	// Crop selects the region of the image that will be drawn, the image outside the region is clipped.
+	Crop *Crop
+	// Opacity is how opaque the image is, from 0 to 1, values outside this range draw the image fully opaque.
+	Opacity float64
+	// Grayscale converts the image colors to shades of gray.
+	Grayscale bool
+	// Clip masks the image with a shape, like clip.Circle for avatar photos.
+	Clip clip.Type
+	// CornerRadius is the radius of the corners of clip.RoundedRect,
+	// when it is zero a tenth of the smallest side of the image is used.
+	CornerRadius float64
+	// Caption is a text drawn below an image or a matrixcode, the image height includes the caption.
+	Caption *Caption
+	// AltText is the alternative description of the image, used by screen readers.
+	AltText string
+	// Vector draws a matrixcode with vector rectangles instead of an image, which is sharp at any size.
+	// It is used only by matrixcodes.
+	Vector bool
+	// GS1 encodes a matrixcode as GS1 DataMatrix, the code must have the application identifiers in parentheses,
+	// ex: (01)09501101530003(17)250101(10)AB12. It is used only by matrixcodes. Values with parentheses
+	// must be sent as elements to code.NewGS1Matrix.
+	GS1 bool
 }
 
 // ToMap from Rect will return a map representation from Rect.
@@ -40,6 +98,60 @@ func (r *Rect) ToMap() map[string]interface{} {
 	if r.JustReferenceWidth {
 		m["prop_just_reference_Width"] = r.JustReferenceWidth
 	}
+
+	if r.Fit != "" && r.Fit != objectfit.Contain {
+		m["prop_fit"] = r.Fit
+	}
+
+	if r.Align != "" {
+		m["prop_align"] = r.Align
+	}
+
+	if r.VerticalAlign != "" {
+		m["prop_vertical_align"] = r.VerticalAlign
+	}
+
+	if r.Crop != nil {
+		m["prop_crop_left"] = r.Crop.Left
+		m["prop_crop_top"] = r.Crop.Top
+		m["prop_crop_width"] = r.Crop.Width
+		m["prop_crop_height"] = r.Crop.Height
+	}
+
+	if r.Opacity > 0 && r.Opacity < 1 {
+		m["prop_opacity"] = r.Opacity
+	}
+
+	if r.Grayscale {
+		m["prop_grayscale"] = r.Grayscale
+	}
+
+	if r.Clip != "" {
+		m["prop_clip"] = r.Clip
+	}
+
+	if r.CornerRadius != 0 {
+		m["prop_corner_radius"] = r.CornerRadius
+	}
+
+	if r.Caption != nil {
+		m["prop_caption"] = r.Caption.Value
+		for key, value := range r.Caption.Prop.ToMap() {
+			m["prop_caption_"+strings.TrimPrefix(key, "prop_")] = value
+		}
+	}
+
+	if r.AltText != "" {
+		m["prop_alt_text"] = r.AltText
+	}
+
+	if r.Vector {
+		m["prop_vector"] = r.Vector
+	}
+
+	if r.GS1 {
+		m["prop_gs1"] = r.GS1
+	}
 	return m
 }
 
@@ -66,4 +178,48 @@ func (r *Rect) MakeValid() {
 	if r.Top < minValue {
 		r.Top = minValue
 	}
+
+	if !r.Fit.IsValid() {
+		r.Fit = objectfit.Contain
+	}
+
+	if r.Crop != nil {
+		crop := *r.Crop
+		crop.makeValid()
+		r.Crop = &crop
+	}
+
+	if r.Opacity <= 0 || r.Opacity > 1 {
+		r.Opacity = 1
+	}
+
+	if !r.Clip.IsValid() {
+		r.Clip = ""
+	}
+
+	if r.CornerRadius < minValue {
+		r.CornerRadius = minValue
+	}
+}
+
+// makeValid keeps the crop region inside the image, an empty width or height selects until the image end.
+func (c *Crop) makeValid() {
+	maxPercentage := 100.0
+
+	// A region starting at the image end would be empty, so the whole side is used.
+	if c.Left < 0 || c.Left >= maxPercentage {
+		c.Left = 0
+	}
+
+	if c.Top < 0 || c.Top >= maxPercentage {
+		c.Top = 0
+	}
+
+	if c.Width <= 0 || c.Left+c.Width > maxPercentage {
+		c.Width = maxPercentage - c.Left
+	}
+
+	if c.Height <= 0 || c.Top+c.Height > maxPercentage {
+		c.Height = maxPercentage - c.Top
+	}
 }
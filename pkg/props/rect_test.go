@@ -6,6 +6,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/clip"
+	"github.com/johnfercher/maroto/v2/pkg/consts/objectfit"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
@@ -61,6 +64,69 @@ func TestRect_MakeValid(t *testing.T) {
 		// Assert
 		assert.Equal(t, prop.Top, 0.0)
 	})
+	t.Run("when fit is not valid, should become contain", func(t *testing.T) {
+		// Arrange
+		prop := props.Rect{Fit: "invalid"}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, objectfit.Contain, prop.Fit)
+	})
+	t.Run("when crop has no size, should select until the image end", func(t *testing.T) {
+		// Arrange
+		prop := props.Rect{Crop: &props.Crop{Left: 20, Top: 30}}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, &props.Crop{Left: 20, Top: 30, Width: 80, Height: 70}, prop.Crop)
+	})
+	t.Run("when crop is outside the image, should select the whole image", func(t *testing.T) {
+		// Arrange
+		prop := props.Rect{Crop: &props.Crop{Left: 100, Top: -10, Width: 50, Height: 150}}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, &props.Crop{Left: 0, Top: 0, Width: 50, Height: 100}, prop.Crop)
+	})
+	t.Run("when crop is sent, should not change the crop sent", func(t *testing.T) {
+		// Arrange
+		crop := &props.Crop{Left: 20, Top: 30}
+		prop := props.Rect{Crop: crop}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, &props.Crop{Left: 20, Top: 30}, crop)
+		assert.Equal(t, &props.Crop{Left: 20, Top: 30, Width: 80, Height: 70}, prop.Crop)
+	})
+	t.Run("when opacity is outside range, should become opaque", func(t *testing.T) {
+		// Arrange
+		prop := props.Rect{Opacity: 1.5}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 1.0, prop.Opacity)
+	})
+	t.Run("when clip is not valid, should not clip", func(t *testing.T) {
+		// Arrange
+		prop := props.Rect{Clip: "star", CornerRadius: -2}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, clip.Type(""), prop.Clip)
+		assert.Equal(t, 0.0, prop.CornerRadius)
+	})
 }
 
 func TestRect_ToMap(t *testing.T) {
@@ -76,4 +142,84 @@ func TestRect_ToMap(t *testing.T) {
 	assert.Equal(t, 10.0, m["prop_top"])
 	assert.Equal(t, 98.0, m["prop_percent"])
 	assert.Equal(t, true, m["prop_center"])
+	assert.Nil(t, m["prop_fit"])
+}
+
+func TestRect_ToMap_WhenFitAndCropAreSet(t *testing.T) {
+	// Arrange
+	sut := props.Rect{
+		Fit:           objectfit.Cover,
+		Align:         align.Right,
+		VerticalAlign: align.Bottom,
+		Crop:          &props.Crop{Left: 10, Top: 20, Width: 30, Height: 40},
+	}
+
+	// Act
+	m := sut.ToMap()
+
+	// Assert
+	assert.Equal(t, objectfit.Cover, m["prop_fit"])
+	assert.Equal(t, align.Right, m["prop_align"])
+	assert.Equal(t, align.Bottom, m["prop_vertical_align"])
+	assert.Equal(t, 10.0, m["prop_crop_left"])
+	assert.Equal(t, 20.0, m["prop_crop_top"])
+	assert.Equal(t, 30.0, m["prop_crop_width"])
+	assert.Equal(t, 40.0, m["prop_crop_height"])
+}
+
+func TestRect_ToMap_WhenEffectsAreSet(t *testing.T) {
+	// Arrange
+	sut := props.Rect{
+		Opacity:      0.4,
+		Grayscale:    true,
+		Clip:         clip.RoundedRect,
+		CornerRadius: 3,
+	}
+
+	// Act
+	m := sut.ToMap()
+
+	// Assert
+	assert.Equal(t, 0.4, m["prop_opacity"])
+	assert.Equal(t, true, m["prop_grayscale"])
+	assert.Equal(t, clip.RoundedRect, m["prop_clip"])
+	assert.Equal(t, 3.0, m["prop_corner_radius"])
+}
+
+func TestRect_ToMap_WhenCaptionAndAltTextAreSet(t *testing.T) {
+	// Arrange
+	sut := props.Rect{
+		Caption: &props.Caption{Value: "Figure 1", Prop: props.Text{Size: 8}},
+		AltText: "company logo",
+	}
+
+	// Act
+	m := sut.ToMap()
+
+	// Assert
+	assert.Equal(t, "Figure 1", m["prop_caption"])
+	assert.Equal(t, 8.0, m["prop_caption_font_size"])
+	assert.Equal(t, "company logo", m["prop_alt_text"])
+}
+
+func TestRect_ToMap_WhenVectorIsSet(t *testing.T) {
+	// Arrange
+	sut := props.Rect{Vector: true}
+
+	// Act
+	m := sut.ToMap()
+
+	// Assert
+	assert.Equal(t, true, m["prop_vector"])
+}
+
+func TestRect_ToMap_WhenGS1IsSet(t *testing.T) {
+	// Arrange
+	sut := props.Rect{GS1: true}
+
+	// Act
+	m := sut.ToMap()
+
+	// Assert
+	assert.Equal(t, true, m["prop_gs1"])
 }
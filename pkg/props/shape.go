@@ -0,0 +1,93 @@
+package props
+
+import (
+	"fmt"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/linestyle"
+)
+
+// Shape represents properties from a shape inside a cell.
+type Shape struct {
+	// StrokeColor define the color of the shape outline. Default: black
+	StrokeColor *Color
+	// FillColor define the color of the shape interior, when nil the shape is not filled.
+	FillColor *Color
+	// StrokeWidth define the thickness of the shape outline.
+	StrokeWidth float64
+	// NoStroke define that the outline of the shape will not be drawn.
+	NoStroke bool
+	// DashPattern define the lengths of the dashes and gaps of the outline, ex: []float64{2, 1}.
+	// When empty the outline is solid.
+	DashPattern []float64
+	// Radius define the radius of the corners of a rectangle.
+	Radius float64
+	// ArrowSize define the length of the arrowhead.
+	ArrowSize float64
+}
+
+// ToMap returns a map with the Shape fields.
+func (s *Shape) ToMap() map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+
+	if s.StrokeColor != nil {
+		m["prop_stroke_color"] = s.StrokeColor.ToString()
+	}
+
+	if s.FillColor != nil {
+		m["prop_fill_color"] = s.FillColor.ToString()
+	}
+
+	if s.StrokeWidth != 0 {
+		m["prop_stroke_width"] = s.StrokeWidth
+	}
+
+	if s.NoStroke {
+		m["prop_no_stroke"] = s.NoStroke
+	}
+
+	if len(s.DashPattern) > 0 {
+		m["prop_dash_pattern"] = fmt.Sprintf("%v", s.DashPattern)
+	}
+
+	if s.Radius != 0 {
+		m["prop_radius"] = s.Radius
+	}
+
+	if s.ArrowSize != 0 {
+		m["prop_arrow_size"] = s.ArrowSize
+	}
+
+	return m
+}
+
+// MakeValid from Shape define default values for a Shape.
+func (s *Shape) MakeValid() {
+	defaultArrowSize := 3.0
+
+	if s.StrokeColor == nil {
+		s.StrokeColor = &BlackColor
+	}
+
+	if s.StrokeWidth <= 0 {
+		s.StrokeWidth = linestyle.DefaultLineThickness
+	}
+
+	for _, length := range s.DashPattern {
+		if length < 0 {
+			s.DashPattern = nil
+			break
+		}
+	}
+
+	if s.Radius < 0 {
+		s.Radius = 0
+	}
+
+	if s.ArrowSize <= 0 {
+		s.ArrowSize = defaultArrowSize
+	}
+}
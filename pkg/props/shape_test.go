@@ -0,0 +1,81 @@
+package props_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/johnfercher/maroto/v2/internal/fixture"
+	"github.com/johnfercher/maroto/v2/pkg/props"
+)
+
+func TestShape_ToMap(t *testing.T) {
+	t.Run("when shape is nil, should return nil", func(t *testing.T) {
+		// Arrange
+		var prop *props.Shape
+
+		// Act & Assert
+		assert.Nil(t, prop.ToMap())
+	})
+	t.Run("when shape is filled, should return all fields", func(t *testing.T) {
+		// Arrange
+		prop := fixture.ShapeProp()
+		prop.NoStroke = true
+
+		// Act
+		m := prop.ToMap()
+
+		// Assert
+		assert.Equal(t, "RGB(100, 50, 200)", m["prop_stroke_color"])
+		assert.Equal(t, "RGB(255, 0, 0)", m["prop_fill_color"])
+		assert.Equal(t, 0.5, m["prop_stroke_width"])
+		assert.Equal(t, true, m["prop_no_stroke"])
+		assert.Equal(t, "[2 1]", m["prop_dash_pattern"])
+		assert.Equal(t, 2.0, m["prop_radius"])
+		assert.Equal(t, 4.0, m["prop_arrow_size"])
+	})
+}
+
+func TestShape_MakeValid(t *testing.T) {
+	t.Run("when shape is empty, should apply defaults", func(t *testing.T) {
+		// Arrange
+		prop := props.Shape{}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, &props.BlackColor, prop.StrokeColor)
+		assert.Nil(t, prop.FillColor)
+		assert.Equal(t, 0.2, prop.StrokeWidth)
+		assert.Equal(t, 3.0, prop.ArrowSize)
+	})
+	t.Run("when dash pattern has negative length, should be solid", func(t *testing.T) {
+		// Arrange
+		prop := props.Shape{DashPattern: []float64{1, -1}}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Nil(t, prop.DashPattern)
+	})
+	t.Run("when radius is negative, should apply 0", func(t *testing.T) {
+		// Arrange
+		prop := props.Shape{Radius: -1}
+
+		// Act
+		prop.MakeValid()
+
+		// Assert
+		assert.Equal(t, 0.0, prop.Radius)
+	})
+}
+
+func TestPoint_ToString(t *testing.T) {
+	// Arrange
+	point := props.Point{X: 10, Y: 20.5}
+
+	// Act & Assert
+	assert.Equal(t, "(10.00, 20.50)", point.ToString())
+}
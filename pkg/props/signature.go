@@ -24,6 +24,11 @@ type Signature struct {
 	LineThickness float64
 
 	SafePadding float64
+	// SignatureHeight is the space above the line of a signature block, where the signature image is placed.
+	// In rows with a fixed height the space grows to fill the cell. Default: 15
+	SignatureHeight float64
+	// DetailFontSize is the font size of the role, the date and the note of a signature block. Default: FontSize
+	DetailFontSize float64
 }
 
 // ToMap returns a map with the Signature fields.
@@ -62,6 +67,14 @@ func (s *Signature) ToMap() map[string]interface{} {
 		m["prop_line_color"] = s.LineColor.ToString()
 	}
 
+	if s.SignatureHeight != 0 {
+		m["prop_signature_height"] = s.SignatureHeight
+	}
+
+	if s.DetailFontSize != 0 {
+		m["prop_detail_font_size"] = s.DetailFontSize
+	}
+
 	return m
 }
 
@@ -40,6 +40,17 @@ func TestSignature_ToMap(t *testing.T) {
 		assert.Equal(t, "RGB(100, 50, 200)", m["prop_font_color"])
 		assert.Equal(t, "RGB(100, 50, 200)", m["prop_line_color"])
 	})
+	t.Run("when block fields are sent, should add them to the map", func(t *testing.T) {
+		// Arrange
+		sut := props.Signature{SignatureHeight: 20, DetailFontSize: 7}
+
+		// Act
+		m := sut.ToMap()
+
+		// Assert
+		assert.Equal(t, 20.0, m["prop_signature_height"])
+		assert.Equal(t, 7.0, m["prop_detail_font_size"])
+	})
 }
 
 func TestSignature_ToLineProp(t *testing.T) {
@@ -0,0 +1,30 @@
+package props
+
+// Table represents properties from a table built from a collection of elements.
+type Table struct {
+	// HeaderText defines the font and spacing of the header texts.
+	HeaderText Text
+	// ContentText defines the font and spacing of the content texts.
+	ContentText Text
+	// HeaderStyle defines the style applied to the header row.
+	// Default: nil
+	HeaderStyle *Cell
+	// StripeStyle defines the style applied to every second content row (zebra striping).
+	// Default: nil
+	StripeStyle *Cell
+	// GroupHeaderText defines the font and spacing of the group header texts.
+	GroupHeaderText Text
+	// GroupHeaderStyle defines the style applied to the group header rows.
+	// Default: nil
+	GroupHeaderStyle *Cell
+	// FooterText defines the font and spacing of the subtotal and total texts.
+	FooterText Text
+	// FooterStyle defines the style applied to the subtotal and total rows.
+	// Default: nil
+	FooterStyle *Cell
+	// RowHeight defines the height of header and content rows, when zero the rows have automatic height.
+	RowHeight float64
+	// EmptyMessage defines the text written in a single row when there is no element,
+	// when empty an empty collection returns an error.
+	EmptyMessage string
+}
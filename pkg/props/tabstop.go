@@ -0,0 +1,38 @@
+package props
+
+import (
+	"fmt"
+
+	"github.com/johnfercher/maroto/v2/pkg/consts/tabstop"
+)
+
+// TabStop represents a position inside a text where a tab character ("\t") jumps to.
+type TabStop struct {
+	// Position is the distance between the text left boundary and the tab stop.
+	Position float64
+	// Align defines how the text after the tab is placed in relation to the tab stop. Default: tabstop.Left
+	Align tabstop.Type
+	// Leader is the fill character repeated between the previous text and the tab stop, ex: ".", "-" or "_".
+	// Default: no leader.
+	Leader string
+}
+
+// ToString returns a string representation of the TabStop.
+func (t *TabStop) ToString() string {
+	if t.Leader == "" {
+		return fmt.Sprintf("%s(%.2f)", t.Align, t.Position)
+	}
+
+	return fmt.Sprintf("%s(%.2f, %s)", t.Align, t.Position, t.Leader)
+}
+
+// MakeValid from TabStop define default values for a TabStop.
+func (t *TabStop) MakeValid() {
+	if !t.Align.IsValid() {
+		t.Align = tabstop.Left
+	}
+
+	if t.Position < 0 {
+		t.Position = 0
+	}
+}
@@ -2,6 +2,8 @@
 package props
 
 import (
+	"sort"
+
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/breakline"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
@@ -33,6 +35,10 @@ type Text struct {
 	Color *Color
 	// Hyperlink define a link to be opened when the text is clicked.
 	Hyperlink *string
+	// TabStops define where each tab character ("\t") of the text jumps to. When defined,
+	// every line of the text (separated by "\n") is written without automatic line break
+	// and Align is ignored.
+	TabStops []TabStop
 }
 
 // ToMap converts a Text to a map.
@@ -85,6 +91,14 @@ func (t *Text) ToMap() map[string]interface{} {
 		m["prop_hyperlink"] = *t.Hyperlink
 	}
 
+	if len(t.TabStops) > 0 {
+		var tabStops []string
+		for _, tabStop := range t.TabStops {
+			tabStops = append(tabStops, tabStop.ToString())
+		}
+		m["prop_tab_stops"] = tabStops
+	}
+
 	return m
 }
 
@@ -136,4 +150,16 @@ func (t *Text) MakeValid(font *Font) {
 	if t.BreakLineStrategy == "" {
 		t.BreakLineStrategy = breakline.EmptySpaceStrategy
 	}
+
+	if len(t.TabStops) > 0 {
+		tabStops := make([]TabStop, len(t.TabStops))
+		copy(tabStops, t.TabStops)
+		for i := range tabStops {
+			tabStops[i].MakeValid()
+		}
+		sort.SliceStable(tabStops, func(i, j int) bool {
+			return tabStops[i].Position < tabStops[j].Position
+		})
+		t.TabStops = tabStops
+	}
 }
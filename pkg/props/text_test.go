@@ -8,6 +8,7 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontfamily"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
+	"github.com/johnfercher/maroto/v2/pkg/consts/tabstop"
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
@@ -89,6 +90,15 @@ func TestText_MakeValid(t *testing.T) {
 				assert.Equal(t, prop.VerticalPadding, 0.0)
 			},
 		},
+		{
+			"When tab stops are not sorted, should sort by position and define default align",
+			&props.Text{
+				TabStops: []props.TabStop{{Position: 80, Align: tabstop.Right}, {Position: -5}},
+			},
+			func(t *testing.T, prop *props.Text) {
+				assert.Equal(t, []props.TabStop{{Position: 0, Align: tabstop.Left}, {Position: 80, Align: tabstop.Right}}, prop.TabStops)
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -96,3 +106,18 @@ func TestText_MakeValid(t *testing.T) {
 		c.assert(t, c.fontProp)
 	}
 }
+
+func TestText_ToMap(t *testing.T) {
+	t.Run("when tab stops are defined, should add them to the map", func(t *testing.T) {
+		// Arrange
+		prop := props.Text{
+			TabStops: []props.TabStop{{Position: 10, Align: tabstop.Left}, {Position: 80, Align: tabstop.Right, Leader: "."}},
+		}
+
+		// Act
+		m := prop.ToMap()
+
+		// Assert
+		assert.Equal(t, []string{"left(10.00)", "right(80.00, .)"}, m["prop_tab_stops"])
+	})
+}
@@ -2,21 +2,22 @@
 package repository
 
 import (
-	"os"
-
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/core/entity"
+	"github.com/johnfercher/maroto/v2/pkg/loader"
 )
 
 // Repository is the abstraction to load custom fonts.
 type Repository interface {
 	AddUTF8Font(family string, style fontstyle.Type, file string) Repository
 	AddUTF8FontFromBytes(family string, style fontstyle.Type, bytes []byte) Repository
+	WithLoader(loader loader.Loader) Repository
 	Load() ([]*entity.CustomFont, error)
 }
 
 type FontRepository struct {
 	customFonts []*entity.CustomFont
+	loader      loader.Loader
 }
 
 // New creates a new repository.
@@ -24,6 +25,18 @@ func New() Repository {
 	return &FontRepository{}
 }
 
+// WithLoader defines the loader used to read the font files when Load is called, like a loader.NewFS of an embed.FS.
+// Without it, the font files are read when the document is built, through the loader of the config,
+// see config.WithFileSystem and config.WithLoader.
+func (r *FontRepository) WithLoader(loader loader.Loader) Repository {
+	if loader == nil {
+		return r
+	}
+
+	r.loader = loader
+	return r
+}
+
 // AddUTF8Font adds a custom font to the repository.
 func (r *FontRepository) AddUTF8Font(family string, style fontstyle.Type, file string) Repository {
 	if family == "" {
@@ -70,13 +83,17 @@ func (r *FontRepository) AddUTF8FontFromBytes(family string, style fontstyle.Typ
 	return r
 }
 
-// Load loads all custom fonts.
+// Load loads all custom fonts, the font files are only read here when a loader was defined by WithLoader.
 func (r *FontRepository) Load() ([]*entity.CustomFont, error) {
+	if r.loader == nil {
+		return r.customFonts, nil
+	}
+
 	for _, customFont := range r.customFonts {
 		if customFont.File == "" {
 			continue
 		}
-		bytes, err := os.ReadFile(customFont.File)
+		bytes, err := r.loader.Load(customFont.File)
 		if err != nil {
 			return nil, err
 		}
@@ -1,11 +1,15 @@
 package repository_test
 
 import (
+	"errors"
 	"os"
 	"path"
 	"strings"
 	"testing"
+	"testing/fstest"
 
+	"github.com/johnfercher/maroto/v2/mocks"
+	"github.com/johnfercher/maroto/v2/pkg/loader"
 	"github.com/johnfercher/maroto/v2/pkg/repository"
 
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
@@ -63,7 +67,7 @@ func TestRepository_AddUTF8Font(t *testing.T) {
 		assert.Equal(t, "family", customFonts[0].Family)
 		assert.Equal(t, fontstyle.Bold, customFonts[0].Style)
 		assert.NotEmpty(t, customFonts[0].File)
-		assert.NotEmpty(t, customFonts[0].Bytes)
+		assert.Empty(t, customFonts[0].Bytes)
 	})
 }
 
@@ -132,3 +136,32 @@ func buildPath(file string) string {
 	dir = strings.ReplaceAll(dir, "pkg/repository", "")
 	return path.Join(dir, file)
 }
+
+func TestRepository_WithLoader(t *testing.T) {
+	t.Run("when loader is sent, should read font file from loader", func(t *testing.T) {
+		// Arrange
+		fsys := fstest.MapFS{"fonts/arial.ttf": &fstest.MapFile{Data: []byte{1, 2, 3}}}
+		sut := repository.New().WithLoader(loader.NewFS(fsys))
+
+		// Act
+		customFonts, err := sut.AddUTF8Font("arial", fontstyle.Normal, "fonts/arial.ttf").Load()
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Len(t, customFonts, 1)
+		assert.Equal(t, []byte{1, 2, 3}, customFonts[0].Bytes)
+	})
+	t.Run("when loader returns error, should return error", func(t *testing.T) {
+		// Arrange
+		fileLoader := mocks.NewLoader(t)
+		fileLoader.EXPECT().Load("s3://bucket/arial.ttf").Return(nil, errors.New("timeout"))
+		sut := repository.New().WithLoader(fileLoader)
+
+		// Act
+		customFonts, err := sut.AddUTF8Font("arial", fontstyle.Normal, "s3://bucket/arial.ttf").Load()
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, customFonts)
+	})
+}